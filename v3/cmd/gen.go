@@ -32,7 +32,7 @@ var genCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, packages []string) {
 		var err error
 
-		cmd.Println("Please wait the command is running, it may take some time\n")
+		cmd.Print("Please wait the command is running, it may take some time\n\n")
 
 		if len(packages) == 0 {
 			packages = viper.GetStringSlice("packages")
@@ -53,7 +53,7 @@ var genCmd = &cobra.Command{
 		if wd == "" {
 			wd, err = os.Getwd()
 			if err != nil {
-				cmd.PrintErrf("failed to get working directory: ", err)
+				cmd.PrintErrf("failed to get working directory: %s", err)
 				os.Exit(1)
 			}
 		}
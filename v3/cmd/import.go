@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/swipe-io/strcase"
+	"gopkg.in/yaml.v3"
+
+	"github.com/swipe-io/swipe/v3/internal/openapi"
+	"github.com/swipe-io/swipe/v3/internal/openapi/reverse"
+)
+
+// importCmd represents the import command
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import a service from an API specification",
+	Long:  ``,
+}
+
+// importOpenapiCmd represents the import openapi command
+var importOpenapiCmd = &cobra.Command{
+	Use:   "openapi <spec>",
+	Short: "Generate a Go service interface and Gokit options from an OpenAPI 3.x spec",
+	Long:  ``,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("requires a spec file argument")
+		}
+		return nil
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		var err error
+		wd := viper.GetString("import-work-dir")
+		if wd == "" {
+			wd, err = os.Getwd()
+			if err != nil {
+				cmd.PrintErrf("failed to get working directory: %s\n", err)
+				os.Exit(1)
+			}
+		}
+
+		output := viper.GetString("import-output")
+		iface := viper.GetString("import-iface")
+		swipePkg := viper.GetString("import-swipe-pkg")
+
+		modulePath, err := readModulePath(filepath.Join(wd, "go.mod"))
+		if err != nil {
+			cmd.PrintErrln(err)
+			os.Exit(1)
+		}
+
+		data, err := os.ReadFile(args[0])
+		if err != nil {
+			cmd.PrintErrf("failed to read spec: %s\n", err)
+			os.Exit(1)
+		}
+
+		var (
+			o openapi.OpenAPI
+			// the document security is not a field of the OpenAPI type
+			doc struct {
+				Security []map[string][]interface{} `yaml:"security" json:"security"`
+			}
+		)
+		switch filepath.Ext(args[0]) {
+		case ".yaml", ".yml":
+			err = yaml.Unmarshal(data, &o)
+			if err == nil {
+				err = yaml.Unmarshal(data, &doc)
+			}
+		default:
+			err = json.Unmarshal(data, &o)
+			if err == nil {
+				err = json.Unmarshal(data, &doc)
+			}
+		}
+		if err != nil {
+			cmd.PrintErrf("failed to parse spec: %s\n", err)
+			os.Exit(1)
+		}
+		if !strings.HasPrefix(o.OpenAPI, "3.") {
+			cmd.PrintErrf("unsupported OpenAPI version %q, expected 3.x\n", o.OpenAPI)
+			os.Exit(1)
+		}
+
+		outputPath := filepath.Join(wd, output)
+
+		result, err := reverse.Generate(&o, reverse.Options{
+			PkgName:    strcase.ToSnake(filepath.Base(outputPath)),
+			Iface:      iface,
+			OptionsPkg: path.Join(modulePath, swipePkg, "swipe", "gokit"),
+			Security:   doc.Security,
+		})
+		if err != nil {
+			cmd.PrintErrf("failed to import spec: %s\n", err)
+			os.Exit(1)
+		}
+
+		cmd.Printf("Workdir: %s\n", wd)
+
+		for _, warning := range result.Warnings {
+			cmd.PrintErrf("Warning: %s\n", warning)
+		}
+
+		if err := os.MkdirAll(outputPath, 0775); err != nil {
+			cmd.PrintErrf("Error: %s\n", err)
+			os.Exit(1)
+		}
+		files := map[string][]byte{
+			strcase.ToSnake(iface) + ".go": result.Service,
+			"swipe.go":                     result.Swipe,
+		}
+		for name, data := range files {
+			filename := filepath.Join(outputPath, name)
+			if err := os.WriteFile(filename, data, 0644); err != nil {
+				cmd.PrintErrf("Error: %s\n", err)
+				os.Exit(1)
+			}
+			cmd.Printf("Wrote %s\n", filename)
+		}
+	},
+}
+
+func readModulePath(filename string) (string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return "", errors.New("go mod not found, run go mod init")
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "module ") {
+			return strings.Trim(strings.TrimSpace(strings.TrimPrefix(line, "module ")), "\""), nil
+		}
+	}
+	return "", errors.New("module path not found in " + filename)
+}
+
+func init() {
+	importOpenapiCmd.Flags().StringP("work-dir", "w", "", "Work directory")
+	importOpenapiCmd.Flags().StringP("output", "o", "service", "Output directory of the service package")
+	importOpenapiCmd.Flags().StringP("iface", "i", "Service", "Service interface name")
+	importOpenapiCmd.Flags().StringP("swipe-pkg", "p", "pkg", "Swipe package name")
+
+	_ = viper.BindPFlag("import-work-dir", importOpenapiCmd.Flags().Lookup("work-dir"))
+	_ = viper.BindPFlag("import-output", importOpenapiCmd.Flags().Lookup("output"))
+	_ = viper.BindPFlag("import-iface", importOpenapiCmd.Flags().Lookup("iface"))
+	_ = viper.BindPFlag("import-swipe-pkg", importOpenapiCmd.Flags().Lookup("swipe-pkg"))
+
+	importCmd.AddCommand(importOpenapiCmd)
+	rootCmd.AddCommand(importCmd)
+}
@@ -17,5 +17,13 @@ func NewFrame(version string, filename string, imports []string, pkgName string,
 		return NewGolangFrame(imports, version, pkgName, useDoNotEdit)
 	case ".js":
 		return NewJSFrame(version)
+	case ".ts":
+		return NewTSFrame(version)
+	case ".py":
+		return NewPythonFrame(version)
+	case ".kt":
+		return NewKotlinFrame(version)
+	case ".swift":
+		return NewSwiftFrame(version)
 	}
 }
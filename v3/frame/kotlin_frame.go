@@ -0,0 +1,20 @@
+package frame
+
+import (
+	"bytes"
+)
+
+type KotlinFrame struct {
+	version string
+}
+
+func (f *KotlinFrame) Frame(data []byte) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	buf.WriteString("// Code generated by Swipe " + f.version + ". DO NOT EDIT.\n\n")
+	buf.Write(data)
+	return buf.Bytes(), nil
+}
+
+func NewKotlinFrame(version string) *KotlinFrame {
+	return &KotlinFrame{version: version}
+}
@@ -0,0 +1,20 @@
+package frame
+
+import (
+	"bytes"
+)
+
+type PythonFrame struct {
+	version string
+}
+
+func (f *PythonFrame) Frame(data []byte) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	buf.WriteString("# Code generated by Swipe " + f.version + ". DO NOT EDIT.\n\n")
+	buf.Write(data)
+	return buf.Bytes(), nil
+}
+
+func NewPythonFrame(version string) *PythonFrame {
+	return &PythonFrame{version: version}
+}
@@ -0,0 +1,20 @@
+package frame
+
+import (
+	"bytes"
+)
+
+type SwiftFrame struct {
+	version string
+}
+
+func (f *SwiftFrame) Frame(data []byte) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	buf.WriteString("// Code generated by Swipe " + f.version + ". DO NOT EDIT.\n\n")
+	buf.Write(data)
+	return buf.Bytes(), nil
+}
+
+func NewSwiftFrame(version string) *SwiftFrame {
+	return &SwiftFrame{version: version}
+}
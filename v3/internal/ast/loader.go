@@ -132,11 +132,18 @@ func (l *Loader) run() (errs []error) {
 			if st, ok := ts.Type.(*ast.StructType); ok {
 				comments := map[string]string{}
 				for _, field := range st.Fields.List {
-					if field.Comment != nil {
-						if len(field.Comment.List) > 0 {
-							for _, name := range field.Names {
-								comments[name.Name] = stdstrings.TrimLeft(field.Comment.List[0].Text, "/")
-							}
+					var lines []string
+					if field.Doc != nil {
+						for _, comment := range field.Doc.List {
+							lines = append(lines, stdstrings.TrimLeft(comment.Text, "/"))
+						}
+					}
+					if field.Comment != nil && len(field.Comment.List) > 0 {
+						lines = append(lines, stdstrings.TrimLeft(field.Comment.List[0].Text, "/"))
+					}
+					if len(lines) > 0 {
+						for _, name := range field.Names {
+							comments[name.Name] = stdstrings.Join(lines, "\n")
 						}
 					}
 				}
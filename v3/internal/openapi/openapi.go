@@ -10,6 +10,7 @@ import (
 	"github.com/swipe-io/strcase"
 
 	"github.com/swipe-io/swipe/v3/internal/plugin"
+	"github.com/swipe-io/swipe/v3/internal/validation"
 	"github.com/swipe-io/swipe/v3/option"
 )
 
@@ -26,6 +27,7 @@ type InterfaceMethod struct {
 	RESTQueryValues  []string
 	RESTHeaderVars   []string
 	BearerAuth       bool
+	Validation       bool
 }
 
 type Interface struct {
@@ -42,15 +44,38 @@ type Error struct {
 	ErrCode string
 }
 
+type Webhook struct {
+	Name   string
+	Method InterfaceMethod
+}
+
 type Openapi struct {
 	info       Info
 	servers    []Server
 	interfaces []Interface
 	errors     map[string]map[string][]Error
 	useJSONRPC bool
+	validation bool
+	version    string
+	webhooks   []Webhook
 	defTypes   map[string]*option.NamedType
 }
 
+func (g *Openapi) SetValidation(validation bool) *Openapi {
+	g.validation = validation
+	return g
+}
+
+func (g *Openapi) SetVersion(version string) *Openapi {
+	g.version = version
+	return g
+}
+
+func (g *Openapi) SetWebhooks(webhooks []Webhook) *Openapi {
+	g.webhooks = webhooks
+	return g
+}
+
 func (g *Openapi) Build() OpenAPI {
 	g.defTypes = make(map[string]*option.NamedType, 1024)
 	o := OpenAPI{
@@ -144,6 +169,7 @@ func (g *Openapi) Build() OpenAPI {
 			}
 			op.Tags = append(op.Tags, m.Tags...)
 			op.Description = m.Description
+			op.Deprecated = m.Func.Deprecated
 
 			if _, ok := o.Paths[pathStr]; !ok {
 				o.Paths[pathStr] = &Path{}
@@ -173,8 +199,28 @@ func (g *Openapi) Build() OpenAPI {
 			},
 		}
 	}
+	if len(g.webhooks) > 0 {
+		o.Webhooks = map[string]*Path{}
+		for _, w := range g.webhooks {
+			op := g.makeRestPath(w.Method)
+			op.Description = w.Method.Description
+			op.Deprecated = w.Method.Func.Deprecated
+			o.Webhooks[w.Name] = &Path{Post: op}
+		}
+	}
 	for _, namedType := range g.defTypes {
-		o.Components.Schemas[namedType.Name.Value] = g.schemaByType(namedType.Type)
+		schema := g.schemaByType(namedType.Type)
+		if len(namedType.Enums) > 0 {
+			schema.Enum = make([]interface{}, 0, len(namedType.Enums))
+			for _, e := range namedType.Enums {
+				schema.Enum = append(schema.Enum, e.Value)
+			}
+			schema.Example = schema.Enum[0]
+		}
+		o.Components.Schemas[namedType.Name.Value] = schema
+	}
+	if g.version == "3.1" {
+		o.UpgradeTo31()
 	}
 	return o
 }
@@ -221,6 +267,9 @@ func (g *Openapi) fillTypeDef(t interface{}) {
 }
 
 func (g *Openapi) schemaByTypeRecursive(schema *Schema, t interface{}) {
+	if g.version == "3.1" && plugin.IsPointer(t) {
+		schema.Nullable = true
+	}
 	switch t := t.(type) {
 	case *option.NamedType:
 		switch t.Pkg.Path {
@@ -269,8 +318,19 @@ func (g *Openapi) schemaByTypeRecursive(schema *Schema, t interface{}) {
 				Properties: Properties{},
 			}
 			filedSchema.Description = field.Var.Comment
+			filedSchema.Deprecated = field.Var.Deprecated
 			schema.Properties[name] = filedSchema
 			g.schemaByTypeRecursive(filedSchema, field.Var.Type)
+			if field.Var.Example != "" {
+				filedSchema.Example = exampleValue(field.Var.Example)
+			}
+			if g.validation {
+				c := validation.MakeConstraints(field.Var.Type, validation.FieldRules(field))
+				if c.Required {
+					schema.Required = append(schema.Required, name)
+				}
+				applyConstraints(filedSchema, c)
+			}
 		}
 	case *option.MapType:
 		mapSchema := &Schema{
@@ -300,6 +360,18 @@ func (g *Openapi) schemaByTypeRecursive(schema *Schema, t interface{}) {
 		}
 		return
 	case *option.IfaceType:
+		if g.version == "3.1" {
+			schema.Description = "Can be any value - string, number, boolean, array, object or null."
+			schema.OneOf = []Schema{
+				{Type: "string", Example: "abc"},
+				{Type: "number", Example: 1.11},
+				{Type: "boolean", Example: true},
+				{Type: "array"},
+				{Type: "object"},
+				{Type: "null"},
+			}
+			return
+		}
 		schema.Type = "object"
 		schema.Description = "Can be any value - string, number, boolean, array or object."
 		schema.Properties = Properties{}
@@ -376,13 +448,26 @@ func (g *Openapi) makeJSONRPCPath(m InterfaceMethod, prefix string) *Operation {
 
 			schema := g.schemaByType(p.Type)
 			schema.Description = p.Comment
+			schema.Deprecated = p.Deprecated
 			requestSchema.Properties[p.Name.Lower()] = schema
+
+			if m.Validation {
+				c := validation.MakeConstraints(p.Type, validation.ParamRules(p))
+				if c.Required {
+					requestSchema.Required = append(requestSchema.Required, p.Name.Lower())
+				}
+				schema.Description = validation.TrimParamRules(p.Comment)
+				applyConstraints(schema, c)
+			}
 		}
 	} else {
 		requestSchema.Type = "object"
 		requestSchema.Nullable = true
 		requestSchema.Example = json.RawMessage("null")
 	}
+	if m.Func.Example != "" {
+		requestSchema.Example = exampleValue(m.Func.Example)
+	}
 
 	lenResults := plugin.LenWithoutErrors(m.Func.Sig.Results)
 
@@ -448,13 +533,14 @@ func (g *Openapi) makeJSONRPCPath(m InterfaceMethod, prefix string) *Operation {
 			},
 			"method": &Schema{
 				Type: "string",
-				Enum: []string{restMethod},
+				Enum: []interface{}{restMethod},
 			},
 			"params": requestSchema,
 		},
 	}
 
 	return &Operation{
+		Summary: operationSummary(m.Func),
 		RequestBody: &RequestBody{
 			Required: true,
 			Content: map[string]Media{
@@ -573,7 +659,17 @@ func (g *Openapi) makeRestPath(m InterfaceMethod) *Operation {
 		g.fillTypeDef(p.Type)
 		schema := g.schemaByType(p.Type)
 		schema.Description = p.Comment
+		schema.Deprecated = p.Deprecated
 		requestSchema.Properties[p.Name.Lower()] = schema
+
+		if m.Validation {
+			c := validation.MakeConstraints(p.Type, validation.ParamRules(p))
+			if c.Required {
+				requestSchema.Required = append(requestSchema.Required, p.Name.Lower())
+			}
+			schema.Description = validation.TrimParamRules(p.Comment)
+			applyConstraints(schema, c)
+		}
 	}
 
 	lenResults := plugin.LenWithoutErrors(m.Func.Sig.Results)
@@ -639,28 +735,16 @@ func (g *Openapi) makeRestPath(m InterfaceMethod) *Operation {
 	}
 
 	o := &Operation{
-		Summary:   m.Name.Value,
+		Summary:   operationSummary(m.Func),
 		Responses: responses,
 	}
 
 	for _, pathVar := range pathVars {
-		o.Parameters = append(o.Parameters, Parameter{
-			In:          "path",
-			Name:        pathVar.Param.Name.Lower(),
-			Description: pathVar.Param.Comment,
-			Required:    pathVar.IsRequired,
-			Schema:      g.schemaByType(pathVar.Param.Type),
-		})
+		o.Parameters = append(o.Parameters, g.makeParameter(m, "path", pathVar.Param.Name.Lower(), pathVar, g.schemaByType(pathVar.Param.Type)))
 	}
 
 	for _, headerVar := range headerVars {
-		o.Parameters = append(o.Parameters, Parameter{
-			In:          "header",
-			Name:        headerVar.Value,
-			Description: headerVar.Param.Comment,
-			Required:    headerVar.IsRequired,
-			Schema:      g.schemaByType(headerVar.Param.Type),
-		})
+		o.Parameters = append(o.Parameters, g.makeParameter(m, "header", headerVar.Value, headerVar, g.schemaByType(headerVar.Param.Type)))
 	}
 
 	for _, queryVar := range queryVars {
@@ -680,19 +764,17 @@ func (g *Openapi) makeRestPath(m InterfaceMethod) *Operation {
 				}
 			}
 		} else {
-			o.Parameters = append(o.Parameters, Parameter{
-				In:          "query",
-				Name:        queryVar.Param.Name.Lower(),
-				Description: queryVar.Param.Comment,
-				Required:    queryVar.IsRequired,
-				Schema: &Schema{
-					Type:       "string",
-					Properties: Properties{},
-				},
-			})
+			o.Parameters = append(o.Parameters, g.makeParameter(m, "query", queryVar.Param.Name.Lower(), queryVar, &Schema{
+				Type:       "string",
+				Properties: Properties{},
+			}))
 		}
 	}
 
+	if m.Func.Example != "" {
+		requestSchema.Example = exampleValue(m.Func.Example)
+	}
+
 	switch m.RESTMethod {
 	case "POST", "PUT", "PATCH":
 		o.RequestBody = &RequestBody{
@@ -707,6 +789,65 @@ func (g *Openapi) makeRestPath(m InterfaceMethod) *Operation {
 	return o
 }
 
+func (g *Openapi) makeParameter(m InterfaceMethod, in, name string, v plugin.VarType, schema *Schema) Parameter {
+	parameter := Parameter{
+		In:          in,
+		Name:        name,
+		Description: v.Param.Comment,
+		Required:    v.IsRequired,
+		Deprecated:  v.Param.Deprecated,
+		Schema:      schema,
+	}
+	if m.Validation {
+		c := validation.MakeConstraints(v.Param.Type, validation.ParamRules(v.Param))
+		parameter.Description = validation.TrimParamRules(v.Param.Comment)
+		parameter.Required = parameter.Required || c.Required
+		applyConstraints(schema, c)
+	}
+	return parameter
+}
+
+// operationSummary returns the first sentence of the method comment.
+func operationSummary(m *option.FuncType) string {
+	if m.Comment == "" || stdstrings.HasPrefix(m.Comment, "Deprecated:") {
+		return m.Name.Value
+	}
+	if i := stdstrings.Index(m.Comment, ". "); i >= 0 {
+		return m.Comment[:i+1]
+	}
+	return m.Comment
+}
+
+// exampleValue decodes the JSON literal of an @example block, other text is used as a string example.
+func exampleValue(s string) interface{} {
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return s
+	}
+	return v
+}
+
+func applyConstraints(schema *Schema, c validation.Constraints) {
+	if schema.Ref != "" {
+		return
+	}
+	if c.Format != "" {
+		schema.Format = c.Format
+	}
+	if len(c.Enum) > 0 {
+		schema.Enum = make([]interface{}, 0, len(c.Enum))
+		for _, v := range c.Enum {
+			schema.Enum = append(schema.Enum, v)
+		}
+	}
+	schema.MinLength = c.MinLength
+	schema.MaxLength = c.MaxLength
+	schema.Minimum = c.Minimum
+	schema.Maximum = c.Maximum
+	schema.MinItems = c.MinItems
+	schema.MaxItems = c.MaxItems
+}
+
 func NewOpenapi(info Info, servers []Server, interfaces []Interface, errors map[string]map[string][]Error, useJSONRPC bool) *Openapi {
 	return &Openapi{info: info, servers: servers, interfaces: interfaces, errors: errors, useJSONRPC: useJSONRPC}
 }
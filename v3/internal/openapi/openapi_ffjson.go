@@ -1,5 +1,5 @@
 // Code generated by ffjson <https://github.com/pquerna/ffjson>. DO NOT EDIT.
-// source: pkg/openapi/gen.go
+// source: internal/openapi/types.go
 
 package openapi
 
@@ -8,12 +8,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-
 	fflib "github.com/pquerna/ffjson/fflib/v1"
 )
 
 // MarshalJSON marshal bytes to json - template
-func (j *Components) MarshalJSON() ([]byte, error) {
+func (j *BearerAuthSecuritySchema) MarshalJSON() ([]byte, error) {
 	var buf fflib.Buffer
 	if j == nil {
 		buf.WriteString("null")
@@ -27,7 +26,7 @@ func (j *Components) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *Components) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *BearerAuthSecuritySchema) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -36,40 +35,43 @@ func (j *Components) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	var obj []byte
 	_ = obj
 	_ = err
-	buf.WriteString(`{ `)
-	if len(j.Schemas) != 0 {
-		buf.WriteString(`"schemas":`)
-		/* Falling back. type=openapi.Schemas kind=map */
-		err = buf.Encode(j.Schemas)
-		if err != nil {
-			return err
-		}
-		buf.WriteByte(',')
-	}
-	buf.Rewind(1)
+	buf.WriteString(`{"type":`)
+	fflib.WriteJsonString(buf, string(j.Type))
+	buf.WriteString(`,"scheme":`)
+	fflib.WriteJsonString(buf, string(j.Scheme))
+	buf.WriteString(`,"bearerFormat":`)
+	fflib.WriteJsonString(buf, string(j.BearerFormat))
 	buf.WriteByte('}')
 	return nil
 }
 
 const (
-	ffjtComponentsbase = iota
-	ffjtComponentsnosuchkey
+	ffjtBearerAuthSecuritySchemabase = iota
+	ffjtBearerAuthSecuritySchemanosuchkey
 
-	ffjtComponentsSchemas
+	ffjtBearerAuthSecuritySchemaType
+
+	ffjtBearerAuthSecuritySchemaScheme
+
+	ffjtBearerAuthSecuritySchemaBearerFormat
 )
 
-var ffjKeyComponentsSchemas = []byte("schemas")
+var ffjKeyBearerAuthSecuritySchemaType = []byte("type")
+
+var ffjKeyBearerAuthSecuritySchemaScheme = []byte("scheme")
+
+var ffjKeyBearerAuthSecuritySchemaBearerFormat = []byte("bearerFormat")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *Components) UnmarshalJSON(input []byte) error {
+func (j *BearerAuthSecuritySchema) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *Components) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *BearerAuthSecuritySchema) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtComponentsbase
+	currentKey := ffjtBearerAuthSecuritySchemabase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -115,29 +117,57 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtComponentsnosuchkey
+				currentKey = ffjtBearerAuthSecuritySchemanosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
 				switch kn[0] {
 
+				case 'b':
+
+					if bytes.Equal(ffjKeyBearerAuthSecuritySchemaBearerFormat, kn) {
+						currentKey = ffjtBearerAuthSecuritySchemaBearerFormat
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
 				case 's':
 
-					if bytes.Equal(ffjKeyComponentsSchemas, kn) {
-						currentKey = ffjtComponentsSchemas
+					if bytes.Equal(ffjKeyBearerAuthSecuritySchemaScheme, kn) {
+						currentKey = ffjtBearerAuthSecuritySchemaScheme
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffjKeyBearerAuthSecuritySchemaType, kn) {
+						currentKey = ffjtBearerAuthSecuritySchemaType
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				}
 
-				if fflib.EqualFoldRight(ffjKeyComponentsSchemas, kn) {
-					currentKey = ffjtComponentsSchemas
+				if fflib.SimpleLetterEqualFold(ffjKeyBearerAuthSecuritySchemaBearerFormat, kn) {
+					currentKey = ffjtBearerAuthSecuritySchemaBearerFormat
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtComponentsnosuchkey
+				if fflib.EqualFoldRight(ffjKeyBearerAuthSecuritySchemaScheme, kn) {
+					currentKey = ffjtBearerAuthSecuritySchemaScheme
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyBearerAuthSecuritySchemaType, kn) {
+					currentKey = ffjtBearerAuthSecuritySchemaType
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtBearerAuthSecuritySchemanosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			}
@@ -154,10 +184,16 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
-				case ffjtComponentsSchemas:
-					goto handle_Schemas
+				case ffjtBearerAuthSecuritySchemaType:
+					goto handle_Type
 
-				case ffjtComponentsnosuchkey:
+				case ffjtBearerAuthSecuritySchemaScheme:
+					goto handle_Scheme
+
+				case ffjtBearerAuthSecuritySchemaBearerFormat:
+					goto handle_BearerFormat
+
+				case ffjtBearerAuthSecuritySchemanosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -171,104 +207,77 @@ mainparse:
 		}
 	}
 
-handle_Schemas:
+handle_Type:
 
-	/* handler: j.Schemas type=openapi.Schemas kind=map quoted=false*/
+	/* handler: j.Type type=string kind=string quoted=false*/
 
 	{
 
 		{
-			if tok != fflib.FFTok_left_bracket && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for Schemas", tok))
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
 			}
 		}
 
 		if tok == fflib.FFTok_null {
-			j.Schemas = nil
-		} else {
-
-			j.Schemas = make(map[string]*Schema, 0)
 
-			wantVal := true
+		} else {
 
-			for {
+			outBuf := fs.Output.Bytes()
 
-				var k string
+			j.Type = string(string(outBuf))
 
-				var tmpJSchemas *Schema
+		}
+	}
 
-				tok = fs.Scan()
-				if tok == fflib.FFTok_error {
-					goto tokerror
-				}
-				if tok == fflib.FFTok_right_bracket {
-					break
-				}
+	state = fflib.FFParse_after_value
+	goto mainparse
 
-				if tok == fflib.FFTok_comma {
-					if wantVal == true {
-						// TODO(pquerna): this isn't an ideal error message, this handles
-						// things like [,,,] as an array value.
-						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
-					}
-					continue
-				} else {
-					wantVal = true
-				}
+handle_Scheme:
 
-				/* handler: k type=string kind=string quoted=false*/
+	/* handler: j.Scheme type=string kind=string quoted=false*/
 
-				{
+	{
 
-					{
-						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-						}
-					}
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
 
-					if tok == fflib.FFTok_null {
+		if tok == fflib.FFTok_null {
 
-					} else {
+		} else {
 
-						outBuf := fs.Output.Bytes()
+			outBuf := fs.Output.Bytes()
 
-						k = string(string(outBuf))
+			j.Scheme = string(string(outBuf))
 
-					}
-				}
+		}
+	}
 
-				// Expect ':' after key
-				tok = fs.Scan()
-				if tok != fflib.FFTok_colon {
-					return fs.WrapErr(fmt.Errorf("wanted colon token, but got token: %v", tok))
-				}
+	state = fflib.FFParse_after_value
+	goto mainparse
 
-				tok = fs.Scan()
-				/* handler: tmpJSchemas type=*openapi.Schema kind=ptr quoted=false*/
+handle_BearerFormat:
 
-				{
-					if tok == fflib.FFTok_null {
+	/* handler: j.BearerFormat type=string kind=string quoted=false*/
 
-						tmpJSchemas = nil
+	{
 
-					} else {
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
 
-						if tmpJSchemas == nil {
-							tmpJSchemas = new(Schema)
-						}
+		if tok == fflib.FFTok_null {
 
-						err = tmpJSchemas.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
-						if err != nil {
-							return err
-						}
-					}
-					state = fflib.FFParse_after_value
-				}
+		} else {
 
-				j.Schemas[k] = tmpJSchemas
+			outBuf := fs.Output.Bytes()
 
-				wantVal = false
-			}
+			j.BearerFormat = string(string(outBuf))
 
 		}
 	}
@@ -295,7 +304,7 @@ done:
 }
 
 // MarshalJSON marshal bytes to json - template
-func (j *Contact) MarshalJSON() ([]byte, error) {
+func (j *Components) MarshalJSON() ([]byte, error) {
 	var buf fflib.Buffer
 	if j == nil {
 		buf.WriteString("null")
@@ -309,7 +318,7 @@ func (j *Contact) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *Contact) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *Components) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -318,20 +327,20 @@ func (j *Contact) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	var obj []byte
 	_ = obj
 	_ = err
-	buf.WriteString(`{ `)
-	if len(j.Name) != 0 {
-		buf.WriteString(`"name":`)
-		fflib.WriteJsonString(buf, string(j.Name))
-		buf.WriteByte(',')
-	}
-	if len(j.URL) != 0 {
-		buf.WriteString(`"url":`)
-		fflib.WriteJsonString(buf, string(j.URL))
-		buf.WriteByte(',')
+	buf.WriteString(`{ "securitySchemes":`)
+	/* Falling back. type=map[string]interface {} kind=map */
+	err = buf.Encode(j.SecuritySchemes)
+	if err != nil {
+		return err
 	}
-	if len(j.Email) != 0 {
-		buf.WriteString(`"email":`)
-		fflib.WriteJsonString(buf, string(j.Email))
+	buf.WriteByte(',')
+	if len(j.Schemas) != 0 {
+		buf.WriteString(`"schemas":`)
+		/* Falling back. type=openapi.Schemas kind=map */
+		err = buf.Encode(j.Schemas)
+		if err != nil {
+			return err
+		}
 		buf.WriteByte(',')
 	}
 	buf.Rewind(1)
@@ -340,32 +349,28 @@ func (j *Contact) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 }
 
 const (
-	ffjtContactbase = iota
-	ffjtContactnosuchkey
-
-	ffjtContactName
+	ffjtComponentsbase = iota
+	ffjtComponentsnosuchkey
 
-	ffjtContactURL
+	ffjtComponentsSecuritySchemes
 
-	ffjtContactEmail
+	ffjtComponentsSchemas
 )
 
-var ffjKeyContactName = []byte("name")
-
-var ffjKeyContactURL = []byte("url")
+var ffjKeyComponentsSecuritySchemes = []byte("securitySchemes")
 
-var ffjKeyContactEmail = []byte("email")
+var ffjKeyComponentsSchemas = []byte("schemas")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *Contact) UnmarshalJSON(input []byte) error {
+func (j *Components) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *Contact) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *Components) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtContactbase
+	currentKey := ffjtComponentsbase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -411,57 +416,40 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtContactnosuchkey
+				currentKey = ffjtComponentsnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
 				switch kn[0] {
 
-				case 'e':
-
-					if bytes.Equal(ffjKeyContactEmail, kn) {
-						currentKey = ffjtContactEmail
-						state = fflib.FFParse_want_colon
-						goto mainparse
-					}
-
-				case 'n':
+				case 's':
 
-					if bytes.Equal(ffjKeyContactName, kn) {
-						currentKey = ffjtContactName
+					if bytes.Equal(ffjKeyComponentsSecuritySchemes, kn) {
+						currentKey = ffjtComponentsSecuritySchemes
 						state = fflib.FFParse_want_colon
 						goto mainparse
-					}
-
-				case 'u':
 
-					if bytes.Equal(ffjKeyContactURL, kn) {
-						currentKey = ffjtContactURL
+					} else if bytes.Equal(ffjKeyComponentsSchemas, kn) {
+						currentKey = ffjtComponentsSchemas
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyContactEmail, kn) {
-					currentKey = ffjtContactEmail
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
-
-				if fflib.SimpleLetterEqualFold(ffjKeyContactURL, kn) {
-					currentKey = ffjtContactURL
+				if fflib.EqualFoldRight(ffjKeyComponentsSchemas, kn) {
+					currentKey = ffjtComponentsSchemas
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyContactName, kn) {
-					currentKey = ffjtContactName
+				if fflib.EqualFoldRight(ffjKeyComponentsSecuritySchemes, kn) {
+					currentKey = ffjtComponentsSecuritySchemes
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtContactnosuchkey
+				currentKey = ffjtComponentsnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			}
@@ -478,16 +466,13 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
-				case ffjtContactName:
-					goto handle_Name
-
-				case ffjtContactURL:
-					goto handle_URL
+				case ffjtComponentsSecuritySchemes:
+					goto handle_SecuritySchemes
 
-				case ffjtContactEmail:
-					goto handle_Email
+				case ffjtComponentsSchemas:
+					goto handle_Schemas
 
-				case ffjtContactnosuchkey:
+				case ffjtComponentsnosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -501,51 +486,98 @@ mainparse:
 		}
 	}
 
-handle_Name:
+handle_SecuritySchemes:
 
-	/* handler: j.Name type=string kind=string quoted=false*/
+	/* handler: j.SecuritySchemes type=map[string]interface {} kind=map quoted=false*/
 
 	{
 
 		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			if tok != fflib.FFTok_left_bracket && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
 			}
 		}
 
 		if tok == fflib.FFTok_null {
-
+			j.SecuritySchemes = nil
 		} else {
 
-			outBuf := fs.Output.Bytes()
+			j.SecuritySchemes = make(map[string]interface{}, 0)
 
-			j.Name = string(string(outBuf))
+			wantVal := true
 
-		}
-	}
+			for {
 
-	state = fflib.FFParse_after_value
-	goto mainparse
+				var k string
 
-handle_URL:
+				var tmpJSecuritySchemes interface{}
 
-	/* handler: j.URL type=string kind=string quoted=false*/
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_bracket {
+					break
+				}
 
-	{
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
 
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
-		}
+				/* handler: k type=string kind=string quoted=false*/
 
-		if tok == fflib.FFTok_null {
+				{
 
-		} else {
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
 
-			outBuf := fs.Output.Bytes()
+					if tok == fflib.FFTok_null {
 
-			j.URL = string(string(outBuf))
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						k = string(string(outBuf))
+
+					}
+				}
+
+				// Expect ':' after key
+				tok = fs.Scan()
+				if tok != fflib.FFTok_colon {
+					return fs.WrapErr(fmt.Errorf("wanted colon token, but got token: %v", tok))
+				}
+
+				tok = fs.Scan()
+				/* handler: tmpJSecuritySchemes type=interface {} kind=interface quoted=false*/
+
+				{
+					/* Falling back. type=interface {} kind=interface */
+					tbuf, err := fs.CaptureField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+
+					err = json.Unmarshal(tbuf, &tmpJSecuritySchemes)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+				}
+
+				j.SecuritySchemes[k] = tmpJSecuritySchemes
+
+				wantVal = false
+			}
 
 		}
 	}
@@ -553,25 +585,104 @@ handle_URL:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Email:
+handle_Schemas:
 
-	/* handler: j.Email type=string kind=string quoted=false*/
+	/* handler: j.Schemas type=openapi.Schemas kind=map quoted=false*/
 
 	{
 
 		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			if tok != fflib.FFTok_left_bracket && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for Schemas", tok))
 			}
 		}
 
 		if tok == fflib.FFTok_null {
-
+			j.Schemas = nil
 		} else {
 
-			outBuf := fs.Output.Bytes()
+			j.Schemas = make(map[string]*Schema, 0)
 
-			j.Email = string(string(outBuf))
+			wantVal := true
+
+			for {
+
+				var k string
+
+				var tmpJSchemas *Schema
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_bracket {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: k type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						k = string(string(outBuf))
+
+					}
+				}
+
+				// Expect ':' after key
+				tok = fs.Scan()
+				if tok != fflib.FFTok_colon {
+					return fs.WrapErr(fmt.Errorf("wanted colon token, but got token: %v", tok))
+				}
+
+				tok = fs.Scan()
+				/* handler: tmpJSchemas type=*openapi.Schema kind=ptr quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+						tmpJSchemas = nil
+
+					} else {
+
+						if tmpJSchemas == nil {
+							tmpJSchemas = new(Schema)
+						}
+
+						err = tmpJSchemas.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						if err != nil {
+							return err
+						}
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				j.Schemas[k] = tmpJSchemas
+
+				wantVal = false
+			}
 
 		}
 	}
@@ -598,7 +709,7 @@ done:
 }
 
 // MarshalJSON marshal bytes to json - template
-func (j *ExternalDocs) MarshalJSON() ([]byte, error) {
+func (j *Contact) MarshalJSON() ([]byte, error) {
 	var buf fflib.Buffer
 	if j == nil {
 		buf.WriteString("null")
@@ -612,7 +723,7 @@ func (j *ExternalDocs) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *ExternalDocs) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *Contact) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -622,9 +733,9 @@ func (j *ExternalDocs) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	_ = obj
 	_ = err
 	buf.WriteString(`{ `)
-	if len(j.Description) != 0 {
-		buf.WriteString(`"description":`)
-		fflib.WriteJsonString(buf, string(j.Description))
+	if len(j.Name) != 0 {
+		buf.WriteString(`"name":`)
+		fflib.WriteJsonString(buf, string(j.Name))
 		buf.WriteByte(',')
 	}
 	if len(j.URL) != 0 {
@@ -632,34 +743,43 @@ func (j *ExternalDocs) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 		fflib.WriteJsonString(buf, string(j.URL))
 		buf.WriteByte(',')
 	}
+	if len(j.Email) != 0 {
+		buf.WriteString(`"email":`)
+		fflib.WriteJsonString(buf, string(j.Email))
+		buf.WriteByte(',')
+	}
 	buf.Rewind(1)
 	buf.WriteByte('}')
 	return nil
 }
 
 const (
-	ffjtExternalDocsbase = iota
-	ffjtExternalDocsnosuchkey
+	ffjtContactbase = iota
+	ffjtContactnosuchkey
 
-	ffjtExternalDocsDescription
+	ffjtContactName
 
-	ffjtExternalDocsURL
+	ffjtContactURL
+
+	ffjtContactEmail
 )
 
-var ffjKeyExternalDocsDescription = []byte("description")
+var ffjKeyContactName = []byte("name")
 
-var ffjKeyExternalDocsURL = []byte("url")
+var ffjKeyContactURL = []byte("url")
+
+var ffjKeyContactEmail = []byte("email")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *ExternalDocs) UnmarshalJSON(input []byte) error {
+func (j *Contact) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *ExternalDocs) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *Contact) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtExternalDocsbase
+	currentKey := ffjtContactbase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -705,43 +825,57 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtExternalDocsnosuchkey
+				currentKey = ffjtContactnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
 				switch kn[0] {
 
-				case 'd':
+				case 'e':
 
-					if bytes.Equal(ffjKeyExternalDocsDescription, kn) {
-						currentKey = ffjtExternalDocsDescription
+					if bytes.Equal(ffjKeyContactEmail, kn) {
+						currentKey = ffjtContactEmail
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'n':
+
+					if bytes.Equal(ffjKeyContactName, kn) {
+						currentKey = ffjtContactName
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				case 'u':
 
-					if bytes.Equal(ffjKeyExternalDocsURL, kn) {
-						currentKey = ffjtExternalDocsURL
+					if bytes.Equal(ffjKeyContactURL, kn) {
+						currentKey = ffjtContactURL
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyExternalDocsURL, kn) {
-					currentKey = ffjtExternalDocsURL
+				if fflib.SimpleLetterEqualFold(ffjKeyContactEmail, kn) {
+					currentKey = ffjtContactEmail
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeyExternalDocsDescription, kn) {
-					currentKey = ffjtExternalDocsDescription
+				if fflib.SimpleLetterEqualFold(ffjKeyContactURL, kn) {
+					currentKey = ffjtContactURL
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtExternalDocsnosuchkey
+				if fflib.SimpleLetterEqualFold(ffjKeyContactName, kn) {
+					currentKey = ffjtContactName
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtContactnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			}
@@ -758,13 +892,16 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
-				case ffjtExternalDocsDescription:
-					goto handle_Description
+				case ffjtContactName:
+					goto handle_Name
 
-				case ffjtExternalDocsURL:
+				case ffjtContactURL:
 					goto handle_URL
 
-				case ffjtExternalDocsnosuchkey:
+				case ffjtContactEmail:
+					goto handle_Email
+
+				case ffjtContactnosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -778,9 +915,9 @@ mainparse:
 		}
 	}
 
-handle_Description:
+handle_Name:
 
-	/* handler: j.Description type=string kind=string quoted=false*/
+	/* handler: j.Name type=string kind=string quoted=false*/
 
 	{
 
@@ -796,7 +933,7 @@ handle_Description:
 
 			outBuf := fs.Output.Bytes()
 
-			j.Description = string(string(outBuf))
+			j.Name = string(string(outBuf))
 
 		}
 	}
@@ -830,7 +967,33 @@ handle_URL:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-wantedvalue:
+handle_Email:
+
+	/* handler: j.Email type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Email = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
 	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
 wrongtokenerror:
 	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
@@ -849,7 +1012,7 @@ done:
 }
 
 // MarshalJSON marshal bytes to json - template
-func (j *Info) MarshalJSON() ([]byte, error) {
+func (j *ExternalDocs) MarshalJSON() ([]byte, error) {
 	var buf fflib.Buffer
 	if j == nil {
 		buf.WriteString("null")
@@ -863,7 +1026,7 @@ func (j *Info) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *Info) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *ExternalDocs) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -873,54 +1036,14 @@ func (j *Info) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	_ = obj
 	_ = err
 	buf.WriteString(`{ `)
-	if len(j.Title) != 0 {
-		buf.WriteString(`"title":`)
-		fflib.WriteJsonString(buf, string(j.Title))
-		buf.WriteByte(',')
-	}
 	if len(j.Description) != 0 {
 		buf.WriteString(`"description":`)
 		fflib.WriteJsonString(buf, string(j.Description))
 		buf.WriteByte(',')
 	}
-	if len(j.TermsOfService) != 0 {
-		buf.WriteString(`"termsOfService":`)
-		fflib.WriteJsonString(buf, string(j.TermsOfService))
-		buf.WriteByte(',')
-	}
-	if j.Contact != nil {
-		if true {
-			buf.WriteString(`"contact":`)
-
-			{
-
-				err = j.Contact.MarshalJSONBuf(buf)
-				if err != nil {
-					return err
-				}
-
-			}
-			buf.WriteByte(',')
-		}
-	}
-	if j.License != nil {
-		if true {
-			buf.WriteString(`"license":`)
-
-			{
-
-				err = j.License.MarshalJSONBuf(buf)
-				if err != nil {
-					return err
-				}
-
-			}
-			buf.WriteByte(',')
-		}
-	}
-	if len(j.Version) != 0 {
-		buf.WriteString(`"version":`)
-		fflib.WriteJsonString(buf, string(j.Version))
+	if len(j.URL) != 0 {
+		buf.WriteString(`"url":`)
+		fflib.WriteJsonString(buf, string(j.URL))
 		buf.WriteByte(',')
 	}
 	buf.Rewind(1)
@@ -929,44 +1052,28 @@ func (j *Info) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 }
 
 const (
-	ffjtInfobase = iota
-	ffjtInfonosuchkey
-
-	ffjtInfoTitle
-
-	ffjtInfoDescription
-
-	ffjtInfoTermsOfService
-
-	ffjtInfoContact
+	ffjtExternalDocsbase = iota
+	ffjtExternalDocsnosuchkey
 
-	ffjtInfoLicense
+	ffjtExternalDocsDescription
 
-	ffjtInfoVersion
+	ffjtExternalDocsURL
 )
 
-var ffjKeyInfoTitle = []byte("title")
-
-var ffjKeyInfoDescription = []byte("description")
-
-var ffjKeyInfoTermsOfService = []byte("termsOfService")
-
-var ffjKeyInfoContact = []byte("contact")
-
-var ffjKeyInfoLicense = []byte("license")
+var ffjKeyExternalDocsDescription = []byte("description")
 
-var ffjKeyInfoVersion = []byte("version")
+var ffjKeyExternalDocsURL = []byte("url")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *Info) UnmarshalJSON(input []byte) error {
+func (j *ExternalDocs) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *Info) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *ExternalDocs) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtInfobase
+	currentKey := ffjtExternalDocsbase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -1012,96 +1119,43 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtInfonosuchkey
+				currentKey = ffjtExternalDocsnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
 				switch kn[0] {
 
-				case 'c':
-
-					if bytes.Equal(ffjKeyInfoContact, kn) {
-						currentKey = ffjtInfoContact
-						state = fflib.FFParse_want_colon
-						goto mainparse
-					}
-
 				case 'd':
 
-					if bytes.Equal(ffjKeyInfoDescription, kn) {
-						currentKey = ffjtInfoDescription
-						state = fflib.FFParse_want_colon
-						goto mainparse
-					}
-
-				case 'l':
-
-					if bytes.Equal(ffjKeyInfoLicense, kn) {
-						currentKey = ffjtInfoLicense
-						state = fflib.FFParse_want_colon
-						goto mainparse
-					}
-
-				case 't':
-
-					if bytes.Equal(ffjKeyInfoTitle, kn) {
-						currentKey = ffjtInfoTitle
-						state = fflib.FFParse_want_colon
-						goto mainparse
-
-					} else if bytes.Equal(ffjKeyInfoTermsOfService, kn) {
-						currentKey = ffjtInfoTermsOfService
+					if bytes.Equal(ffjKeyExternalDocsDescription, kn) {
+						currentKey = ffjtExternalDocsDescription
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 'v':
+				case 'u':
 
-					if bytes.Equal(ffjKeyInfoVersion, kn) {
-						currentKey = ffjtInfoVersion
+					if bytes.Equal(ffjKeyExternalDocsURL, kn) {
+						currentKey = ffjtExternalDocsURL
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				}
 
-				if fflib.EqualFoldRight(ffjKeyInfoVersion, kn) {
-					currentKey = ffjtInfoVersion
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
-
-				if fflib.EqualFoldRight(ffjKeyInfoLicense, kn) {
-					currentKey = ffjtInfoLicense
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
-
-				if fflib.SimpleLetterEqualFold(ffjKeyInfoContact, kn) {
-					currentKey = ffjtInfoContact
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
-
-				if fflib.EqualFoldRight(ffjKeyInfoTermsOfService, kn) {
-					currentKey = ffjtInfoTermsOfService
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
-
-				if fflib.EqualFoldRight(ffjKeyInfoDescription, kn) {
-					currentKey = ffjtInfoDescription
+				if fflib.SimpleLetterEqualFold(ffjKeyExternalDocsURL, kn) {
+					currentKey = ffjtExternalDocsURL
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyInfoTitle, kn) {
-					currentKey = ffjtInfoTitle
+				if fflib.EqualFoldRight(ffjKeyExternalDocsDescription, kn) {
+					currentKey = ffjtExternalDocsDescription
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtInfonosuchkey
+				currentKey = ffjtExternalDocsnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			}
@@ -1118,25 +1172,13 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
-				case ffjtInfoTitle:
-					goto handle_Title
-
-				case ffjtInfoDescription:
+				case ffjtExternalDocsDescription:
 					goto handle_Description
 
-				case ffjtInfoTermsOfService:
-					goto handle_TermsOfService
-
-				case ffjtInfoContact:
-					goto handle_Contact
-
-				case ffjtInfoLicense:
-					goto handle_License
-
-				case ffjtInfoVersion:
-					goto handle_Version
+				case ffjtExternalDocsURL:
+					goto handle_URL
 
-				case ffjtInfonosuchkey:
+				case ffjtExternalDocsnosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -1150,32 +1192,6 @@ mainparse:
 		}
 	}
 
-handle_Title:
-
-	/* handler: j.Title type=string kind=string quoted=false*/
-
-	{
-
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
-		}
-
-		if tok == fflib.FFTok_null {
-
-		} else {
-
-			outBuf := fs.Output.Bytes()
-
-			j.Title = string(string(outBuf))
-
-		}
-	}
-
-	state = fflib.FFParse_after_value
-	goto mainparse
-
 handle_Description:
 
 	/* handler: j.Description type=string kind=string quoted=false*/
@@ -1202,9 +1218,9 @@ handle_Description:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_TermsOfService:
+handle_URL:
 
-	/* handler: j.TermsOfService type=string kind=string quoted=false*/
+	/* handler: j.URL type=string kind=string quoted=false*/
 
 	{
 
@@ -1220,7 +1236,7 @@ handle_TermsOfService:
 
 			outBuf := fs.Output.Bytes()
 
-			j.TermsOfService = string(string(outBuf))
+			j.URL = string(string(outBuf))
 
 		}
 	}
@@ -1228,104 +1244,26 @@ handle_TermsOfService:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Contact:
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
 
-	/* handler: j.Contact type=openapi.Contact kind=struct quoted=false*/
-
-	{
-		if tok == fflib.FFTok_null {
-
-			j.Contact = nil
-
-		} else {
-
-			if j.Contact == nil {
-				j.Contact = new(Contact)
-			}
-
-			err = j.Contact.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
-			if err != nil {
-				return err
-			}
-		}
-		state = fflib.FFParse_after_value
-	}
-
-	state = fflib.FFParse_after_value
-	goto mainparse
-
-handle_License:
-
-	/* handler: j.License type=openapi.License kind=struct quoted=false*/
-
-	{
-		if tok == fflib.FFTok_null {
-
-			j.License = nil
-
-		} else {
-
-			if j.License == nil {
-				j.License = new(License)
-			}
-
-			err = j.License.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
-			if err != nil {
-				return err
-			}
-		}
-		state = fflib.FFParse_after_value
-	}
-
-	state = fflib.FFParse_after_value
-	goto mainparse
-
-handle_Version:
-
-	/* handler: j.Version type=string kind=string quoted=false*/
-
-	{
-
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
-		}
-
-		if tok == fflib.FFTok_null {
-
-		} else {
-
-			outBuf := fs.Output.Bytes()
-
-			j.Version = string(string(outBuf))
-
-		}
-	}
-
-	state = fflib.FFParse_after_value
-	goto mainparse
-
-wantedvalue:
-	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
-wrongtokenerror:
-	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
-tokerror:
-	if fs.BigError != nil {
-		return fs.WrapErr(fs.BigError)
-	}
-	err = fs.Error.ToError()
-	if err != nil {
-		return fs.WrapErr(err)
-	}
-	panic("ffjson-generated: unreachable, please report bug.")
-done:
-
-	return nil
-}
+	return nil
+}
 
 // MarshalJSON marshal bytes to json - template
-func (j *License) MarshalJSON() ([]byte, error) {
+func (j *Info) MarshalJSON() ([]byte, error) {
 	var buf fflib.Buffer
 	if j == nil {
 		buf.WriteString("null")
@@ -1339,7 +1277,7 @@ func (j *License) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *License) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *Info) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -1349,14 +1287,54 @@ func (j *License) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	_ = obj
 	_ = err
 	buf.WriteString(`{ `)
-	if len(j.Name) != 0 {
-		buf.WriteString(`"name":`)
-		fflib.WriteJsonString(buf, string(j.Name))
+	if len(j.Title) != 0 {
+		buf.WriteString(`"title":`)
+		fflib.WriteJsonString(buf, string(j.Title))
 		buf.WriteByte(',')
 	}
-	if len(j.URL) != 0 {
-		buf.WriteString(`"url":`)
-		fflib.WriteJsonString(buf, string(j.URL))
+	if len(j.Description) != 0 {
+		buf.WriteString(`"description":`)
+		fflib.WriteJsonString(buf, string(j.Description))
+		buf.WriteByte(',')
+	}
+	if len(j.TermsOfService) != 0 {
+		buf.WriteString(`"termsOfService":`)
+		fflib.WriteJsonString(buf, string(j.TermsOfService))
+		buf.WriteByte(',')
+	}
+	if j.Contact != nil {
+		if true {
+			buf.WriteString(`"contact":`)
+
+			{
+
+				err = j.Contact.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if j.License != nil {
+		if true {
+			buf.WriteString(`"license":`)
+
+			{
+
+				err = j.License.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if len(j.Version) != 0 {
+		buf.WriteString(`"version":`)
+		fflib.WriteJsonString(buf, string(j.Version))
 		buf.WriteByte(',')
 	}
 	buf.Rewind(1)
@@ -1365,28 +1343,44 @@ func (j *License) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 }
 
 const (
-	ffjtLicensebase = iota
-	ffjtLicensenosuchkey
+	ffjtInfobase = iota
+	ffjtInfonosuchkey
 
-	ffjtLicenseName
+	ffjtInfoTitle
 
-	ffjtLicenseURL
+	ffjtInfoDescription
+
+	ffjtInfoTermsOfService
+
+	ffjtInfoContact
+
+	ffjtInfoLicense
+
+	ffjtInfoVersion
 )
 
-var ffjKeyLicenseName = []byte("name")
+var ffjKeyInfoTitle = []byte("title")
 
-var ffjKeyLicenseURL = []byte("url")
+var ffjKeyInfoDescription = []byte("description")
+
+var ffjKeyInfoTermsOfService = []byte("termsOfService")
+
+var ffjKeyInfoContact = []byte("contact")
+
+var ffjKeyInfoLicense = []byte("license")
+
+var ffjKeyInfoVersion = []byte("version")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *License) UnmarshalJSON(input []byte) error {
+func (j *Info) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *License) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *Info) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtLicensebase
+	currentKey := ffjtInfobase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -1432,66 +1426,131 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtLicensenosuchkey
+				currentKey = ffjtInfonosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
 				switch kn[0] {
 
-				case 'n':
+				case 'c':
 
-					if bytes.Equal(ffjKeyLicenseName, kn) {
-						currentKey = ffjtLicenseName
+					if bytes.Equal(ffjKeyInfoContact, kn) {
+						currentKey = ffjtInfoContact
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 'u':
+				case 'd':
 
-					if bytes.Equal(ffjKeyLicenseURL, kn) {
-						currentKey = ffjtLicenseURL
+					if bytes.Equal(ffjKeyInfoDescription, kn) {
+						currentKey = ffjtInfoDescription
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'l':
+
+					if bytes.Equal(ffjKeyInfoLicense, kn) {
+						currentKey = ffjtInfoLicense
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffjKeyInfoTitle, kn) {
+						currentKey = ffjtInfoTitle
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyInfoTermsOfService, kn) {
+						currentKey = ffjtInfoTermsOfService
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'v':
+
+					if bytes.Equal(ffjKeyInfoVersion, kn) {
+						currentKey = ffjtInfoVersion
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyLicenseURL, kn) {
-					currentKey = ffjtLicenseURL
+				if fflib.EqualFoldRight(ffjKeyInfoVersion, kn) {
+					currentKey = ffjtInfoVersion
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyLicenseName, kn) {
-					currentKey = ffjtLicenseName
+				if fflib.EqualFoldRight(ffjKeyInfoLicense, kn) {
+					currentKey = ffjtInfoLicense
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtLicensenosuchkey
-				state = fflib.FFParse_want_colon
-				goto mainparse
-			}
+				if fflib.SimpleLetterEqualFold(ffjKeyInfoContact, kn) {
+					currentKey = ffjtInfoContact
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
 
-		case fflib.FFParse_want_colon:
-			if tok != fflib.FFTok_colon {
-				wantedTok = fflib.FFTok_colon
-				goto wrongtokenerror
-			}
-			state = fflib.FFParse_want_value
-			continue
-		case fflib.FFParse_want_value:
+				if fflib.EqualFoldRight(ffjKeyInfoTermsOfService, kn) {
+					currentKey = ffjtInfoTermsOfService
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
 
-			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				if fflib.EqualFoldRight(ffjKeyInfoDescription, kn) {
+					currentKey = ffjtInfoDescription
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyInfoTitle, kn) {
+					currentKey = ffjtInfoTitle
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtInfonosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
-				case ffjtLicenseName:
-					goto handle_Name
+				case ffjtInfoTitle:
+					goto handle_Title
 
-				case ffjtLicenseURL:
-					goto handle_URL
+				case ffjtInfoDescription:
+					goto handle_Description
 
-				case ffjtLicensenosuchkey:
+				case ffjtInfoTermsOfService:
+					goto handle_TermsOfService
+
+				case ffjtInfoContact:
+					goto handle_Contact
+
+				case ffjtInfoLicense:
+					goto handle_License
+
+				case ffjtInfoVersion:
+					goto handle_Version
+
+				case ffjtInfonosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -1505,9 +1564,9 @@ mainparse:
 		}
 	}
 
-handle_Name:
+handle_Title:
 
-	/* handler: j.Name type=string kind=string quoted=false*/
+	/* handler: j.Title type=string kind=string quoted=false*/
 
 	{
 
@@ -1523,7 +1582,7 @@ handle_Name:
 
 			outBuf := fs.Output.Bytes()
 
-			j.Name = string(string(outBuf))
+			j.Title = string(string(outBuf))
 
 		}
 	}
@@ -1531,9 +1590,9 @@ handle_Name:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_URL:
+handle_Description:
 
-	/* handler: j.URL type=string kind=string quoted=false*/
+	/* handler: j.Description type=string kind=string quoted=false*/
 
 	{
 
@@ -1549,7 +1608,111 @@ handle_URL:
 
 			outBuf := fs.Output.Bytes()
 
-			j.URL = string(string(outBuf))
+			j.Description = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_TermsOfService:
+
+	/* handler: j.TermsOfService type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.TermsOfService = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Contact:
+
+	/* handler: j.Contact type=openapi.Contact kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.Contact = nil
+
+		} else {
+
+			if j.Contact == nil {
+				j.Contact = new(Contact)
+			}
+
+			err = j.Contact.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_License:
+
+	/* handler: j.License type=openapi.License kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.License = nil
+
+		} else {
+
+			if j.License == nil {
+				j.License = new(License)
+			}
+
+			err = j.License.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Version:
+
+	/* handler: j.Version type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Version = string(string(outBuf))
 
 		}
 	}
@@ -1576,7 +1739,7 @@ done:
 }
 
 // MarshalJSON marshal bytes to json - template
-func (j *Media) MarshalJSON() ([]byte, error) {
+func (j *License) MarshalJSON() ([]byte, error) {
 	var buf fflib.Buffer
 	if j == nil {
 		buf.WriteString("null")
@@ -1590,7 +1753,7 @@ func (j *Media) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *Media) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *License) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -1600,20 +1763,15 @@ func (j *Media) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	_ = obj
 	_ = err
 	buf.WriteString(`{ `)
-	if j.Schema != nil {
-		if true {
-			buf.WriteString(`"schema":`)
-
-			{
-
-				err = j.Schema.MarshalJSONBuf(buf)
-				if err != nil {
-					return err
-				}
-
-			}
-			buf.WriteByte(',')
-		}
+	if len(j.Name) != 0 {
+		buf.WriteString(`"name":`)
+		fflib.WriteJsonString(buf, string(j.Name))
+		buf.WriteByte(',')
+	}
+	if len(j.URL) != 0 {
+		buf.WriteString(`"url":`)
+		fflib.WriteJsonString(buf, string(j.URL))
+		buf.WriteByte(',')
 	}
 	buf.Rewind(1)
 	buf.WriteByte('}')
@@ -1621,24 +1779,28 @@ func (j *Media) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 }
 
 const (
-	ffjtMediabase = iota
-	ffjtMedianosuchkey
+	ffjtLicensebase = iota
+	ffjtLicensenosuchkey
 
-	ffjtMediaSchema
+	ffjtLicenseName
+
+	ffjtLicenseURL
 )
 
-var ffjKeyMediaSchema = []byte("schema")
+var ffjKeyLicenseName = []byte("name")
+
+var ffjKeyLicenseURL = []byte("url")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *Media) UnmarshalJSON(input []byte) error {
+func (j *License) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *Media) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *License) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtMediabase
+	currentKey := ffjtLicensebase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -1684,29 +1846,43 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtMedianosuchkey
+				currentKey = ffjtLicensenosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
 				switch kn[0] {
 
-				case 's':
+				case 'n':
 
-					if bytes.Equal(ffjKeyMediaSchema, kn) {
-						currentKey = ffjtMediaSchema
+					if bytes.Equal(ffjKeyLicenseName, kn) {
+						currentKey = ffjtLicenseName
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'u':
+
+					if bytes.Equal(ffjKeyLicenseURL, kn) {
+						currentKey = ffjtLicenseURL
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				}
 
-				if fflib.EqualFoldRight(ffjKeyMediaSchema, kn) {
-					currentKey = ffjtMediaSchema
+				if fflib.SimpleLetterEqualFold(ffjKeyLicenseURL, kn) {
+					currentKey = ffjtLicenseURL
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtMedianosuchkey
+				if fflib.SimpleLetterEqualFold(ffjKeyLicenseName, kn) {
+					currentKey = ffjtLicenseName
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtLicensenosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			}
@@ -1723,10 +1899,13 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
-				case ffjtMediaSchema:
-					goto handle_Schema
+				case ffjtLicenseName:
+					goto handle_Name
 
-				case ffjtMedianosuchkey:
+				case ffjtLicenseURL:
+					goto handle_URL
+
+				case ffjtLicensenosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -1740,16 +1919,251 @@ mainparse:
 		}
 	}
 
-handle_Schema:
+handle_Name:
 
-	/* handler: j.Schema type=openapi.Schema kind=struct quoted=false*/
+	/* handler: j.Name type=string kind=string quoted=false*/
 
 	{
-		if tok == fflib.FFTok_null {
-
-			j.Schema = nil
-
-		} else {
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Name = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_URL:
+
+	/* handler: j.URL type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.URL = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *Media) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *Media) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ `)
+	if j.Schema != nil {
+		if true {
+			buf.WriteString(`"schema":`)
+
+			{
+
+				err = j.Schema.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtMediabase = iota
+	ffjtMedianosuchkey
+
+	ffjtMediaSchema
+)
+
+var ffjKeyMediaSchema = []byte("schema")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *Media) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *Media) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtMediabase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtMedianosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 's':
+
+					if bytes.Equal(ffjKeyMediaSchema, kn) {
+						currentKey = ffjtMediaSchema
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.EqualFoldRight(ffjKeyMediaSchema, kn) {
+					currentKey = ffjtMediaSchema
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtMedianosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtMediaSchema:
+					goto handle_Schema
+
+				case ffjtMedianosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Schema:
+
+	/* handler: j.Schema type=openapi.Schema kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.Schema = nil
+
+		} else {
 
 			if j.Schema == nil {
 				j.Schema = new(Schema)
@@ -1897,6 +2311,15 @@ func (j *OpenAPI) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 		}
 		buf.WriteByte(',')
 	}
+	if len(j.Webhooks) != 0 {
+		buf.WriteString(`"webhooks":`)
+		/* Falling back. type=map[string]*openapi.Path kind=map */
+		err = buf.Encode(j.Webhooks)
+		if err != nil {
+			return err
+		}
+		buf.WriteByte(',')
+	}
 	if true {
 		buf.WriteString(`"components":`)
 
@@ -1931,6 +2354,8 @@ const (
 
 	ffjtOpenAPIPaths
 
+	ffjtOpenAPIWebhooks
+
 	ffjtOpenAPIComponents
 )
 
@@ -1946,6 +2371,8 @@ var ffjKeyOpenAPISchemes = []byte("schemes")
 
 var ffjKeyOpenAPIPaths = []byte("paths")
 
+var ffjKeyOpenAPIWebhooks = []byte("webhooks")
+
 var ffjKeyOpenAPIComponents = []byte("components")
 
 // UnmarshalJSON umarshall json - template of ffjson
@@ -2062,6 +2489,14 @@ mainparse:
 						goto mainparse
 					}
 
+				case 'w':
+
+					if bytes.Equal(ffjKeyOpenAPIWebhooks, kn) {
+						currentKey = ffjtOpenAPIWebhooks
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
 				}
 
 				if fflib.EqualFoldRight(ffjKeyOpenAPIComponents, kn) {
@@ -2070,13 +2505,19 @@ mainparse:
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeyOpenAPIPaths, kn) {
-					currentKey = ffjtOpenAPIPaths
+				if fflib.EqualFoldRight(ffjKeyOpenAPIWebhooks, kn) {
+					currentKey = ffjtOpenAPIWebhooks
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeyOpenAPISchemes, kn) {
+				if fflib.EqualFoldRight(ffjKeyOpenAPIPaths, kn) {
+					currentKey = ffjtOpenAPIPaths
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyOpenAPISchemes, kn) {
 					currentKey = ffjtOpenAPISchemes
 					state = fflib.FFParse_want_colon
 					goto mainparse
@@ -2141,6 +2582,9 @@ mainparse:
 				case ffjtOpenAPIPaths:
 					goto handle_Paths
 
+				case ffjtOpenAPIWebhooks:
+					goto handle_Webhooks
+
 				case ffjtOpenAPIComponents:
 					goto handle_Components
 
@@ -2186,7 +2630,7 @@ handle_OpenAPI:
 
 handle_Info:
 
-	/* handler: j.info type=openapi.info kind=struct quoted=false*/
+	/* handler: j.Info type=openapi.Info kind=struct quoted=false*/
 
 	{
 		if tok == fflib.FFTok_null {
@@ -2206,7 +2650,7 @@ handle_Info:
 
 handle_Servers:
 
-	/* handler: j.servers type=[]openapi.Server kind=slice quoted=false*/
+	/* handler: j.Servers type=[]openapi.Server kind=slice quoted=false*/
 
 	{
 
@@ -2519,6 +2963,111 @@ handle_Paths:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
+handle_Webhooks:
+
+	/* handler: j.Webhooks type=map[string]*openapi.Path kind=map quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_bracket && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.Webhooks = nil
+		} else {
+
+			j.Webhooks = make(map[string]*Path, 0)
+
+			wantVal := true
+
+			for {
+
+				var k string
+
+				var tmpJWebhooks *Path
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_bracket {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: k type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						k = string(string(outBuf))
+
+					}
+				}
+
+				// Expect ':' after key
+				tok = fs.Scan()
+				if tok != fflib.FFTok_colon {
+					return fs.WrapErr(fmt.Errorf("wanted colon token, but got token: %v", tok))
+				}
+
+				tok = fs.Scan()
+				/* handler: tmpJWebhooks type=*openapi.Path kind=ptr quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+						tmpJWebhooks = nil
+
+					} else {
+
+						if tmpJWebhooks == nil {
+							tmpJWebhooks = new(Path)
+						}
+
+						err = tmpJWebhooks.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						if err != nil {
+							return err
+						}
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				j.Webhooks[k] = tmpJWebhooks
+
+				wantVal = false
+			}
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
 handle_Components:
 
 	/* handler: j.Components type=openapi.Components kind=struct quoted=false*/
@@ -2581,7 +3130,7 @@ func (j *Operation) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	var obj []byte
 	_ = obj
 	_ = err
-	buf.WriteString(`{ `)
+	buf.WriteByte('{')
 	if len(j.Tags) != 0 {
 		buf.WriteString(`"tags":`)
 		if j.Tags != nil {
@@ -2693,7 +3242,31 @@ func (j *Operation) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 		}
 		buf.WriteByte(',')
 	}
-	buf.Rewind(1)
+	if j.Deprecated != false {
+		if j.Deprecated {
+			buf.WriteString(`"deprecated":true`)
+		} else {
+			buf.WriteString(`"deprecated":false`)
+		}
+		buf.WriteByte(',')
+	}
+	buf.WriteString(`"security":`)
+	if j.Security != nil {
+		buf.WriteString(`[`)
+		for i, v := range j.Security {
+			if i != 0 {
+				buf.WriteString(`,`)
+			}
+			/* Falling back. type=map[string][]interface {} kind=map */
+			err = buf.Encode(v)
+			if err != nil {
+				return err
+			}
+		}
+		buf.WriteString(`]`)
+	} else {
+		buf.WriteString(`null`)
+	}
 	buf.WriteByte('}')
 	return nil
 }
@@ -2719,6 +3292,10 @@ const (
 	ffjtOperationRequestBody
 
 	ffjtOperationResponses
+
+	ffjtOperationDeprecated
+
+	ffjtOperationSecurity
 )
 
 var ffjKeyOperationTags = []byte("tags")
@@ -2739,6 +3316,10 @@ var ffjKeyOperationRequestBody = []byte("requestBody")
 
 var ffjKeyOperationResponses = []byte("responses")
 
+var ffjKeyOperationDeprecated = []byte("deprecated")
+
+var ffjKeyOperationSecurity = []byte("security")
+
 // UnmarshalJSON umarshall json - template of ffjson
 func (j *Operation) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
@@ -2814,6 +3395,11 @@ mainparse:
 						currentKey = ffjtOperationDescription
 						state = fflib.FFParse_want_colon
 						goto mainparse
+
+					} else if bytes.Equal(ffjKeyOperationDeprecated, kn) {
+						currentKey = ffjtOperationDeprecated
+						state = fflib.FFParse_want_colon
+						goto mainparse
 					}
 
 				case 'o':
@@ -2856,6 +3442,11 @@ mainparse:
 						currentKey = ffjtOperationSummary
 						state = fflib.FFParse_want_colon
 						goto mainparse
+
+					} else if bytes.Equal(ffjKeyOperationSecurity, kn) {
+						currentKey = ffjtOperationSecurity
+						state = fflib.FFParse_want_colon
+						goto mainparse
 					}
 
 				case 't':
@@ -2868,6 +3459,18 @@ mainparse:
 
 				}
 
+				if fflib.EqualFoldRight(ffjKeyOperationSecurity, kn) {
+					currentKey = ffjtOperationSecurity
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyOperationDeprecated, kn) {
+					currentKey = ffjtOperationDeprecated
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
 				if fflib.EqualFoldRight(ffjKeyOperationResponses, kn) {
 					currentKey = ffjtOperationResponses
 					state = fflib.FFParse_want_colon
@@ -2966,6 +3569,12 @@ mainparse:
 				case ffjtOperationResponses:
 					goto handle_Responses
 
+				case ffjtOperationDeprecated:
+					goto handle_Deprecated
+
+				case ffjtOperationSecurity:
+					goto handle_Security
+
 				case ffjtOperationnosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
@@ -3447,13 +4056,19 @@ handle_Responses:
 				}
 
 				tok = fs.Scan()
-				/* handler: tmpJResponses type=openapi.Response kind=struct quoted=false*/
+				/* handler: tmpJResponses type=*openapi.Response kind=ptr quoted=false*/
 
 				{
 					if tok == fflib.FFTok_null {
 
+						tmpJResponses = nil
+
 					} else {
 
+						if tmpJResponses == nil {
+							tmpJResponses = new(Response)
+						}
+
 						err = tmpJResponses.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
 						if err != nil {
 							return err
@@ -3473,6 +4088,61 @@ handle_Responses:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
+handle_Deprecated:
+
+	/* handler: j.Deprecated type=bool kind=bool quoted=false*/
+
+	{
+		if tok != fflib.FFTok_bool && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for bool", tok))
+		}
+	}
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+			tmpb := fs.Output.Bytes()
+
+			if bytes.Compare([]byte{'t', 'r', 'u', 'e'}, tmpb) == 0 {
+
+				j.Deprecated = true
+
+			} else if bytes.Compare([]byte{'f', 'a', 'l', 's', 'e'}, tmpb) == 0 {
+
+				j.Deprecated = false
+
+			} else {
+				err = errors.New("unexpected bytes for true/false value")
+				return fs.WrapErr(err)
+			}
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Security:
+
+	/* handler: j.Security type=[]map[string][]interface {} kind=slice quoted=false*/
+
+	{
+		/* Falling back. type=[]map[string][]interface {} kind=slice */
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = json.Unmarshal(tbuf, &j.Security)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
 wantedvalue:
 	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
 wrongtokenerror:
@@ -3544,6 +4214,14 @@ func (j *Parameter) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 		}
 		buf.WriteByte(',')
 	}
+	if j.Deprecated != false {
+		if j.Deprecated {
+			buf.WriteString(`"deprecated":true`)
+		} else {
+			buf.WriteString(`"deprecated":false`)
+		}
+		buf.WriteByte(',')
+	}
 	if j.Schema != nil {
 		if true {
 			buf.WriteString(`"schema":`)
@@ -3578,6 +4256,8 @@ const (
 
 	ffjtParameterRequired
 
+	ffjtParameterDeprecated
+
 	ffjtParameterSchema
 )
 
@@ -3591,6 +4271,8 @@ var ffjKeyParameterDescription = []byte("description")
 
 var ffjKeyParameterRequired = []byte("required")
 
+var ffjKeyParameterDeprecated = []byte("deprecated")
+
 var ffjKeyParameterSchema = []byte("schema")
 
 // UnmarshalJSON umarshall json - template of ffjson
@@ -3668,6 +4350,11 @@ mainparse:
 						currentKey = ffjtParameterDescription
 						state = fflib.FFParse_want_colon
 						goto mainparse
+
+					} else if bytes.Equal(ffjKeyParameterDeprecated, kn) {
+						currentKey = ffjtParameterDeprecated
+						state = fflib.FFParse_want_colon
+						goto mainparse
 					}
 
 				case 'i':
@@ -3710,6 +4397,12 @@ mainparse:
 					goto mainparse
 				}
 
+				if fflib.SimpleLetterEqualFold(ffjKeyParameterDeprecated, kn) {
+					currentKey = ffjtParameterDeprecated
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
 				if fflib.SimpleLetterEqualFold(ffjKeyParameterRequired, kn) {
 					currentKey = ffjtParameterRequired
 					state = fflib.FFParse_want_colon
@@ -3772,6 +4465,9 @@ mainparse:
 				case ffjtParameterRequired:
 					goto handle_Required
 
+				case ffjtParameterDeprecated:
+					goto handle_Deprecated
+
 				case ffjtParameterSchema:
 					goto handle_Schema
 
@@ -3928,6 +4624,41 @@ handle_Required:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
+handle_Deprecated:
+
+	/* handler: j.Deprecated type=bool kind=bool quoted=false*/
+
+	{
+		if tok != fflib.FFTok_bool && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for bool", tok))
+		}
+	}
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+			tmpb := fs.Output.Bytes()
+
+			if bytes.Compare([]byte{'t', 'r', 'u', 'e'}, tmpb) == 0 {
+
+				j.Deprecated = true
+
+			} else if bytes.Compare([]byte{'f', 'a', 'l', 's', 'e'}, tmpb) == 0 {
+
+				j.Deprecated = false
+
+			} else {
+				err = errors.New("unexpected bytes for true/false value")
+				return fs.WrapErr(err)
+			}
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
 handle_Schema:
 
 	/* handler: j.Schema type=openapi.Schema kind=struct quoted=false*/
@@ -5331,9 +6062,21 @@ func (j *Schema) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 		fflib.WriteJsonString(buf, string(j.Ref))
 		buf.WriteByte(',')
 	}
-	if len(j.Type) != 0 {
+	if j.Type != nil {
 		buf.WriteString(`"type":`)
-		fflib.WriteJsonString(buf, string(j.Type))
+		/* Interface types must use runtime reflection. type=interface {} kind=interface */
+		err = buf.Encode(j.Type)
+		if err != nil {
+			return err
+		}
+		buf.WriteByte(',')
+	}
+	if j.Nullable != false {
+		if j.Nullable {
+			buf.WriteString(`"nullable":true`)
+		} else {
+			buf.WriteString(`"nullable":false`)
+		}
 		buf.WriteByte(',')
 	}
 	if len(j.Format) != 0 {
@@ -5350,6 +6093,22 @@ func (j *Schema) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 		}
 		buf.WriteByte(',')
 	}
+	if len(j.Required) != 0 {
+		buf.WriteString(`"required":`)
+		if j.Required != nil {
+			buf.WriteString(`[`)
+			for i, v := range j.Required {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.WriteJsonString(buf, string(v))
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
 	if j.Items != nil {
 		if true {
 			buf.WriteString(`"items":`)
@@ -5389,22 +6148,101 @@ func (j *Schema) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 		}
 		buf.WriteByte(',')
 	}
-	if len(j.Enum) != 0 {
-		buf.WriteString(`"enum":`)
-		if j.Enum != nil {
+	if len(j.OneOf) != 0 {
+		buf.WriteString(`"oneOf":`)
+		if j.OneOf != nil {
 			buf.WriteString(`[`)
-			for i, v := range j.Enum {
+			for i, v := range j.OneOf {
 				if i != 0 {
 					buf.WriteString(`,`)
 				}
-				fflib.WriteJsonString(buf, string(v))
-			}
-			buf.WriteString(`]`)
+
+				{
+
+					err = v.MarshalJSONBuf(buf)
+					if err != nil {
+						return err
+					}
+
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(j.Enum) != 0 {
+		buf.WriteString(`"enum":`)
+		if j.Enum != nil {
+			buf.WriteString(`[`)
+			for i, v := range j.Enum {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				/* Interface types must use runtime reflection. type=interface {} kind=interface */
+				err = buf.Encode(v)
+				if err != nil {
+					return err
+				}
+			}
+			buf.WriteString(`]`)
 		} else {
 			buf.WriteString(`null`)
 		}
 		buf.WriteByte(',')
 	}
+	if j.Const != nil {
+		buf.WriteString(`"const":`)
+		/* Interface types must use runtime reflection. type=interface {} kind=interface */
+		err = buf.Encode(j.Const)
+		if err != nil {
+			return err
+		}
+		buf.WriteByte(',')
+	}
+	if j.MinLength != nil {
+		if true {
+			buf.WriteString(`"minLength":`)
+			fflib.FormatBits2(buf, uint64(*j.MinLength), 10, *j.MinLength < 0)
+			buf.WriteByte(',')
+		}
+	}
+	if j.MaxLength != nil {
+		if true {
+			buf.WriteString(`"maxLength":`)
+			fflib.FormatBits2(buf, uint64(*j.MaxLength), 10, *j.MaxLength < 0)
+			buf.WriteByte(',')
+		}
+	}
+	if j.Minimum != nil {
+		if true {
+			buf.WriteString(`"minimum":`)
+			fflib.AppendFloat(buf, float64(*j.Minimum), 'g', -1, 64)
+			buf.WriteByte(',')
+		}
+	}
+	if j.Maximum != nil {
+		if true {
+			buf.WriteString(`"maximum":`)
+			fflib.AppendFloat(buf, float64(*j.Maximum), 'g', -1, 64)
+			buf.WriteByte(',')
+		}
+	}
+	if j.MinItems != nil {
+		if true {
+			buf.WriteString(`"minItems":`)
+			fflib.FormatBits2(buf, uint64(*j.MinItems), 10, *j.MinItems < 0)
+			buf.WriteByte(',')
+		}
+	}
+	if j.MaxItems != nil {
+		if true {
+			buf.WriteString(`"maxItems":`)
+			fflib.FormatBits2(buf, uint64(*j.MaxItems), 10, *j.MaxItems < 0)
+			buf.WriteByte(',')
+		}
+	}
 	if j.Example != nil {
 		buf.WriteString(`"example":`)
 		/* Interface types must use runtime reflection. type=interface {} kind=interface */
@@ -5414,6 +6252,34 @@ func (j *Schema) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 		}
 		buf.WriteByte(',')
 	}
+	if len(j.Examples) != 0 {
+		buf.WriteString(`"examples":`)
+		if j.Examples != nil {
+			buf.WriteString(`[`)
+			for i, v := range j.Examples {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				/* Interface types must use runtime reflection. type=interface {} kind=interface */
+				err = buf.Encode(v)
+				if err != nil {
+					return err
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if j.Deprecated != false {
+		if j.Deprecated {
+			buf.WriteString(`"deprecated":true`)
+		} else {
+			buf.WriteString(`"deprecated":false`)
+		}
+		buf.WriteByte(',')
+	}
 	buf.Rewind(1)
 	buf.WriteByte('}')
 	return nil
@@ -5429,17 +6295,41 @@ const (
 
 	ffjtSchemaType
 
+	ffjtSchemaNullable
+
 	ffjtSchemaFormat
 
 	ffjtSchemaProperties
 
+	ffjtSchemaRequired
+
 	ffjtSchemaItems
 
 	ffjtSchemaAnyOf
 
+	ffjtSchemaOneOf
+
 	ffjtSchemaEnum
 
+	ffjtSchemaConst
+
+	ffjtSchemaMinLength
+
+	ffjtSchemaMaxLength
+
+	ffjtSchemaMinimum
+
+	ffjtSchemaMaximum
+
+	ffjtSchemaMinItems
+
+	ffjtSchemaMaxItems
+
 	ffjtSchemaExample
+
+	ffjtSchemaExamples
+
+	ffjtSchemaDeprecated
 )
 
 var ffjKeySchemaDescription = []byte("description")
@@ -5448,18 +6338,42 @@ var ffjKeySchemaRef = []byte("$ref")
 
 var ffjKeySchemaType = []byte("type")
 
+var ffjKeySchemaNullable = []byte("nullable")
+
 var ffjKeySchemaFormat = []byte("format")
 
 var ffjKeySchemaProperties = []byte("properties")
 
+var ffjKeySchemaRequired = []byte("required")
+
 var ffjKeySchemaItems = []byte("items")
 
 var ffjKeySchemaAnyOf = []byte("anyOf")
 
+var ffjKeySchemaOneOf = []byte("oneOf")
+
 var ffjKeySchemaEnum = []byte("enum")
 
+var ffjKeySchemaConst = []byte("const")
+
+var ffjKeySchemaMinLength = []byte("minLength")
+
+var ffjKeySchemaMaxLength = []byte("maxLength")
+
+var ffjKeySchemaMinimum = []byte("minimum")
+
+var ffjKeySchemaMaximum = []byte("maximum")
+
+var ffjKeySchemaMinItems = []byte("minItems")
+
+var ffjKeySchemaMaxItems = []byte("maxItems")
+
 var ffjKeySchemaExample = []byte("example")
 
+var ffjKeySchemaExamples = []byte("examples")
+
+var ffjKeySchemaDeprecated = []byte("deprecated")
+
 // UnmarshalJSON umarshall json - template of ffjson
 func (j *Schema) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
@@ -5537,12 +6451,25 @@ mainparse:
 						goto mainparse
 					}
 
+				case 'c':
+
+					if bytes.Equal(ffjKeySchemaConst, kn) {
+						currentKey = ffjtSchemaConst
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
 				case 'd':
 
 					if bytes.Equal(ffjKeySchemaDescription, kn) {
 						currentKey = ffjtSchemaDescription
 						state = fflib.FFParse_want_colon
 						goto mainparse
+
+					} else if bytes.Equal(ffjKeySchemaDeprecated, kn) {
+						currentKey = ffjtSchemaDeprecated
+						state = fflib.FFParse_want_colon
+						goto mainparse
 					}
 
 				case 'e':
@@ -5556,6 +6483,11 @@ mainparse:
 						currentKey = ffjtSchemaExample
 						state = fflib.FFParse_want_colon
 						goto mainparse
+
+					} else if bytes.Equal(ffjKeySchemaExamples, kn) {
+						currentKey = ffjtSchemaExamples
+						state = fflib.FFParse_want_colon
+						goto mainparse
 					}
 
 				case 'f':
@@ -5574,6 +6506,55 @@ mainparse:
 						goto mainparse
 					}
 
+				case 'm':
+
+					if bytes.Equal(ffjKeySchemaMinLength, kn) {
+						currentKey = ffjtSchemaMinLength
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeySchemaMaxLength, kn) {
+						currentKey = ffjtSchemaMaxLength
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeySchemaMinimum, kn) {
+						currentKey = ffjtSchemaMinimum
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeySchemaMaximum, kn) {
+						currentKey = ffjtSchemaMaximum
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeySchemaMinItems, kn) {
+						currentKey = ffjtSchemaMinItems
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeySchemaMaxItems, kn) {
+						currentKey = ffjtSchemaMaxItems
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'n':
+
+					if bytes.Equal(ffjKeySchemaNullable, kn) {
+						currentKey = ffjtSchemaNullable
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'o':
+
+					if bytes.Equal(ffjKeySchemaOneOf, kn) {
+						currentKey = ffjtSchemaOneOf
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
 				case 'p':
 
 					if bytes.Equal(ffjKeySchemaProperties, kn) {
@@ -5582,6 +6563,14 @@ mainparse:
 						goto mainparse
 					}
 
+				case 'r':
+
+					if bytes.Equal(ffjKeySchemaRequired, kn) {
+						currentKey = ffjtSchemaRequired
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
 				case 't':
 
 					if bytes.Equal(ffjKeySchemaType, kn) {
@@ -5592,18 +6581,78 @@ mainparse:
 
 				}
 
+				if fflib.SimpleLetterEqualFold(ffjKeySchemaDeprecated, kn) {
+					currentKey = ffjtSchemaDeprecated
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeySchemaExamples, kn) {
+					currentKey = ffjtSchemaExamples
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
 				if fflib.SimpleLetterEqualFold(ffjKeySchemaExample, kn) {
 					currentKey = ffjtSchemaExample
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
+				if fflib.EqualFoldRight(ffjKeySchemaMaxItems, kn) {
+					currentKey = ffjtSchemaMaxItems
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeySchemaMinItems, kn) {
+					currentKey = ffjtSchemaMinItems
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeySchemaMaximum, kn) {
+					currentKey = ffjtSchemaMaximum
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeySchemaMinimum, kn) {
+					currentKey = ffjtSchemaMinimum
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeySchemaMaxLength, kn) {
+					currentKey = ffjtSchemaMaxLength
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeySchemaMinLength, kn) {
+					currentKey = ffjtSchemaMinLength
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeySchemaConst, kn) {
+					currentKey = ffjtSchemaConst
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
 				if fflib.SimpleLetterEqualFold(ffjKeySchemaEnum, kn) {
 					currentKey = ffjtSchemaEnum
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
+				if fflib.SimpleLetterEqualFold(ffjKeySchemaOneOf, kn) {
+					currentKey = ffjtSchemaOneOf
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
 				if fflib.SimpleLetterEqualFold(ffjKeySchemaAnyOf, kn) {
 					currentKey = ffjtSchemaAnyOf
 					state = fflib.FFParse_want_colon
@@ -5616,6 +6665,12 @@ mainparse:
 					goto mainparse
 				}
 
+				if fflib.SimpleLetterEqualFold(ffjKeySchemaRequired, kn) {
+					currentKey = ffjtSchemaRequired
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
 				if fflib.EqualFoldRight(ffjKeySchemaProperties, kn) {
 					currentKey = ffjtSchemaProperties
 					state = fflib.FFParse_want_colon
@@ -5628,6 +6683,12 @@ mainparse:
 					goto mainparse
 				}
 
+				if fflib.SimpleLetterEqualFold(ffjKeySchemaNullable, kn) {
+					currentKey = ffjtSchemaNullable
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
 				if fflib.SimpleLetterEqualFold(ffjKeySchemaType, kn) {
 					currentKey = ffjtSchemaType
 					state = fflib.FFParse_want_colon
@@ -5672,24 +6733,60 @@ mainparse:
 				case ffjtSchemaType:
 					goto handle_Type
 
+				case ffjtSchemaNullable:
+					goto handle_Nullable
+
 				case ffjtSchemaFormat:
 					goto handle_Format
 
 				case ffjtSchemaProperties:
 					goto handle_Properties
 
+				case ffjtSchemaRequired:
+					goto handle_Required
+
 				case ffjtSchemaItems:
 					goto handle_Items
 
 				case ffjtSchemaAnyOf:
 					goto handle_AnyOf
 
+				case ffjtSchemaOneOf:
+					goto handle_OneOf
+
 				case ffjtSchemaEnum:
 					goto handle_Enum
 
+				case ffjtSchemaConst:
+					goto handle_Const
+
+				case ffjtSchemaMinLength:
+					goto handle_MinLength
+
+				case ffjtSchemaMaxLength:
+					goto handle_MaxLength
+
+				case ffjtSchemaMinimum:
+					goto handle_Minimum
+
+				case ffjtSchemaMaximum:
+					goto handle_Maximum
+
+				case ffjtSchemaMinItems:
+					goto handle_MinItems
+
+				case ffjtSchemaMaxItems:
+					goto handle_MaxItems
+
 				case ffjtSchemaExample:
 					goto handle_Example
 
+				case ffjtSchemaExamples:
+					goto handle_Examples
+
+				case ffjtSchemaDeprecated:
+					goto handle_Deprecated
+
 				case ffjtSchemanosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
@@ -5758,49 +6855,540 @@ handle_Ref:
 
 handle_Type:
 
-	/* handler: j.Type type=string kind=string quoted=false*/
+	/* handler: j.Type type=interface {} kind=interface quoted=false*/
 
 	{
+		/* Falling back. type=interface {} kind=interface */
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
 
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
+		err = json.Unmarshal(tbuf, &j.Type)
+		if err != nil {
+			return fs.WrapErr(err)
 		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Nullable:
+
+	/* handler: j.Nullable type=bool kind=bool quoted=false*/
+
+	{
+		if tok != fflib.FFTok_bool && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for bool", tok))
+		}
+	}
 
+	{
 		if tok == fflib.FFTok_null {
 
 		} else {
+			tmpb := fs.Output.Bytes()
 
-			outBuf := fs.Output.Bytes()
+			if bytes.Compare([]byte{'t', 'r', 'u', 'e'}, tmpb) == 0 {
 
-			j.Type = string(string(outBuf))
+				j.Nullable = true
+
+			} else if bytes.Compare([]byte{'f', 'a', 'l', 's', 'e'}, tmpb) == 0 {
+
+				j.Nullable = false
+
+			} else {
+				err = errors.New("unexpected bytes for true/false value")
+				return fs.WrapErr(err)
+			}
+
+		}
+	}
 
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Format:
+
+	/* handler: j.Format type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Format = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Properties:
+
+	/* handler: j.Properties type=openapi.Properties kind=map quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_bracket && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for Properties", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.Properties = nil
+		} else {
+
+			j.Properties = make(map[string]*Schema, 0)
+
+			wantVal := true
+
+			for {
+
+				var k string
+
+				var tmpJProperties *Schema
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_bracket {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: k type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						k = string(string(outBuf))
+
+					}
+				}
+
+				// Expect ':' after key
+				tok = fs.Scan()
+				if tok != fflib.FFTok_colon {
+					return fs.WrapErr(fmt.Errorf("wanted colon token, but got token: %v", tok))
+				}
+
+				tok = fs.Scan()
+				/* handler: tmpJProperties type=*openapi.Schema kind=ptr quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+						tmpJProperties = nil
+
+					} else {
+
+						if tmpJProperties == nil {
+							tmpJProperties = new(Schema)
+						}
+
+						err = tmpJProperties.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						if err != nil {
+							return err
+						}
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				j.Properties[k] = tmpJProperties
+
+				wantVal = false
+			}
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Required:
+
+	/* handler: j.Required type=[]string kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.Required = nil
+		} else {
+
+			j.Required = []string{}
+
+			wantVal := true
+
+			for {
+
+				var tmpJRequired string
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmpJRequired type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						tmpJRequired = string(string(outBuf))
+
+					}
+				}
+
+				j.Required = append(j.Required, tmpJRequired)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Items:
+
+	/* handler: j.Items type=openapi.Schema kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.Items = nil
+
+		} else {
+
+			if j.Items == nil {
+				j.Items = new(Schema)
+			}
+
+			err = j.Items.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_AnyOf:
+
+	/* handler: j.AnyOf type=[]openapi.Schema kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.AnyOf = nil
+		} else {
+
+			j.AnyOf = []Schema{}
+
+			wantVal := true
+
+			for {
+
+				var tmpJAnyOf Schema
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmpJAnyOf type=openapi.Schema kind=struct quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						err = tmpJAnyOf.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						if err != nil {
+							return err
+						}
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				j.AnyOf = append(j.AnyOf, tmpJAnyOf)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_OneOf:
+
+	/* handler: j.OneOf type=[]openapi.Schema kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.OneOf = nil
+		} else {
+
+			j.OneOf = []Schema{}
+
+			wantVal := true
+
+			for {
+
+				var tmpJOneOf Schema
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmpJOneOf type=openapi.Schema kind=struct quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						err = tmpJOneOf.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						if err != nil {
+							return err
+						}
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				j.OneOf = append(j.OneOf, tmpJOneOf)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Enum:
+
+	/* handler: j.Enum type=[]interface {} kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.Enum = nil
+		} else {
+
+			j.Enum = []interface{}{}
+
+			wantVal := true
+
+			for {
+
+				var tmpJEnum interface{}
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmpJEnum type=interface {} kind=interface quoted=false*/
+
+				{
+					/* Falling back. type=interface {} kind=interface */
+					tbuf, err := fs.CaptureField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+
+					err = json.Unmarshal(tbuf, &tmpJEnum)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+				}
+
+				j.Enum = append(j.Enum, tmpJEnum)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Const:
+
+	/* handler: j.Const type=interface {} kind=interface quoted=false*/
+
+	{
+		/* Falling back. type=interface {} kind=interface */
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = json.Unmarshal(tbuf, &j.Const)
+		if err != nil {
+			return fs.WrapErr(err)
 		}
 	}
 
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Format:
+handle_MinLength:
 
-	/* handler: j.Format type=string kind=string quoted=false*/
+	/* handler: j.MinLength type=int64 kind=int64 quoted=false*/
 
 	{
-
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int64", tok))
 		}
+	}
+
+	{
 
 		if tok == fflib.FFTok_null {
 
+			j.MinLength = nil
+
 		} else {
 
-			outBuf := fs.Output.Bytes()
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
 
-			j.Format = string(string(outBuf))
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			ttypval := int64(tval)
+			j.MinLength = &ttypval
 
 		}
 	}
@@ -5808,208 +7396,194 @@ handle_Format:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Properties:
+handle_MaxLength:
 
-	/* handler: j.Properties type=openapi.Properties kind=map quoted=false*/
+	/* handler: j.MaxLength type=int64 kind=int64 quoted=false*/
 
 	{
-
-		{
-			if tok != fflib.FFTok_left_bracket && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for Properties", tok))
-			}
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int64", tok))
 		}
+	}
+
+	{
 
 		if tok == fflib.FFTok_null {
-			j.Properties = nil
+
+			j.MaxLength = nil
+
 		} else {
 
-			j.Properties = make(map[string]*Schema, 0)
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
 
-			wantVal := true
+			if err != nil {
+				return fs.WrapErr(err)
+			}
 
-			for {
+			ttypval := int64(tval)
+			j.MaxLength = &ttypval
 
-				var k string
+		}
+	}
 
-				var tmpJProperties *Schema
+	state = fflib.FFParse_after_value
+	goto mainparse
 
-				tok = fs.Scan()
-				if tok == fflib.FFTok_error {
-					goto tokerror
-				}
-				if tok == fflib.FFTok_right_bracket {
-					break
-				}
+handle_Minimum:
 
-				if tok == fflib.FFTok_comma {
-					if wantVal == true {
-						// TODO(pquerna): this isn't an ideal error message, this handles
-						// things like [,,,] as an array value.
-						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
-					}
-					continue
-				} else {
-					wantVal = true
-				}
+	/* handler: j.Minimum type=float64 kind=float64 quoted=false*/
 
-				/* handler: k type=string kind=string quoted=false*/
+	{
+		if tok != fflib.FFTok_double && tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for float64", tok))
+		}
+	}
 
-				{
+	{
 
-					{
-						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-						}
-					}
+		if tok == fflib.FFTok_null {
 
-					if tok == fflib.FFTok_null {
+			j.Minimum = nil
 
-					} else {
+		} else {
 
-						outBuf := fs.Output.Bytes()
+			tval, err := fflib.ParseFloat(fs.Output.Bytes(), 64)
 
-						k = string(string(outBuf))
+			if err != nil {
+				return fs.WrapErr(err)
+			}
 
-					}
-				}
+			ttypval := float64(tval)
+			j.Minimum = &ttypval
 
-				// Expect ':' after key
-				tok = fs.Scan()
-				if tok != fflib.FFTok_colon {
-					return fs.WrapErr(fmt.Errorf("wanted colon token, but got token: %v", tok))
-				}
+		}
+	}
 
-				tok = fs.Scan()
-				/* handler: tmpJProperties type=*openapi.Schema kind=ptr quoted=false*/
+	state = fflib.FFParse_after_value
+	goto mainparse
 
-				{
-					if tok == fflib.FFTok_null {
+handle_Maximum:
 
-						tmpJProperties = nil
+	/* handler: j.Maximum type=float64 kind=float64 quoted=false*/
 
-					} else {
+	{
+		if tok != fflib.FFTok_double && tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for float64", tok))
+		}
+	}
 
-						if tmpJProperties == nil {
-							tmpJProperties = new(Schema)
-						}
+	{
 
-						err = tmpJProperties.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
-						if err != nil {
-							return err
-						}
-					}
-					state = fflib.FFParse_after_value
-				}
+		if tok == fflib.FFTok_null {
 
-				j.Properties[k] = tmpJProperties
+			j.Maximum = nil
 
-				wantVal = false
+		} else {
+
+			tval, err := fflib.ParseFloat(fs.Output.Bytes(), 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
 			}
 
+			ttypval := float64(tval)
+			j.Maximum = &ttypval
+
 		}
 	}
 
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Items:
+handle_MinItems:
 
-	/* handler: j.Items type=openapi.Schema kind=struct quoted=false*/
+	/* handler: j.MinItems type=int64 kind=int64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int64", tok))
+		}
+	}
 
 	{
+
 		if tok == fflib.FFTok_null {
 
-			j.Items = nil
+			j.MinItems = nil
 
 		} else {
 
-			if j.Items == nil {
-				j.Items = new(Schema)
-			}
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
 
-			err = j.Items.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
 			if err != nil {
-				return err
+				return fs.WrapErr(err)
 			}
+
+			ttypval := int64(tval)
+			j.MinItems = &ttypval
+
 		}
-		state = fflib.FFParse_after_value
 	}
 
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_AnyOf:
+handle_MaxItems:
 
-	/* handler: j.AnyOf type=[]openapi.Schema kind=slice quoted=false*/
+	/* handler: j.MaxItems type=int64 kind=int64 quoted=false*/
 
 	{
-
-		{
-			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
-			}
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int64", tok))
 		}
+	}
 
-		if tok == fflib.FFTok_null {
-			j.AnyOf = nil
-		} else {
+	{
 
-			j.AnyOf = []Schema{}
+		if tok == fflib.FFTok_null {
 
-			wantVal := true
+			j.MaxItems = nil
 
-			for {
+		} else {
 
-				var tmpJAnyOf Schema
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
 
-				tok = fs.Scan()
-				if tok == fflib.FFTok_error {
-					goto tokerror
-				}
-				if tok == fflib.FFTok_right_brace {
-					break
-				}
+			if err != nil {
+				return fs.WrapErr(err)
+			}
 
-				if tok == fflib.FFTok_comma {
-					if wantVal == true {
-						// TODO(pquerna): this isn't an ideal error message, this handles
-						// things like [,,,] as an array value.
-						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
-					}
-					continue
-				} else {
-					wantVal = true
-				}
+			ttypval := int64(tval)
+			j.MaxItems = &ttypval
 
-				/* handler: tmpJAnyOf type=openapi.Schema kind=struct quoted=false*/
+		}
+	}
 
-				{
-					if tok == fflib.FFTok_null {
+	state = fflib.FFParse_after_value
+	goto mainparse
 
-					} else {
+handle_Example:
 
-						err = tmpJAnyOf.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
-						if err != nil {
-							return err
-						}
-					}
-					state = fflib.FFParse_after_value
-				}
+	/* handler: j.Example type=interface {} kind=interface quoted=false*/
 
-				j.AnyOf = append(j.AnyOf, tmpJAnyOf)
+	{
+		/* Falling back. type=interface {} kind=interface */
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
 
-				wantVal = false
-			}
+		err = json.Unmarshal(tbuf, &j.Example)
+		if err != nil {
+			return fs.WrapErr(err)
 		}
 	}
 
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Enum:
+handle_Examples:
 
-	/* handler: j.Enum type=[]string kind=slice quoted=false*/
+	/* handler: j.Examples type=[]interface {} kind=slice quoted=false*/
 
 	{
 
@@ -6020,16 +7594,16 @@ handle_Enum:
 		}
 
 		if tok == fflib.FFTok_null {
-			j.Enum = nil
+			j.Examples = nil
 		} else {
 
-			j.Enum = []string{}
+			j.Examples = []interface{}{}
 
 			wantVal := true
 
 			for {
 
-				var tmpJEnum string
+				var tmpJExamples interface{}
 
 				tok = fs.Scan()
 				if tok == fflib.FFTok_error {
@@ -6050,28 +7624,22 @@ handle_Enum:
 					wantVal = true
 				}
 
-				/* handler: tmpJEnum type=string kind=string quoted=false*/
+				/* handler: tmpJExamples type=interface {} kind=interface quoted=false*/
 
 				{
-
-					{
-						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-						}
+					/* Falling back. type=interface {} kind=interface */
+					tbuf, err := fs.CaptureField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
 					}
 
-					if tok == fflib.FFTok_null {
-
-					} else {
-
-						outBuf := fs.Output.Bytes()
-
-						tmpJEnum = string(string(outBuf))
-
+					err = json.Unmarshal(tbuf, &tmpJExamples)
+					if err != nil {
+						return fs.WrapErr(err)
 					}
 				}
 
-				j.Enum = append(j.Enum, tmpJEnum)
+				j.Examples = append(j.Examples, tmpJExamples)
 
 				wantVal = false
 			}
@@ -6081,20 +7649,35 @@ handle_Enum:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Example:
+handle_Deprecated:
 
-	/* handler: j.Example type=interface {} kind=interface quoted=false*/
+	/* handler: j.Deprecated type=bool kind=bool quoted=false*/
 
 	{
-		/* Falling back. type=interface {} kind=interface */
-		tbuf, err := fs.CaptureField(tok)
-		if err != nil {
-			return fs.WrapErr(err)
+		if tok != fflib.FFTok_bool && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for bool", tok))
 		}
+	}
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+			tmpb := fs.Output.Bytes()
+
+			if bytes.Compare([]byte{'t', 'r', 'u', 'e'}, tmpb) == 0 {
+
+				j.Deprecated = true
+
+			} else if bytes.Compare([]byte{'f', 'a', 'l', 's', 'e'}, tmpb) == 0 {
+
+				j.Deprecated = false
+
+			} else {
+				err = errors.New("unexpected bytes for true/false value")
+				return fs.WrapErr(err)
+			}
 
-		err = json.Unmarshal(tbuf, &j.Example)
-		if err != nil {
-			return fs.WrapErr(err)
 		}
 	}
 
@@ -0,0 +1,780 @@
+package reverse
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"go/token"
+	"net/http"
+	"sort"
+	"strconv"
+	stdstrings "strings"
+
+	"github.com/swipe-io/strcase"
+
+	"github.com/swipe-io/swipe/v3/internal/openapi"
+	"github.com/swipe-io/swipe/v3/writer"
+)
+
+const schemaRefPrefix = "#/components/schemas/"
+
+var httpMethods = []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+
+// Options of the generated Go package.
+type Options struct {
+	// PkgName is the name of the generated Go package.
+	PkgName string
+	// Iface is the name of the service interface.
+	Iface string
+	// OptionsPkg is the import path of the Gokit swipe options package.
+	OptionsPkg string
+	// Security is the document security requirement, the operations without one use it.
+	Security []map[string][]interface{}
+}
+
+// Result contains the formatted source of the generated files.
+type Result struct {
+	// Service is the service interface with the request and response types.
+	Service []byte
+	// Swipe is the swipe option file for the service.
+	Swipe []byte
+	// Warnings describe the parts of the document that are not imported.
+	Warnings []string
+}
+
+type param struct {
+	name       string
+	typ        string
+	comment    string
+	deprecated bool
+}
+
+type method struct {
+	name       string
+	comment    string
+	deprecated bool
+	example    interface{}
+	httpMethod string
+	path       string
+	bodyType   string
+	queryVars  []string
+	headerVars []string
+	auth       []string
+	params     []param
+	results    []param
+}
+
+type generator struct {
+	o        *openapi.OpenAPI
+	opts     Options
+	types    writer.GoWriter
+	imports  map[string]struct{}
+	names    map[string]struct{}
+	methods  []method
+	owner    string
+	warnings []string
+}
+
+// Generate builds a Go service interface and Gokit swipe options from the OpenAPI document.
+func Generate(o *openapi.OpenAPI, opts Options) (*Result, error) {
+	g := &generator{
+		o:       o,
+		opts:    opts,
+		imports: map[string]struct{}{"context": {}},
+		names:   map[string]struct{}{opts.Iface: {}},
+	}
+	if err := g.generate(); err != nil {
+		return nil, err
+	}
+	service, err := format.Source(g.serviceSource())
+	if err != nil {
+		return nil, fmt.Errorf("failed format service source: %w", err)
+	}
+	swipe, err := format.Source(g.swipeSource())
+	if err != nil {
+		return nil, fmt.Errorf("failed format swipe source: %w", err)
+	}
+	return &Result{Service: service, Swipe: swipe, Warnings: g.warnings}, nil
+}
+
+func (g *generator) warnf(format string, a ...interface{}) {
+	g.warnings = append(g.warnings, fmt.Sprintf(format, a...))
+}
+
+func (g *generator) generate() error {
+	schemaNames := make([]string, 0, len(g.o.Components.Schemas))
+	for name := range g.o.Components.Schemas {
+		schemaNames = append(schemaNames, name)
+		g.names[typeName(name)] = struct{}{}
+	}
+	sort.Strings(schemaNames)
+	for _, name := range schemaNames {
+		g.owner = name
+		g.writeNamedType(typeName(name), g.o.Components.Schemas[name])
+	}
+	g.owner = ""
+
+	paths := make([]string, 0, len(g.o.Paths))
+	for p := range g.o.Paths {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	methodNames := map[string]struct{}{}
+	for _, p := range paths {
+		pathItem := g.o.Paths[p]
+		for _, httpMethod := range httpMethods {
+			op := pathOperation(pathItem, httpMethod)
+			if op == nil {
+				continue
+			}
+			m, err := g.makeMethod(p, httpMethod, op)
+			if err != nil {
+				return fmt.Errorf("%s %s: %w", httpMethod, p, err)
+			}
+			name := m.name
+			for i := 2; ; i++ {
+				if _, ok := methodNames[m.name]; !ok {
+					break
+				}
+				m.name = name + strconv.Itoa(i)
+			}
+			methodNames[m.name] = struct{}{}
+			g.methods = append(g.methods, m)
+		}
+	}
+	if len(g.methods) == 0 {
+		return fmt.Errorf("no operations found")
+	}
+	return nil
+}
+
+func (g *generator) makeMethod(p, httpMethod string, op *openapi.Operation) (m method, err error) {
+	m.name = strcase.ToCamel(op.OperationID)
+	if m.name == "" {
+		m.name = strcase.ToCamel(stdstrings.ToLower(httpMethod) + " " + stdstrings.NewReplacer("{", "", "}", "", "/", " ").Replace(p))
+	}
+	m.httpMethod = httpMethod
+	m.path = p
+	m.deprecated = op.Deprecated
+	m.comment = op.Description
+	if m.comment == "" {
+		m.comment = op.Summary
+	}
+	security := op.Security
+	if security == nil {
+		security = g.opts.Security
+	}
+	g.makeAuth(&m, security)
+
+	for _, parameter := range op.Parameters {
+		if parameter.Ref != "" {
+			return m, fmt.Errorf("parameter reference %s is not supported", parameter.Ref)
+		}
+		name := identName(parameter.Name)
+		switch parameter.In {
+		default:
+			return m, fmt.Errorf("parameter %s: location %q is not supported", parameter.Name, parameter.In)
+		case "path":
+			m.path = stdstrings.Replace(m.path, "{"+parameter.Name+"}", "{"+name+"}", -1)
+		case "query":
+			m.queryVars = append(m.queryVars, varName(parameter.Name, parameter.Required), name)
+		case "header":
+			m.headerVars = append(m.headerVars, varName(parameter.Name, parameter.Required), name)
+		}
+		m.params = append(m.params, param{
+			name:       name,
+			typ:        g.goType(parameter.Schema, m.name+strcase.ToCamel(parameter.Name)),
+			comment:    parameter.Description,
+			deprecated: parameter.Deprecated,
+		})
+	}
+
+	if op.RequestBody != nil {
+		contentType, media := pickMedia(op.RequestBody.Content)
+		switch contentType {
+		case "application/x-www-form-urlencoded":
+			m.bodyType = "urlencoded"
+		case "multipart/form-data":
+			m.bodyType = "multipart"
+		}
+		if media.Schema != nil {
+			m.example = media.Schema.Example
+			if properties, _, ok := g.objectProperties(media.Schema); ok {
+				for _, name := range sortedProperties(properties) {
+					s := properties[name]
+					m.params = append(m.params, param{
+						name:       identName(name),
+						typ:        g.goType(s, m.name+strcase.ToCamel(name)),
+						comment:    s.Description,
+						deprecated: s.Deprecated,
+					})
+				}
+			} else if t, _ := schemaType(media.Schema); media.Schema.Ref != "" || t != "object" {
+				m.params = append(m.params, param{
+					name:    "body",
+					typ:     g.goType(media.Schema, m.name+"Body"),
+					comment: op.RequestBody.Description,
+				})
+			}
+		}
+	}
+
+	codes := make([]string, 0, len(op.Responses))
+	for code := range op.Responses {
+		if stdstrings.HasPrefix(code, "2") {
+			codes = append(codes, code)
+		}
+	}
+	sort.Strings(codes)
+	if len(codes) > 0 {
+		if _, media := pickMedia(op.Responses[codes[0]].Content); media.Schema != nil {
+			s := media.Schema
+			if s.Ref == "" {
+				if properties, _, ok := g.objectProperties(s); ok {
+					for _, name := range sortedProperties(properties) {
+						m.results = append(m.results, param{
+							name: identName(name),
+							typ:  g.goType(properties[name], m.name+strcase.ToCamel(name)),
+						})
+					}
+				}
+			}
+			if len(m.results) == 0 {
+				name := "result"
+				if s.Ref != "" {
+					name = identName(stdstrings.TrimPrefix(s.Ref, schemaRefPrefix))
+				}
+				m.results = append(m.results, param{
+					name: name,
+					typ:  g.goType(s, m.name+"Result"),
+				})
+			}
+		}
+	}
+
+	paramNames := map[string]struct{}{}
+	for _, p := range m.params {
+		paramNames[p.name] = struct{}{}
+	}
+	for i, r := range m.results {
+		if _, ok := paramNames[r.name]; ok {
+			m.results[i].name = r.name + "Result"
+		}
+	}
+	return m, nil
+}
+
+// objectProperties returns the properties of an inline object schema.
+func (g *generator) objectProperties(s *openapi.Schema) (openapi.Properties, []string, bool) {
+	if s.Ref != "" || len(s.Properties) == 0 {
+		return nil, nil, false
+	}
+	if t, _ := schemaType(s); t != "" && t != "object" {
+		return nil, nil, false
+	}
+	return s.Properties, s.Required, true
+}
+
+// writeNamedType declares the component schema, inline object declarations are written before it.
+func (g *generator) writeNamedType(name string, s *openapi.Schema) {
+	var w writer.GoWriter
+	if s.Description != "" {
+		writeComment(&w, name+" "+lowerFirst(s.Description), s.Deprecated, nil)
+	} else {
+		writeComment(&w, name+" ...", s.Deprecated, nil)
+	}
+	if properties, required, ok := g.objectProperties(s); ok {
+		g.writeStruct(&w, name, properties, required)
+	} else {
+		typ := g.goType(&openapi.Schema{Type: s.Type, Format: s.Format, Items: s.Items, OneOf: s.OneOf, AnyOf: s.AnyOf}, name+"Item")
+		w.W("type %s %s\n\n", name, typ)
+		if len(s.Enum) > 0 {
+			w.W("const (\n")
+			for _, v := range s.Enum {
+				w.W("%s %s = %s\n", name+enumName(v), name, literal(v))
+			}
+			w.W(")\n\n")
+		}
+	}
+	g.types.Write(w.Bytes())
+}
+
+func (g *generator) writeStruct(w *writer.GoWriter, name string, properties openapi.Properties, required []string) {
+	var fields writer.GoWriter
+	for _, propName := range sortedProperties(properties) {
+		s := properties[propName]
+		writeComment(&fields, s.Description, s.Deprecated, s.Example)
+		typ := g.goType(s, name+strcase.ToCamel(propName))
+		tag := propName
+		if !contains(required, propName) {
+			tag += ",omitempty"
+		}
+		// the optional references are pointers like the nullable ones, a required reference
+		// is a pointer when it refers back to the declared schema, otherwise the type is recursive.
+		if ref := schemaRef(s); ref != "" && (!contains(required, propName) || g.embeds(ref, g.owner, map[string]struct{}{})) {
+			typ = pointer(typ)
+		}
+		fields.W("%s %s `json:\"%s\"`\n", strcase.ToCamel(propName), typ, tag)
+	}
+	w.W("type %s struct {\n", name)
+	w.Write(fields.Bytes())
+	w.W("}\n\n")
+}
+
+// goType returns the Go type of the schema, inline objects are declared as named structs with the hint name.
+func (g *generator) goType(s *openapi.Schema, hint string) (typ string) {
+	if s == nil {
+		return "interface{}"
+	}
+	t, nullable := schemaType(s)
+	nullable = nullable || s.Nullable
+	defer func() {
+		if nullable {
+			typ = pointer(typ)
+		}
+	}()
+	if s.Ref != "" {
+		return typeName(stdstrings.TrimPrefix(s.Ref, schemaRefPrefix))
+	}
+	if variants := s.OneOf; len(variants) > 0 || len(s.AnyOf) > 0 {
+		if len(variants) == 0 {
+			variants = s.AnyOf
+		}
+		if len(variants) == 2 {
+			for i, v := range variants {
+				if vt, _ := schemaType(&v); vt == "null" {
+					nullable = true
+					other := variants[1-i]
+					return g.goType(&other, hint)
+				}
+			}
+		}
+		return "interface{}"
+	}
+	switch t {
+	case "string":
+		switch s.Format {
+		case "date-time", "date":
+			g.imports["time"] = struct{}{}
+			return "time.Time"
+		case "byte", "binary":
+			return "[]byte"
+		}
+		return "string"
+	case "integer":
+		switch s.Format {
+		case "int32":
+			return "int32"
+		case "int64":
+			return "int64"
+		}
+		return "int"
+	case "number":
+		if s.Format == "float" {
+			return "float32"
+		}
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "array":
+		return "[]" + g.goType(s.Items, hint+"Item")
+	case "", "object":
+		if len(s.Properties) == 0 {
+			if t == "" {
+				return "interface{}"
+			}
+			return "map[string]interface{}"
+		}
+		name := g.uniqueName(hint)
+		var w writer.GoWriter
+		writeComment(&w, name+" ...", false, nil)
+		g.writeStruct(&w, name, s.Properties, s.Required)
+		g.types.Write(w.Bytes())
+		return name
+	}
+	return "interface{}"
+}
+
+// embeds reports whether the component schema contains the target schema by value,
+// the optional, nullable and slice fields do not embed the schema.
+func (g *generator) embeds(name, target string, visited map[string]struct{}) bool {
+	if target == "" {
+		return false
+	}
+	if name == target {
+		return true
+	}
+	if _, ok := visited[name]; ok {
+		return false
+	}
+	visited[name] = struct{}{}
+	s, ok := g.o.Components.Schemas[name]
+	if !ok {
+		return false
+	}
+	for _, ref := range valueRefs(s) {
+		if g.embeds(ref, target, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// valueRefs returns the component schemas referenced by the required fields of the object schema.
+func valueRefs(s *openapi.Schema) (refs []string) {
+	if s.Ref != "" {
+		return nil
+	}
+	for _, name := range sortedProperties(s.Properties) {
+		if !contains(s.Required, name) {
+			continue
+		}
+		p := s.Properties[name]
+		if _, nullable := schemaType(p); nullable || p.Nullable {
+			continue
+		}
+		if p.Ref != "" {
+			refs = append(refs, stdstrings.TrimPrefix(p.Ref, schemaRefPrefix))
+			continue
+		}
+		refs = append(refs, valueRefs(p)...)
+	}
+	return
+}
+
+// makeAuth maps the security requirements to the auth options, the requirements are alternatives
+// like the swipe auth checks, the requirements that can not be mapped are reported as warnings.
+func (g *generator) makeAuth(m *method, requirements []map[string][]interface{}) {
+	for _, r := range requirements {
+		if len(r) == 0 {
+			if len(requirements) > 1 {
+				g.warnf("%s %s: optional security is not supported, the method is imported without auth", m.httpMethod, m.path)
+			}
+			return
+		}
+	}
+	var apiKey bool
+	for _, r := range requirements {
+		names := make([]string, 0, len(r))
+		for name := range r {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		if len(names) > 1 {
+			g.warnf("%s %s: security requirement %s is imported as alternatives, swipe accepts any of the schemes", m.httpMethod, m.path, stdstrings.Join(names, " and "))
+		}
+		for _, name := range names {
+			auth, err := g.authOption(name)
+			if err != nil {
+				g.warnf("%s %s: security %s is dropped: %s", m.httpMethod, m.path, name, err)
+				continue
+			}
+			if contains(m.auth, auth) {
+				continue
+			}
+			if stdstrings.HasPrefix(auth, "APIKeyAuth(") {
+				if apiKey {
+					g.warnf("%s %s: security %s is dropped: only one api key is supported", m.httpMethod, m.path, name)
+					continue
+				}
+				apiKey = true
+			}
+			m.auth = append(m.auth, auth)
+		}
+	}
+}
+
+// authOption returns the auth option of the security scheme.
+func (g *generator) authOption(name string) (string, error) {
+	scheme, ok := g.o.Components.SecuritySchemes[name]
+	if !ok {
+		return "", fmt.Errorf("the scheme is not declared")
+	}
+	switch t := schemeField(scheme, "type"); t {
+	case "http":
+		switch s := stdstrings.ToLower(schemeField(scheme, "scheme")); s {
+		case "bearer":
+			return "BearerAuth()", nil
+		case "basic":
+			return "BasicAuth()", nil
+		default:
+			return "", fmt.Errorf("http scheme %q is not supported", s)
+		}
+	case "apiKey":
+		switch in := schemeField(scheme, "in"); in {
+		case "header", "query":
+			return fmt.Sprintf("APIKeyAuth(%s, %s)", strconv.Quote(in), strconv.Quote(schemeField(scheme, "name"))), nil
+		default:
+			return "", fmt.Errorf("api key location %q is not supported", in)
+		}
+	default:
+		return "", fmt.Errorf("scheme type %q is not supported", t)
+	}
+}
+
+func (g *generator) uniqueName(name string) string {
+	result := name
+	for i := 2; ; i++ {
+		if _, ok := g.names[result]; !ok {
+			break
+		}
+		result = name + strconv.Itoa(i)
+	}
+	g.names[result] = struct{}{}
+	return result
+}
+
+func (g *generator) serviceSource() []byte {
+	var w writer.GoWriter
+
+	imports := make([]string, 0, len(g.imports))
+	for pkg := range g.imports {
+		imports = append(imports, strconv.Quote(pkg))
+	}
+	sort.Strings(imports)
+
+	w.W("package %s\n\n", g.opts.PkgName)
+	w.W("import (\n%s\n)\n\n", stdstrings.Join(imports, "\n"))
+
+	if g.o.Info.Description != "" {
+		writeComment(&w, g.opts.Iface+" "+lowerFirst(g.o.Info.Description), false, nil)
+	} else {
+		writeComment(&w, g.opts.Iface+" ...", false, nil)
+	}
+	w.W("type %s interface {\n", g.opts.Iface)
+	for _, m := range g.methods {
+		writeComment(&w, m.comment, m.deprecated, m.example)
+		for _, p := range m.params {
+			if p.comment != "" {
+				w.W("// @%s %s\n", p.name, stdstrings.Join(stdstrings.Fields(p.comment), " "))
+			}
+		}
+		params := []string{"ctx context.Context"}
+		for _, p := range m.params {
+			params = append(params, p.name+" "+p.typ)
+		}
+		results := make([]string, 0, len(m.results)+1)
+		for _, r := range m.results {
+			results = append(results, r.name+" "+r.typ)
+		}
+		results = append(results, "err error")
+		w.W("%s(%s) (%s)\n", m.name, stdstrings.Join(params, ", "), stdstrings.Join(results, ", "))
+	}
+	w.W("}\n\n")
+	w.Write(g.types.Bytes())
+	return w.Bytes()
+}
+
+func (g *generator) swipeSource() []byte {
+	var w writer.GoWriter
+
+	optionsPkgName := g.opts.OptionsPkg[stdstrings.LastIndex(g.opts.OptionsPkg, "/")+1:]
+
+	w.W("//go:build swipe\n// +build swipe\n\n")
+	w.W("package %s\n\n", g.opts.PkgName)
+	w.W("import (\n\"net/http\"\n\n%s\n)\n\n", strconv.Quote(g.opts.OptionsPkg))
+	w.W("func Swipe() {\n")
+	w.W("%s.Gokit(\n", optionsPkgName)
+	w.W("%s.HTTPServer(),\n", optionsPkgName)
+	w.W("%s.Interface((*%s)(nil), \"\"),\n", optionsPkgName, g.opts.Iface)
+	w.W("%s.OpenapiEnable(),\n", optionsPkgName)
+	w.W("%s.OpenapiInfo(%s, %s, %s),\n", optionsPkgName, strconv.Quote(g.o.Info.Title), strconv.Quote(g.o.Info.Description), strconv.Quote(g.o.Info.Version))
+	for _, s := range g.o.Servers {
+		w.W("%s.OpenapiServer(%s, %s),\n", optionsPkgName, strconv.Quote(s.Description), strconv.Quote(s.URL))
+	}
+	for _, m := range g.methods {
+		w.W("%s.MethodOptions(%s.%s,\n", optionsPkgName, g.opts.Iface, m.name)
+		w.W("%s.RESTMethod(http.Method%s),\n", optionsPkgName, strcase.ToCamel(stdstrings.ToLower(m.httpMethod)))
+		w.W("%s.RESTPath(%s),\n", optionsPkgName, strconv.Quote(m.path))
+		if len(m.queryVars) > 0 {
+			w.W("%s.RESTQueryVars(%s),\n", optionsPkgName, stringSlice(m.queryVars))
+		}
+		if len(m.headerVars) > 0 {
+			w.W("%s.RESTHeaderVars(%s),\n", optionsPkgName, stringSlice(m.headerVars))
+		}
+		if m.bodyType != "" {
+			w.W("%s.RESTBodyType(%s),\n", optionsPkgName, strconv.Quote(m.bodyType))
+		}
+		for _, auth := range m.auth {
+			w.W("%s.%s,\n", optionsPkgName, auth)
+		}
+		w.W("),\n")
+	}
+	w.W(")\n")
+	w.W("}\n")
+	return w.Bytes()
+}
+
+func writeComment(w *writer.GoWriter, comment string, deprecated bool, example interface{}) {
+	comment = stdstrings.TrimSpace(comment)
+	if comment != "" {
+		for _, line := range stdstrings.Split(comment, "\n") {
+			w.W("// %s\n", stdstrings.TrimSpace(line))
+		}
+	}
+	if example != nil {
+		if data, err := json.Marshal(example); err == nil {
+			w.W("// @example %s\n", data)
+		}
+	}
+	if deprecated {
+		if comment != "" || example != nil {
+			w.W("//\n")
+		}
+		w.W("// Deprecated: marked as deprecated in the OpenAPI document.\n")
+	}
+}
+
+func pathOperation(p *openapi.Path, httpMethod string) *openapi.Operation {
+	switch httpMethod {
+	case http.MethodGet:
+		return p.Get
+	case http.MethodPost:
+		return p.Post
+	case http.MethodPut:
+		return p.Put
+	case http.MethodPatch:
+		return p.Patch
+	case http.MethodDelete:
+		return p.Delete
+	}
+	return nil
+}
+
+func pickMedia(content openapi.Content) (string, openapi.Media) {
+	for _, contentType := range []string{"application/json", "application/x-www-form-urlencoded", "multipart/form-data"} {
+		if media, ok := content[contentType]; ok {
+			return contentType, media
+		}
+	}
+	contentTypes := make([]string, 0, len(content))
+	for contentType := range content {
+		contentTypes = append(contentTypes, contentType)
+	}
+	sort.Strings(contentTypes)
+	if len(contentTypes) > 0 {
+		return contentTypes[0], content[contentTypes[0]]
+	}
+	return "", openapi.Media{}
+}
+
+// schemaType returns the schema type, the OpenAPI 3.1 "null" type in the type list marks the schema as nullable.
+func schemaType(s *openapi.Schema) (t string, nullable bool) {
+	switch v := s.Type.(type) {
+	case string:
+		t = v
+	case []interface{}:
+		for _, item := range v {
+			if str, ok := item.(string); ok {
+				if str == "null" {
+					nullable = true
+					continue
+				}
+				t = str
+			}
+		}
+	case []string:
+		for _, str := range v {
+			if str == "null" {
+				nullable = true
+				continue
+			}
+			t = str
+		}
+	}
+	return
+}
+
+// schemaRef returns the component name of the referenced schema.
+func schemaRef(s *openapi.Schema) string {
+	return stdstrings.TrimPrefix(s.Ref, schemaRefPrefix)
+}
+
+// schemeField returns the string field of the decoded security scheme.
+func schemeField(scheme interface{}, name string) string {
+	switch v := scheme.(type) {
+	case map[string]interface{}:
+		s, _ := v[name].(string)
+		return s
+	case map[interface{}]interface{}:
+		s, _ := v[name].(string)
+		return s
+	}
+	return ""
+}
+
+func sortedProperties(properties openapi.Properties) []string {
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func typeName(name string) string {
+	return strcase.ToCamel(name)
+}
+
+// identName returns a Go identifier for the name that does not collide with keywords and the ctx, err names.
+func identName(name string) string {
+	ident := strcase.ToLowerCamel(name)
+	if token.IsKeyword(ident) || ident == "ctx" || ident == "err" {
+		ident += "Value"
+	}
+	return ident
+}
+
+func varName(name string, required bool) string {
+	if required {
+		return "!" + name
+	}
+	return name
+}
+
+func enumName(v interface{}) string {
+	name := strcase.ToCamel(fmt.Sprint(v))
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "V" + name
+	}
+	return name
+}
+
+func literal(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return strconv.Quote(s)
+	}
+	return fmt.Sprint(v)
+}
+
+func pointer(typ string) string {
+	if stdstrings.HasPrefix(typ, "*") || stdstrings.HasPrefix(typ, "[]") || stdstrings.HasPrefix(typ, "map[") || typ == "interface{}" {
+		return typ
+	}
+	return "*" + typ
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return stdstrings.ToLower(s[:1]) + s[1:]
+}
+
+func contains(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
+func stringSlice(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = strconv.Quote(v)
+	}
+	return "[]string{" + stdstrings.Join(quoted, ", ") + "}"
+}
@@ -0,0 +1,159 @@
+package reverse
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"regexp"
+	"testing"
+
+	"github.com/swipe-io/swipe/v3/internal/openapi"
+)
+
+const testPaths = `"paths": {
+	"/get": {"post": {"operationId": "get", "responses": {"200": {"description": "OK", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/User"}}}}}}}
+}`
+
+func generate(t *testing.T, spec string, security []map[string][]interface{}) *Result {
+	t.Helper()
+	var o openapi.OpenAPI
+	if err := json.Unmarshal([]byte(spec), &o); err != nil {
+		t.Fatalf("unmarshal spec: %v", err)
+	}
+	result, err := Generate(&o, Options{PkgName: "service", Iface: "Service", OptionsPkg: "example.com/app/pkg/swipe/gokit", Security: security})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	return result
+}
+
+// typeCheck reports the errors of the generated service source, the recursive value types are errors.
+func typeCheck(t *testing.T, src []byte) {
+	t.Helper()
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "service.go", src, 0)
+	if err != nil {
+		t.Fatalf("parse service: %v\n%s", err, src)
+	}
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	if _, err := conf.Check("service", fset, []*ast.File{f}, nil); err != nil {
+		t.Fatalf("type check service: %v\n%s", err, src)
+	}
+}
+
+func Test_goTypeRefs(t *testing.T) {
+	tests := []struct {
+		name    string
+		schemas string
+		want    []string
+	}{
+		{
+			"optional self reference",
+			`"User": {"type": "object", "properties": {"name": {"type": "string"}, "parent": {"$ref": "#/components/schemas/User"}}, "required": ["name"]}`,
+			[]string{`Parent\s+\*User\s`},
+		},
+		{
+			"required self reference",
+			`"User": {"type": "object", "properties": {"parent": {"$ref": "#/components/schemas/User"}}, "required": ["parent"]}`,
+			[]string{`Parent\s+\*User\s`},
+		},
+		{
+			"required reference cycle",
+			`"User": {"type": "object", "properties": {"group": {"$ref": "#/components/schemas/Group"}}, "required": ["group"]},
+			"Group": {"type": "object", "properties": {"owner": {"$ref": "#/components/schemas/User"}}, "required": ["owner"]}`,
+			[]string{`Group\s+\*Group\s`, `Owner\s+\*User\s`},
+		},
+		{
+			"required reference",
+			`"User": {"type": "object", "properties": {"group": {"$ref": "#/components/schemas/Group"}}, "required": ["group"]},
+			"Group": {"type": "object", "properties": {"name": {"type": "string"}}}`,
+			[]string{`Group\s+Group\s`},
+		},
+		{
+			"optional reference",
+			`"User": {"type": "object", "properties": {"group": {"$ref": "#/components/schemas/Group"}}},
+			"Group": {"type": "object", "properties": {"name": {"type": "string"}}}`,
+			[]string{`Group\s+\*Group\s`},
+		},
+		{
+			"oneOf null reference",
+			`"User": {"type": "object", "properties": {"group": {"oneOf": [{"$ref": "#/components/schemas/Group"}, {"type": "null"}]}}, "required": ["group"]},
+			"Group": {"type": "object", "properties": {"name": {"type": "string"}}}`,
+			[]string{`Group\s+\*Group\s`},
+		},
+		{
+			"reference slice",
+			`"User": {"type": "object", "properties": {"children": {"type": "array", "items": {"$ref": "#/components/schemas/User"}}}, "required": ["children"]}`,
+			[]string{`Children\s+\[\]User\s`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := generate(t, `{"openapi": "3.0.0", `+testPaths+`, "components": {"schemas": {`+tt.schemas+`}}}`, nil)
+			for _, want := range tt.want {
+				if !regexp.MustCompile(want).Match(result.Service) {
+					t.Errorf("service does not match %q:\n%s", want, result.Service)
+				}
+			}
+			typeCheck(t, result.Service)
+		})
+	}
+}
+
+func Test_makeAuth(t *testing.T) {
+	const schemes = `"securitySchemes": {
+		"bearerAuth": {"type": "http", "scheme": "bearer"},
+		"basicAuth": {"type": "http", "scheme": "basic"},
+		"apiKey": {"type": "apiKey", "in": "header", "name": "X-API-Key"},
+		"cookieKey": {"type": "apiKey", "in": "cookie", "name": "session"},
+		"oauth": {"type": "oauth2"}
+	}`
+	const user = `"schemas": {"User": {"type": "object", "properties": {"name": {"type": "string"}}}}`
+
+	tests := []struct {
+		name         string
+		security     string
+		docSecurity  []map[string][]interface{}
+		want         []string
+		wantWarnings int
+	}{
+		{
+			"operation security",
+			`"security": [{"bearerAuth": []}, {"apiKey": []}, {"basicAuth": []}],`,
+			nil,
+			[]string{`gokit\.BearerAuth\(\)`, `gokit\.APIKeyAuth\("header", "X-API-Key"\)`, `gokit\.BasicAuth\(\)`},
+			0,
+		},
+		{
+			"document security",
+			``,
+			[]map[string][]interface{}{{"bearerAuth": {}}},
+			[]string{`gokit\.BearerAuth\(\)`},
+			0,
+		},
+		{
+			"unsupported schemes",
+			`"security": [{"oauth": []}, {"cookieKey": []}, {"missing": []}],`,
+			nil,
+			nil,
+			3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := `{"openapi": "3.0.0", "paths": {"/get": {"post": {"operationId": "get", ` + tt.security + ` "responses": {"200": {"description": "OK"}}}}}, "components": {` + schemes + `, ` + user + `}}`
+			result := generate(t, spec, tt.docSecurity)
+			for _, want := range tt.want {
+				if !regexp.MustCompile(want).Match(result.Swipe) {
+					t.Errorf("swipe does not match %q:\n%s", want, result.Swipe)
+				}
+			}
+			if len(result.Warnings) != tt.wantWarnings {
+				t.Errorf("warnings = %q, want %d", result.Warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
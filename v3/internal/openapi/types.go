@@ -34,16 +34,27 @@ type Tag struct {
 type Properties map[string]*Schema
 
 type Schema struct {
-	Description string      `yaml:"description,omitempty" json:"description,omitempty"`
-	Ref         string      `yaml:"$ref,omitempty" json:"$ref,omitempty"`
-	Type        string      `yaml:"type,omitempty" json:"type,omitempty"`
-	Nullable    bool        `yaml:"nullable,omitempty" json:"nullable,omitempty"`
-	Format      string      `yaml:"format,omitempty" json:"format,omitempty"`
-	Properties  Properties  `yaml:"properties,omitempty" json:"properties,omitempty"`
-	Items       *Schema     `yaml:"items,omitempty" json:"items,omitempty"`
-	AnyOf       []Schema    `yaml:"anyOf,omitempty" json:"anyOf,omitempty"`
-	Enum        []string    `yaml:"enum,omitempty" json:"enum,omitempty"`
-	Example     interface{} `yaml:"example,omitempty" json:"example,omitempty"`
+	Description string        `yaml:"description,omitempty" json:"description,omitempty"`
+	Ref         string        `yaml:"$ref,omitempty" json:"$ref,omitempty"`
+	Type        interface{}   `yaml:"type,omitempty" json:"type,omitempty"`
+	Nullable    bool          `yaml:"nullable,omitempty" json:"nullable,omitempty"`
+	Format      string        `yaml:"format,omitempty" json:"format,omitempty"`
+	Properties  Properties    `yaml:"properties,omitempty" json:"properties,omitempty"`
+	Required    []string      `yaml:"required,omitempty" json:"required,omitempty"`
+	Items       *Schema       `yaml:"items,omitempty" json:"items,omitempty"`
+	AnyOf       []Schema      `yaml:"anyOf,omitempty" json:"anyOf,omitempty"`
+	OneOf       []Schema      `yaml:"oneOf,omitempty" json:"oneOf,omitempty"`
+	Enum        []interface{} `yaml:"enum,omitempty" json:"enum,omitempty"`
+	Const       interface{}   `yaml:"const,omitempty" json:"const,omitempty"`
+	MinLength   *int64        `yaml:"minLength,omitempty" json:"minLength,omitempty"`
+	MaxLength   *int64        `yaml:"maxLength,omitempty" json:"maxLength,omitempty"`
+	Minimum     *float64      `yaml:"minimum,omitempty" json:"minimum,omitempty"`
+	Maximum     *float64      `yaml:"maximum,omitempty" json:"maximum,omitempty"`
+	MinItems    *int64        `yaml:"minItems,omitempty" json:"minItems,omitempty"`
+	MaxItems    *int64        `yaml:"maxItems,omitempty" json:"maxItems,omitempty"`
+	Example     interface{}   `yaml:"example,omitempty" json:"example,omitempty"`
+	Examples    []interface{} `yaml:"examples,omitempty" json:"examples,omitempty"`
+	Deprecated  bool          `yaml:"deprecated,omitempty" json:"deprecated,omitempty"`
 }
 
 type Parameter struct {
@@ -52,6 +63,7 @@ type Parameter struct {
 	Name        string  `yaml:"name,omitempty" json:"name,omitempty"`
 	Description string  `yaml:"description,omitempty" json:"description,omitempty"`
 	Required    bool    `yaml:"required,omitempty" json:"required,omitempty"`
+	Deprecated  bool    `yaml:"deprecated,omitempty" json:"deprecated,omitempty"`
 	Schema      *Schema `yaml:"schema,omitempty" json:"schema,omitempty"`
 }
 
@@ -84,6 +96,7 @@ type Operation struct {
 	Parameters  []Parameter                `yaml:"parameters,omitempty" json:"parameters,omitempty"`
 	RequestBody *RequestBody               `yaml:"requestBody,omitempty" json:"requestBody,omitempty"`
 	Responses   Responses                  `yaml:"responses,omitempty" json:"responses,omitempty"`
+	Deprecated  bool                       `yaml:"deprecated,omitempty" json:"deprecated,omitempty"`
 	Security    []map[string][]interface{} `yaml:"security" json:"security"`
 }
 
@@ -130,5 +143,6 @@ type OpenAPI struct {
 	Tags       []Tag            `yaml:"tags,omitempty" json:"tags,omitempty"`
 	Schemes    []string         `yaml:"schemes,omitempty" json:"schemes,omitempty"`
 	Paths      map[string]*Path `yaml:"paths,omitempty" json:"paths,omitempty"`
+	Webhooks   map[string]*Path `yaml:"webhooks,omitempty" json:"webhooks,omitempty"`
 	Components Components       `yaml:"components,omitempty" json:"components,omitempty"`
 }
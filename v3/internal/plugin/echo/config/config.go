@@ -11,6 +11,13 @@ type Interface struct {
 	ClientName option.StringValue `swipe:"option"`
 }
 
+type HTTPCORS struct {
+	Origins []string
+	Methods []string
+	Headers []string
+	MaxAge  int64
+}
+
 type MethodOption struct {
 	Signature     *option.NamedType
 	MethodOptions `mapstructure:",squash"`
@@ -28,6 +35,7 @@ type MethodOptions struct {
 	RESTPathVars           map[string]string       `swipe:"option"`
 	RESTBodyType           option.StringValue      `swipe:"option"`
 	BearerAuth             *struct{}               `swipe:"option"`
+	Validation             option.BoolValue        `swipe:"option"`
 }
 
 type OpenapiInfo struct {
@@ -57,10 +65,17 @@ type OpenapiTag struct {
 	Tags    []string           `mapstructure:"tags"`
 }
 
+type OpenapiWebhook struct {
+	Method *option.NamedType `mapstructure:"method"`
+	Name   string            `mapstructure:"name"`
+}
+
 // Config
 // @swipe:"Echo"
 type Config struct {
 	Interfaces           []*Interface `mapstructure:"Interface"`
+	HTTPCORS             *HTTPCORS
+	HealthEndpoints      *struct{}
 	ClientEnable         *struct{}
 	ClientOutput         option.StringValue
 	MethodOptions        []MethodOption
@@ -72,8 +87,12 @@ type Config struct {
 	OpenapiContact       OpenapiContact
 	OpenapiLicence       OpenapiLicence
 	OpenapiServers       []OpenapiServer `mapstructure:"OpenapiServer"`
+	OpenapiVersion       option.StringValue
+	OpenapiWebhooks      []OpenapiWebhook `mapstructure:"OpenapiWebhook"`
 
 	MethodOptionsMap  map[string]MethodOptions             `mapstructure:"-"`
 	OpenapiMethodTags map[string][]string                  `mapstructure:"-"`
 	IfaceErrors       map[string]map[string][]finder.Error `mapstructure:"-"`
+	ValidationEnable  bool                                 `mapstructure:"-"`
+	AppName           string                               `mapstructure:"-"`
 }
@@ -1,5 +1,5 @@
 package config
 
 func (*Config) Options() []byte {
-	return []byte("// Echo\nfunc Echo(opts ...EchoOption) {}\n\n// InterfaceOption ...\ntype InterfaceOption string\n\n// ClientName ...\nfunc ClientName(value string) InterfaceOption { return \"implementation not generated, run swipe\" }\n\n// EchoOption ...\ntype EchoOption string\n\n// Interface ...\n// @type:\"repeat\"\nfunc Interface(iface interface{}, ns string, opts ...InterfaceOption) EchoOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// ClientEnable ...\nfunc ClientEnable() EchoOption { return \"implementation not generated, run swipe\" }\n\n// ClientOutput ...\nfunc ClientOutput(value string) EchoOption { return \"implementation not generated, run swipe\" }\n\n// MethodOptionsOption ...\ntype MethodOptionsOption string\n\n// RESTMethod ...\nfunc RESTMethod(value interface{}) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTWrapResponse ...\nfunc RESTWrapResponse(value string) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTWrapRequest ...\nfunc RESTWrapRequest(value string) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTPath ...\nfunc RESTPath(value interface{}) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTMultipartMaxMemory ...\nfunc RESTMultipartMaxMemory(value int64) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTHeaderVars ...\nfunc RESTHeaderVars(value []string) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTQueryVars ...\nfunc RESTQueryVars(value []string) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTQueryValues ...\nfunc RESTQueryValues(value []string) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTBodyType ...\nfunc RESTBodyType(value string) MethodOptionsOption { return \"implementation not generated, run swipe\" }\n\n// BearerAuth ...\nfunc BearerAuth() MethodOptionsOption { return \"implementation not generated, run swipe\" }\n\n// MethodOptions ...\n// @type:\"repeat\"\nfunc MethodOptions(signature interface{}, opts ...MethodOptionsOption) EchoOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// MethodDefaultOptions ...\nfunc MethodDefaultOptions(opts ...MethodOptionsOption) EchoOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// OpenapiEnable ...\nfunc OpenapiEnable() EchoOption { return \"implementation not generated, run swipe\" }\n\n// OpenapiTags ...\n// @type:\"repeat\"\nfunc OpenapiTags(methods []interface{}, tags []string) EchoOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// OpenapiOutput ...\nfunc OpenapiOutput(value string) EchoOption { return \"implementation not generated, run swipe\" }\n\n// OpenapiInfo ...\nfunc OpenapiInfo(title string, description string, version string) EchoOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// OpenapiContact ...\nfunc OpenapiContact(name string, email string, url string) EchoOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// OpenapiLicence ...\nfunc OpenapiLicence(name string, url string) EchoOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// OpenapiServer ...\n// @type:\"repeat\"\nfunc OpenapiServer(description string, url string) EchoOption {\n\treturn \"implementation not generated, run swipe\"\n}\n")
+	return []byte("// Echo\nfunc Echo(opts ...EchoOption) {}\n\n// InterfaceOption ...\ntype InterfaceOption string\n\n// ClientName ...\nfunc ClientName(value string) InterfaceOption { return \"implementation not generated, run swipe\" }\n\n// EchoOption ...\ntype EchoOption string\n\n// Interface ...\n// @type:\"repeat\"\nfunc Interface(iface interface{}, ns string, opts ...InterfaceOption) EchoOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// HTTPCORS ...\nfunc HTTPCORS(origins []string, methods []string, headers []string, maxAge int64) EchoOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// HealthEndpoints ...\nfunc HealthEndpoints() EchoOption { return \"implementation not generated, run swipe\" }\n\n// ClientEnable ...\nfunc ClientEnable() EchoOption { return \"implementation not generated, run swipe\" }\n\n// ClientOutput ...\nfunc ClientOutput(value string) EchoOption { return \"implementation not generated, run swipe\" }\n\n// MethodOptionsOption ...\ntype MethodOptionsOption string\n\n// RESTMethod ...\nfunc RESTMethod(value interface{}) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTWrapResponse ...\nfunc RESTWrapResponse(value string) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTWrapRequest ...\nfunc RESTWrapRequest(value string) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTPath ...\nfunc RESTPath(value interface{}) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTMultipartMaxMemory ...\nfunc RESTMultipartMaxMemory(value int64) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTHeaderVars ...\nfunc RESTHeaderVars(value []string) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTQueryVars ...\nfunc RESTQueryVars(value []string) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTQueryValues ...\nfunc RESTQueryValues(value []string) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTBodyType ...\nfunc RESTBodyType(value string) MethodOptionsOption { return \"implementation not generated, run swipe\" }\n\n// BearerAuth ...\nfunc BearerAuth() MethodOptionsOption { return \"implementation not generated, run swipe\" }\n\n// Validation ...\nfunc Validation(value bool) MethodOptionsOption { return \"implementation not generated, run swipe\" }\n\n// MethodOptions ...\n// @type:\"repeat\"\nfunc MethodOptions(signature interface{}, opts ...MethodOptionsOption) EchoOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// MethodDefaultOptions ...\nfunc MethodDefaultOptions(opts ...MethodOptionsOption) EchoOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// OpenapiEnable ...\nfunc OpenapiEnable() EchoOption { return \"implementation not generated, run swipe\" }\n\n// OpenapiTags ...\n// @type:\"repeat\"\nfunc OpenapiTags(methods []interface{}, tags []string) EchoOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// OpenapiOutput ...\nfunc OpenapiOutput(value string) EchoOption { return \"implementation not generated, run swipe\" }\n\n// OpenapiInfo ...\nfunc OpenapiInfo(title string, description string, version string) EchoOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// OpenapiContact ...\nfunc OpenapiContact(name string, email string, url string) EchoOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// OpenapiLicence ...\nfunc OpenapiLicence(name string, url string) EchoOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// OpenapiServer ...\n// @type:\"repeat\"\nfunc OpenapiServer(description string, url string) EchoOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// OpenapiVersion ...\nfunc OpenapiVersion(value string) EchoOption { return \"implementation not generated, run swipe\" }\n\n// OpenapiWebhook ...\n// @type:\"repeat\"\nfunc OpenapiWebhook(method interface{}, name string) EchoOption {\n\treturn \"implementation not generated, run swipe\"\n}\n")
 }
@@ -0,0 +1,131 @@
+package generator
+
+import (
+	"strconv"
+
+	"github.com/swipe-io/swipe/v3/swipe"
+)
+
+type serviceMethod struct {
+	name       string
+	httpMethod string
+	path       string
+}
+
+func (g *RoutesGenerator) writeHealthServerOptions(importer swipe.Importer) {
+	timePkg := importer.Import("time", "time")
+
+	g.w.W("// HealthCheckOption registers the readiness checker, the check fails when the checker\n")
+	g.w.W("// does not return within the timeout, a zero timeout waits for the checker.\n")
+	g.w.W("func HealthCheckOption(name string, timeout %s.Duration, checker HealthChecker) ServerOption {\n", timePkg)
+	g.w.W("return func(c *serverOpts) {\n")
+	g.w.W("c.healthChecks = append(c.healthChecks, healthCheck{name: name, timeout: timeout, checker: checker})\n")
+	g.w.W("}\n}\n\n")
+
+	g.w.W("// VersionOption sets the service version of the info endpoint, the main module version is used by default.\n")
+	g.w.W("func VersionOption(version string) ServerOption {\nreturn func(c *serverOpts) {\nc.version = version\n}\n}\n\n")
+}
+
+func (g *RoutesGenerator) writeHealth(importer swipe.Importer, echoPkg, httpPkg string, methods []serviceMethod) {
+	contextPkg := importer.Import("context", "context")
+	debugPkg := importer.Import("debug", "runtime/debug")
+	timePkg := importer.Import("time", "time")
+
+	g.w.W("// HealthChecker reports the readiness of a service dependency, a nil error means ready.\n")
+	g.w.W("type HealthChecker func(ctx %s.Context) error\n\n", contextPkg)
+
+	g.w.W("type healthCheck struct {\n")
+	g.w.W("name string\n")
+	g.w.W("timeout %s.Duration\n", timePkg)
+	g.w.W("checker HealthChecker\n")
+	g.w.W("}\n\n")
+
+	g.w.W("type healthStatus struct {\n")
+	g.w.W("Status string `json:\"status\"`\n")
+	g.w.W("Checks map[string]string `json:\"checks,omitempty\"`\n")
+	g.w.W("}\n\n")
+
+	g.w.W("type healthCheckResult struct {\nname string\nerr error\n}\n\n")
+
+	g.w.W("// runHealthChecks runs the checkers concurrently, the service is ready when all the checks pass.\n")
+	g.w.W("func runHealthChecks(ctx %s.Context, checks []healthCheck) (healthStatus, bool) {\n", contextPkg)
+	g.w.W("status := healthStatus{Status: \"ok\"}\n")
+	g.w.W("if len(checks) == 0 {\nreturn status, true\n}\n")
+	g.w.W("results := make(chan healthCheckResult, len(checks))\n")
+	g.w.W("for _, c := range checks {\n")
+	g.w.W("go func(c healthCheck) {\n")
+	g.w.W("var (\ncheckCtx %[1]s.Context\ncancel %[1]s.CancelFunc\n)\n", contextPkg)
+	g.w.W("if c.timeout > 0 {\n")
+	g.w.W("checkCtx, cancel = %s.WithTimeout(ctx, c.timeout)\n", contextPkg)
+	g.w.W("} else {\n")
+	g.w.W("checkCtx, cancel = %s.WithCancel(ctx)\n", contextPkg)
+	g.w.W("}\n")
+	g.w.W("defer cancel()\n")
+	g.w.W("done := make(chan error, 1)\n")
+	g.w.W("go func() {\ndone <- c.checker(checkCtx)\n}()\n")
+	g.w.W("select {\n")
+	g.w.W("case err := <-done:\nresults <- healthCheckResult{name: c.name, err: err}\n")
+	g.w.W("case <-checkCtx.Done():\nresults <- healthCheckResult{name: c.name, err: checkCtx.Err()}\n")
+	g.w.W("}\n")
+	g.w.W("}(c)\n")
+	g.w.W("}\n")
+	g.w.W("ready := true\n")
+	g.w.W("status.Checks = make(map[string]string, len(checks))\n")
+	g.w.W("for range checks {\n")
+	g.w.W("r := <-results\n")
+	g.w.W("if r.err != nil {\nready = false\nstatus.Checks[r.name] = r.err.Error()\ncontinue\n}\n")
+	g.w.W("status.Checks[r.name] = \"ok\"\n")
+	g.w.W("}\n")
+	g.w.W("if !ready {\nstatus.Status = \"unavailable\"\n}\n")
+	g.w.W("return status, ready\n")
+	g.w.W("}\n\n")
+
+	g.w.W("type serviceMethod struct {\n")
+	g.w.W("Name string `json:\"name\"`\n")
+	g.w.W("HTTPMethod string `json:\"httpMethod\"`\n")
+	g.w.W("Path string `json:\"path\"`\n")
+	g.w.W("}\n\n")
+
+	g.w.W("type serviceInfo struct {\n")
+	g.w.W("Name string `json:\"name\"`\n")
+	g.w.W("Version string `json:\"version\"`\n")
+	g.w.W("Methods []serviceMethod `json:\"methods\"`\n")
+	g.w.W("}\n\n")
+
+	g.w.W("var serviceMethods = []serviceMethod{\n")
+	for _, m := range methods {
+		g.w.W("{Name: %s, HTTPMethod: %s, Path: %s},\n", strconv.Quote(m.name), strconv.Quote(m.httpMethod), strconv.Quote(m.path))
+	}
+	g.w.W("}\n\n")
+
+	g.w.W("func newServiceInfo(version string) serviceInfo {\n")
+	g.w.W("if version == \"\" {\n")
+	g.w.W("if info, ok := %s.ReadBuildInfo(); ok {\nversion = info.Main.Version\n}\n", debugPkg)
+	g.w.W("}\n")
+	g.w.W("return serviceInfo{Name: %s, Version: version, Methods: serviceMethods}\n", strconv.Quote(g.AppName))
+	g.w.W("}\n\n")
+
+	g.w.W("func healthLiveHandler(ctx %s.Context) error {\n", echoPkg)
+	g.w.W("return ctx.JSON(%s.StatusOK, healthStatus{Status: \"ok\"})\n", httpPkg)
+	g.w.W("}\n\n")
+
+	g.w.W("func healthReadyHandler(checks []healthCheck) %s.HandlerFunc {\n", echoPkg)
+	g.w.W("return func(ctx %s.Context) error {\n", echoPkg)
+	g.w.W("status, ready := runHealthChecks(ctx.Request().Context(), checks)\n")
+	g.w.W("if !ready {\nreturn ctx.JSON(%s.StatusServiceUnavailable, status)\n}\n", httpPkg)
+	g.w.W("return ctx.JSON(%s.StatusOK, status)\n", httpPkg)
+	g.w.W("}\n}\n\n")
+
+	g.w.W("func serviceInfoHandler(info serviceInfo) %s.HandlerFunc {\n", echoPkg)
+	g.w.W("return func(ctx %s.Context) error {\n", echoPkg)
+	g.w.W("return ctx.JSON(%s.StatusOK, info)\n", httpPkg)
+	g.w.W("}\n}\n\n")
+}
+
+// writeHealthRoutes registers the liveness, readiness and service info endpoints,
+// they are registered before the service routes so the service methods replace them.
+func (g *RoutesGenerator) writeHealthRoutes() {
+	g.w.W("e.GET(\"/healthz\", healthLiveHandler)\n")
+	g.w.W("e.GET(\"/readyz\", healthReadyHandler(opts.healthChecks))\n")
+	g.w.W("e.GET(\"/info\", serviceInfoHandler(newServiceInfo(opts.version)))\n")
+}
@@ -13,15 +13,18 @@ import (
 )
 
 type Openapi struct {
-	Contact       config.OpenapiContact
-	Info          config.OpenapiInfo
-	MethodTags    map[string][]string
-	Servers       []config.OpenapiServer
-	Licence       config.OpenapiLicence
-	Output        string
-	Interfaces    []*config.Interface
-	MethodOptions map[string]config.MethodOptions
-	IfaceErrors   map[string]map[string][]finder.Error
+	Contact          config.OpenapiContact
+	Info             config.OpenapiInfo
+	MethodTags       map[string][]string
+	Servers          []config.OpenapiServer
+	Licence          config.OpenapiLicence
+	Output           string
+	Interfaces       []*config.Interface
+	MethodOptions    map[string]config.MethodOptions
+	IfaceErrors      map[string]map[string][]finder.Error
+	ValidationEnable bool
+	Version          string
+	Webhooks         []config.OpenapiWebhook
 }
 
 func (g *Openapi) Generate(ctx context.Context) []byte {
@@ -49,6 +52,7 @@ func (g *Openapi) Generate(ctx context.Context) []byte {
 				RESTQueryValues:  mopt.RESTQueryValues.Value,
 				RESTHeaderVars:   mopt.RESTHeaderVars.Value,
 				BearerAuth:       mopt.BearerAuth != nil,
+				Validation:       mopt.Validation.Take(),
 			})
 		}
 		interfaces = append(interfaces, openapiIface)
@@ -72,7 +76,20 @@ func (g *Openapi) Generate(ctx context.Context) []byte {
 		map[string]map[string][]openapi.Error{},
 		false,
 	)
-	result := o.Build()
+	var webhooks []openapi.Webhook
+	for _, w := range g.Webhooks {
+		m := webhookMethod(w.Method)
+		webhooks = append(webhooks, openapi.Webhook{
+			Name: w.Name,
+			Method: openapi.InterfaceMethod{
+				Name:        m.Name,
+				RESTMethod:  "POST",
+				Func:        m,
+				Description: m.Comment,
+			},
+		})
+	}
+	result := o.SetValidation(g.ValidationEnable).SetVersion(g.Version).SetWebhooks(webhooks).Build()
 	data, _ := yaml.Marshal(result)
 	return data
 }
@@ -84,3 +101,18 @@ func (g *Openapi) OutputPath() string {
 func (g *Openapi) Filename() string {
 	return "openapi.yaml"
 }
+
+// webhookMethod returns the interface method with its comment, the signature is used when the receiver is not an interface.
+func webhookMethod(named *option.NamedType) *option.FuncType {
+	sig := named.Type.(*option.SignType)
+	if recv, ok := sig.Recv.(*option.NamedType); ok {
+		if ifaceType, ok := recv.Type.(*option.IfaceType); ok {
+			for _, m := range ifaceType.Methods {
+				if m.Name.Value == named.Name.Value {
+					return m
+				}
+			}
+		}
+	}
+	return &option.FuncType{Name: named.Name, Sig: sig}
+}
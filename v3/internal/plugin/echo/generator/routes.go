@@ -18,9 +18,12 @@ import (
 )
 
 type RoutesGenerator struct {
-	w             writer.GoWriter
-	Interfaces    []*config.Interface
-	MethodOptions map[string]config.MethodOptions
+	w               writer.GoWriter
+	Interfaces      []*config.Interface
+	CORS            *config.HTTPCORS
+	HealthEndpoints *struct{}
+	AppName         string
+	MethodOptions   map[string]config.MethodOptions
 }
 
 func (g *RoutesGenerator) Generate(ctx context.Context) []byte {
@@ -32,6 +35,10 @@ func (g *RoutesGenerator) Generate(ctx context.Context) []byte {
 	g.writeDefaultErrorEncoder(echoPkg, httpPkg)
 	g.writeEncodeResponseFunc(echoPkg, httpPkg)
 
+	if g.CORS != nil {
+		g.writeCORS(echoPkg, httpPkg)
+	}
+
 	g.w.W("type Option func(*opts)\n")
 	g.w.W("type ServerOption func(*serverOpts)\n\n")
 
@@ -42,7 +49,15 @@ func (g *RoutesGenerator) Generate(ctx context.Context) []byte {
 		}
 	}
 
+	if g.HealthEndpoints != nil {
+		g.writeHealthServerOptions(importer)
+	}
+
 	g.w.W("type serverOpts struct {\ngenericOpts opts\n")
+	if g.HealthEndpoints != nil {
+		g.w.W("healthChecks []healthCheck\n")
+		g.w.W("version string\n")
+	}
 	for _, iface := range g.Interfaces {
 		ifaceType := iface.Named.Type.(*option.IfaceType)
 
@@ -86,7 +101,20 @@ func (g *RoutesGenerator) Generate(ctx context.Context) []byte {
 
 	g.w.W("opts := &serverOpts{}\nfor _, o := range options {\no(opts)\n}\n")
 
-	var contextParamFound bool
+	if g.CORS != nil {
+		g.w.W("opts.genericOpts.middlewares = append([]%s.MiddlewareFunc{corsMiddleware}, opts.genericOpts.middlewares...)\n", echoPkg)
+	}
+	if g.HealthEndpoints != nil {
+		g.writeHealthRoutes()
+	}
+
+	var (
+		contextParamFound bool
+		corsPaths         []string
+		serviceMethods    []serviceMethod
+	)
+	corsMethods := map[string][]string{}
+	corsHeaders := map[string][]string{}
 
 	for _, iface := range g.Interfaces {
 		ifaceType := iface.Named.Type.(*option.IfaceType)
@@ -140,14 +168,26 @@ func (g *RoutesGenerator) Generate(ctx context.Context) []byte {
 				urlPath = "/" + urlPath
 			}
 
+			httpMethod := "GET"
+			if mopt.RESTMethod.Take() != "" {
+				httpMethod = stdstrings.ToUpper(mopt.RESTMethod.Take())
+			}
+
+			methodName := m.Name.Lower()
+			if iface.Namespace != "" {
+				methodName = iface.Namespace + "." + methodName
+			}
+			serviceMethods = append(serviceMethods, serviceMethod{name: methodName, httpMethod: httpMethod, path: urlPath})
+
 			// replace brace indices for echo router
 			urlPath = stdstrings.ReplaceAll(urlPath, "{", ":")
 			urlPath = stdstrings.ReplaceAll(urlPath, "}", "")
 
-			httpMethod := "GET"
-			if mopt.RESTMethod.Take() != "" {
-				httpMethod = stdstrings.ToUpper(mopt.RESTMethod.Take())
+			if _, ok := corsMethods[urlPath]; !ok {
+				corsPaths = append(corsPaths, urlPath)
 			}
+			corsMethods[urlPath] = append(corsMethods[urlPath], httpMethod)
+			corsHeaders[urlPath] = appendCORSValues(corsHeaders[urlPath], corsMethodHeaders(httpMethod, m, mopt)...)
 
 			g.w.W("e.%s(%s, func(ctx %s.Context) (err error) {\n", httpMethod, strconv.Quote(urlPath), echoPkg)
 
@@ -350,8 +390,30 @@ func (g *RoutesGenerator) Generate(ctx context.Context) []byte {
 		}
 	}
 
+	if g.CORS != nil {
+		for _, urlPath := range corsPaths {
+			// the configured methods are narrowed to the ones served by the route
+			var methods []string
+			for _, m := range corsMethods[urlPath] {
+				if len(g.CORS.Methods) == 0 || containsCORSValue(g.CORS.Methods, m) {
+					methods = append(methods, m)
+				}
+			}
+			args := strconv.Quote(stdstrings.Join(append(methods, "OPTIONS"), ", "))
+			// the configured allowed headers are extended, otherwise the preflight allows the requested ones
+			if len(g.CORS.Headers) > 0 {
+				args += ", " + strconv.Quote(stdstrings.Join(appendCORSValues(append([]string{}, g.CORS.Headers...), corsHeaders[urlPath]...), ", "))
+			}
+			g.w.W("e.OPTIONS(%s, corsPreflightHandler(%s), corsMiddleware)\n", strconv.Quote(urlPath), args)
+		}
+	}
+
 	g.w.W("\n}\n")
 
+	if g.HealthEndpoints != nil {
+		g.writeHealth(importer, echoPkg, httpPkg, serviceMethods)
+	}
+
 	if contextParamFound {
 		contextPkg := importer.Import("context", "context")
 		timePkg := importer.Import("time", "time")
@@ -361,6 +423,100 @@ func (g *RoutesGenerator) Generate(ctx context.Context) []byte {
 	return g.w.Bytes()
 }
 
+func (g *RoutesGenerator) writeCORS(echoPkg, httpPkg string) {
+	g.w.W("var corsAllowedOrigins = []string{")
+	for i, origin := range g.CORS.Origins {
+		if i > 0 {
+			g.w.W(", ")
+		}
+		g.w.W(strconv.Quote(origin))
+	}
+	g.w.W("}\n\n")
+
+	g.w.W("func corsAllowOrigin(origin string) (string, bool) {\n")
+	g.w.W("if origin == \"\" {\nreturn \"\", false\n}\n")
+	g.w.W("for _, o := range corsAllowedOrigins {\n")
+	g.w.W("if o == \"*\" {\nreturn \"*\", true\n}\n")
+	g.w.W("if o == origin {\nreturn origin, true\n}\n")
+	g.w.W("}\n")
+	g.w.W("return \"\", false\n")
+	g.w.W("}\n\n")
+
+	g.w.W("func corsMiddleware(next %[1]s.HandlerFunc) %[1]s.HandlerFunc {\n", echoPkg)
+	g.w.W("return func(ctx %s.Context) error {\n", echoPkg)
+	g.w.W("if origin, ok := corsAllowOrigin(ctx.Request().Header.Get(\"Origin\")); ok {\n")
+	g.w.W("ctx.Response().Header().Set(\"Access-Control-Allow-Origin\", origin)\n")
+	g.w.W("ctx.Response().Header().Add(\"Vary\", \"Origin\")\n")
+	g.w.W("}\n")
+	g.w.W("return next(ctx)\n")
+	g.w.W("}\n")
+	g.w.W("}\n\n")
+
+	if len(g.CORS.Headers) > 0 {
+		g.w.W("func corsPreflightHandler(methods, headers string) %s.HandlerFunc {\n", echoPkg)
+	} else {
+		g.w.W("func corsPreflightHandler(methods string) %s.HandlerFunc {\n", echoPkg)
+	}
+	g.w.W("return func(ctx %s.Context) error {\n", echoPkg)
+	g.w.W("if _, ok := corsAllowOrigin(ctx.Request().Header.Get(\"Origin\")); !ok {\n")
+	g.w.W("return ctx.NoContent(%s.StatusForbidden)\n", httpPkg)
+	g.w.W("}\n")
+	g.w.W("ctx.Response().Header().Set(\"Access-Control-Allow-Methods\", methods)\n")
+	if len(g.CORS.Headers) > 0 {
+		g.w.W("ctx.Response().Header().Set(\"Access-Control-Allow-Headers\", headers)\n")
+	} else {
+		g.w.W("if headers := ctx.Request().Header.Get(\"Access-Control-Request-Headers\"); headers != \"\" {\n")
+		g.w.W("ctx.Response().Header().Set(\"Access-Control-Allow-Headers\", headers)\n")
+		g.w.W("}\n")
+	}
+	if g.CORS.MaxAge > 0 {
+		g.w.W("ctx.Response().Header().Set(\"Access-Control-Max-Age\", %s)\n", strconv.Quote(strconv.FormatInt(g.CORS.MaxAge, 10)))
+	}
+	g.w.W("return ctx.NoContent(%s.StatusNoContent)\n", httpPkg)
+	g.w.W("}\n")
+	g.w.W("}\n\n")
+}
+
+// containsCORSValue reports whether the list contains the value, the header and the method names are case-insensitive.
+func containsCORSValue(list []string, v string) bool {
+	for _, l := range list {
+		if stdstrings.EqualFold(l, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func appendCORSValues(list []string, values ...string) []string {
+	for _, v := range values {
+		if !containsCORSValue(list, v) {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+// corsMethodHeaders returns the request headers sent to the method by the generated client.
+func corsMethodHeaders(httpMethod string, m *option.FuncType, mopt config.MethodOptions) []string {
+	var headers []string
+	if mopt.BearerAuth != nil {
+		headers = append(headers, "Authorization")
+	}
+	switch httpMethod {
+	case "POST", "PUT", "PATCH":
+		for _, p := range m.Sig.Params {
+			if !plugin.IsContext(p) {
+				headers = append(headers, "Content-Type")
+				break
+			}
+		}
+	}
+	for i := 0; i < len(mopt.RESTHeaderVars.Value); i += 2 {
+		headers = append(headers, mopt.RESTHeaderVars.Value[i])
+	}
+	return headers
+}
+
 func (g *RoutesGenerator) writeContextWrapper(echoPkg, contextPkg, timePkg string) {
 	g.w.W("type contextWrapper struct {\n")
 	g.w.W("ctx %s.Context\n", echoPkg)
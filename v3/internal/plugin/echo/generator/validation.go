@@ -0,0 +1,59 @@
+package generator
+
+import (
+	"context"
+
+	"github.com/swipe-io/swipe/v3/internal/plugin/echo/config"
+	"github.com/swipe-io/swipe/v3/internal/validation"
+	"github.com/swipe-io/swipe/v3/option"
+	"github.com/swipe-io/swipe/v3/swipe"
+	"github.com/swipe-io/swipe/v3/writer"
+)
+
+type Validation struct {
+	w             writer.GoWriter
+	Interfaces    []*config.Interface
+	MethodOptions map[string]config.MethodOptions
+	Output        string
+	Pkg           string
+}
+
+func (g *Validation) Generate(ctx context.Context) []byte {
+	importer := ctx.Value(swipe.ImporterKey).(swipe.Importer)
+
+	var interfaces []validation.Interface
+	for _, iface := range g.Interfaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+		validationInterface := validation.Interface{
+			TypeName: NameInterface(iface),
+			LcName:   LcNameWithAppPrefix(iface),
+			UcName:   UcNameWithAppPrefix(iface),
+		}
+		for _, method := range ifaceType.Methods {
+			mopt := g.MethodOptions[iface.Named.Name.Value+method.Name.Value]
+			validationInterface.Methods = append(validationInterface.Methods, validation.Method{
+				Name:   method.Name,
+				Sig:    method.Sig,
+				Enable: mopt.Validation.Take(),
+			})
+		}
+		interfaces = append(interfaces, validationInterface)
+	}
+
+	data := validation.NewValidation(importer).SetInterfaces(interfaces).Build()
+	_, _ = g.w.Write(data)
+
+	return g.w.Bytes()
+}
+
+func (g *Validation) Package() string {
+	return g.Pkg
+}
+
+func (g *Validation) OutputPath() string {
+	return g.Output
+}
+
+func (g *Validation) Filename() string {
+	return "validation.go"
+}
@@ -1,6 +1,9 @@
 package echo
 
 import (
+	"errors"
+	"fmt"
+	"path"
 	"path/filepath"
 
 	"github.com/mitchellh/mapstructure"
@@ -33,6 +36,23 @@ func (p *Plugin) Configure(cfg *swipe.Config, module *option.Module, options map
 	if err := mapstructure.Decode(options, &p.config); err != nil {
 		return []error{err}
 	}
+	_, appName := path.Split(module.Path)
+	p.config.AppName = strcase.ToCamel(appName)
+	if p.config.HTTPCORS != nil && len(p.config.HTTPCORS.Origins) == 0 {
+		return []error{errors.New("HTTPCORS: at least one origin is required")}
+	}
+	errs = plugin.ValidateOpenapiVersion(p.config.OpenapiVersion.Take(), len(p.config.OpenapiWebhooks) > 0)
+	for _, w := range p.config.OpenapiWebhooks {
+		if _, ok := w.Method.Type.(*option.SignType); !ok {
+			errs = append(errs, fmt.Errorf("OpenapiWebhook: %s is not a method", w.Method.Name))
+		}
+		if w.Name == "" {
+			errs = append(errs, fmt.Errorf("OpenapiWebhook: %s: name is required", w.Method.Name))
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
 
 	p.config.MethodOptionsMap = map[string]config.MethodOptions{}
 
@@ -67,6 +87,13 @@ func (p *Plugin) Configure(cfg *swipe.Config, module *option.Module, options map
 			}
 			dstMethodOption.RESTPathVars = pathVars
 
+			if !dstMethodOption.Validation.IsValid() {
+				dstMethodOption.Validation = p.config.MethodDefaultOptions.Validation
+			}
+			if !p.config.ValidationEnable && dstMethodOption.Validation.Take() {
+				p.config.ValidationEnable = true
+			}
+
 			p.config.MethodOptionsMap[iface.Named.Name.Value+m.Name.Value] = dstMethodOption
 		}
 	}
@@ -85,8 +112,11 @@ func (p *Plugin) Generators() ([]swipe.Generator, []error) {
 
 	generators := []swipe.Generator{
 		&generator.RoutesGenerator{
-			Interfaces:    p.config.Interfaces,
-			MethodOptions: p.config.MethodOptionsMap,
+			Interfaces:      p.config.Interfaces,
+			CORS:            p.config.HTTPCORS,
+			HealthEndpoints: p.config.HealthEndpoints,
+			AppName:         p.config.AppName,
+			MethodOptions:   p.config.MethodOptionsMap,
 		},
 		&generator.InterfaceGenerator{
 			Interfaces: p.config.Interfaces,
@@ -98,6 +128,12 @@ func (p *Plugin) Generators() ([]swipe.Generator, []error) {
 			Interfaces: p.config.Interfaces,
 		},
 	}
+	if p.config.ValidationEnable {
+		generators = append(generators, &generator.Validation{
+			Interfaces:    p.config.Interfaces,
+			MethodOptions: p.config.MethodOptionsMap,
+		})
+	}
 	if p.config.ClientEnable != nil {
 		output := p.config.ClientOutput.Take()
 		if output == "" {
@@ -126,15 +162,18 @@ func (p *Plugin) Generators() ([]swipe.Generator, []error) {
 	}
 	if p.config.OpenapiEnable != nil {
 		generators = append(generators, &generator.Openapi{
-			Contact:       p.config.OpenapiContact,
-			Info:          p.config.OpenapiInfo,
-			MethodTags:    p.config.OpenapiMethodTags,
-			Licence:       p.config.OpenapiLicence,
-			Servers:       p.config.OpenapiServers,
-			Output:        p.config.OpenapiOutput.Take(),
-			Interfaces:    p.config.Interfaces,
-			MethodOptions: p.config.MethodOptionsMap,
-			IfaceErrors:   p.config.IfaceErrors,
+			Contact:          p.config.OpenapiContact,
+			Info:             p.config.OpenapiInfo,
+			MethodTags:       p.config.OpenapiMethodTags,
+			Licence:          p.config.OpenapiLicence,
+			Servers:          p.config.OpenapiServers,
+			Output:           p.config.OpenapiOutput.Take(),
+			Interfaces:       p.config.Interfaces,
+			MethodOptions:    p.config.MethodOptionsMap,
+			IfaceErrors:      p.config.IfaceErrors,
+			ValidationEnable: p.config.ValidationEnable,
+			Version:          p.config.OpenapiVersion.Take(),
+			Webhooks:         p.config.OpenapiWebhooks,
 		})
 	}
 	return generators, nil
@@ -19,10 +19,22 @@ type ExternalInterface struct {
 }
 
 type Interface struct {
-	Named      *option.NamedType  `mapstructure:"iface"`
-	Namespace  string             `mapstructure:"ns"`
-	ClientName option.StringValue `swipe:"option"`
-	Gateway    *struct{}          `swipe:"option"`
+	Named        *option.NamedType  `mapstructure:"iface"`
+	Namespace    string             `mapstructure:"ns"`
+	ClientName   option.StringValue `swipe:"option"`
+	Gateway      *struct{}          `swipe:"option"`
+	Version      option.StringValue `mapstructure:"InterfaceVersion" swipe:"option"`
+	Deprecations []Deprecation      `mapstructure:"Deprecated" swipe:"option"`
+
+	DeprecatedMethods map[string]Deprecation `mapstructure:"-"`
+}
+
+// Deprecation marks the methods of the interface version as deprecated since the date,
+// the methods are removed at the sunset date, the dates are in the 2006-01-02 format.
+type Deprecation struct {
+	Methods []option.NamedType `mapstructure:"methods"`
+	Since   string             `mapstructure:"since"`
+	Sunset  string             `mapstructure:"sunset"`
 }
 
 type LoggingParams struct {
@@ -30,6 +42,41 @@ type LoggingParams struct {
 	Excludes []string
 }
 
+type TracingParams struct {
+	Includes []string
+	Excludes []string
+}
+
+type APIKeyAuth struct {
+	In   string
+	Name string
+}
+
+type HTTPCORS struct {
+	Origins []string
+	Methods []string
+	Headers []string
+	MaxAge  int64
+}
+
+type CodecsEnable struct {
+	Codecs []string
+}
+
+func (c *CodecsEnable) Contains(v string) bool {
+	for _, n := range c.Codecs {
+		if v == n {
+			return true
+		}
+	}
+	return false
+}
+
+type RateLimit struct {
+	Rps   float64
+	Burst int64
+}
+
 type LoggingContext struct {
 	Key  interface{}
 	Name string
@@ -59,6 +106,14 @@ type MethodOptions struct {
 	Logging                option.BoolValue        `swipe:"option"`
 	LoggingParams          LoggingParams           `swipe:"option"`
 	LoggingContext         []LoggingContext        `swipe:"option"`
+	Tracing                option.BoolValue        `swipe:"option"`
+	TracingParams          TracingParams           `swipe:"option"`
+	Validation             option.BoolValue        `swipe:"option"`
+	BearerAuth             *struct{}               `swipe:"option"`
+	APIKeyAuth             *APIKeyAuth             `swipe:"option"`
+	BasicAuth              *struct{}               `swipe:"option"`
+	RateLimit              *RateLimit              `swipe:"option"`
+	RateLimitKeyHeader     option.StringValue      `swipe:"option"`
 	RESTMethod             option.ExprStringValue  `swipe:"option"`
 	RESTWrapResponse       option.StringValue      `swipe:"option"`
 	RESTWrapRequest        option.StringValue      `swipe:"option"`
@@ -69,6 +124,9 @@ type MethodOptions struct {
 	RESTQueryValues        option.SliceStringValue `swipe:"option"`
 	RESTPathVars           map[string]string       `swipe:"option"`
 	RESTBodyType           option.StringValue      `swipe:"option"`
+	RESTCodec              option.StringValue      `swipe:"option"`
+	Paginated              option.StringValue      `swipe:"option"`
+	Idempotent             *struct{}               `swipe:"option"`
 	ErrorDecode            MethodErrorDecode       `swipe:"option"`
 
 	//Aggregate              []Aggregate       `swipe:"option"`
@@ -106,6 +164,11 @@ type OpenapiTag struct {
 	Tags    []string           `mapstructure:"tags"`
 }
 
+type OpenapiWebhook struct {
+	Method *option.NamedType `mapstructure:"method"`
+	Name   string            `mapstructure:"name"`
+}
+
 type Langs []string
 
 type ClientsEnable struct {
@@ -126,15 +189,32 @@ func (a Langs) Contains(v string) bool {
 type Config struct {
 	HTTPServer           *struct{}
 	HTTPFast             *struct{}
+	HTTPCORS             *HTTPCORS
+	HealthEndpoints      *struct{}
+	RESTErrorFormat      option.StringValue
+	CodecsEnable         *CodecsEnable
 	ClientsEnable        ClientsEnable
 	ClientOutput         option.StringValue
+	PythonClientOutput   option.StringValue
+	KotlinClientOutput   option.StringValue
+	SwiftClientOutput    option.StringValue
 	CURLEnable           *struct{}
 	CURLOutput           option.StringValue
 	CURLURL              option.StringValue
+	PostmanEnable        *struct{}
+	PostmanOutput        option.StringValue
+	HTTPFileEnable       *struct{}
+	HTTPFileOutput       option.StringValue
 	JSONRPCEnable        *struct{}
 	JSONRPCPath          option.StringValue
 	JSONRPCDocEnable     *struct{}
 	JSONRPCDocOutput     option.StringValue
+	OpenRPCEnable        *struct{}
+	OpenRPCOutput        option.StringValue
+	MockEnable           *struct{}
+	MockOutput           option.StringValue
+	ContractTestsEnable  *struct{}
+	FuzzEnable           *struct{}
 	Interfaces           []*Interface `mapstructure:"Interface"`
 	OpenapiEnable        *struct{}
 	OpenapiTags          []OpenapiTag
@@ -143,6 +223,8 @@ type Config struct {
 	OpenapiContact       OpenapiContact
 	OpenapiLicence       OpenapiLicence
 	OpenapiServers       []OpenapiServer `mapstructure:"OpenapiServer"`
+	OpenapiVersion       option.StringValue
+	OpenapiWebhooks      []OpenapiWebhook `mapstructure:"OpenapiWebhook"`
 	MethodOptions        []MethodOption
 	MethodDefaultOptions MethodOptions
 	InstrumentingLabels  []InstrumentingLabel `swipe:"option"`
@@ -150,10 +232,14 @@ type Config struct {
 	// non options params
 	LoggingEnable       bool                          `mapstructure:"-"`
 	InstrumentingEnable bool                          `mapstructure:"-"`
+	TracingEnable       bool                          `mapstructure:"-"`
+	ValidationEnable    bool                          `mapstructure:"-"`
+	AuthEnable          bool                          `mapstructure:"-"`
 	MethodOptionsMap    map[string]MethodOptions      `mapstructure:"-"`
 	OpenapiMethodTags   map[string][]string           `mapstructure:"-"`
 	IfaceErrors         map[string]map[string][]Error `mapstructure:"-"`
 	JSPkgImportPath     string                        `mapstructure:"-"`
 	AppName             string                        `mapstructure:"-"`
 	HasExternal         bool                          `mapstructure:"-"`
+	ModulePath          string                        `mapstructure:"-"`
 }
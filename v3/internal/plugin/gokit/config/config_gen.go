@@ -1,5 +1,5 @@
 package config
 
 func (*Config) Options() []byte {
-	return []byte("// Gokit\nfunc Gokit(opts ...GokitOption) {}\n\n// GokitOption ...\ntype GokitOption string\n\n// HTTPServer ...\nfunc HTTPServer() GokitOption { return \"implementation not generated, run swipe\" }\n\n// HTTPFast ...\nfunc HTTPFast() GokitOption { return \"implementation not generated, run swipe\" }\n\n// ClientsEnable ...\nfunc ClientsEnable(langs []string) GokitOption { return \"implementation not generated, run swipe\" }\n\n// ClientOutput ...\nfunc ClientOutput(value string) GokitOption { return \"implementation not generated, run swipe\" }\n\n// CURLEnable ...\nfunc CURLEnable() GokitOption { return \"implementation not generated, run swipe\" }\n\n// CURLOutput ...\nfunc CURLOutput(value string) GokitOption { return \"implementation not generated, run swipe\" }\n\n// CURLURL ...\nfunc CURLURL(value string) GokitOption { return \"implementation not generated, run swipe\" }\n\n// JSONRPCEnable ...\nfunc JSONRPCEnable() GokitOption { return \"implementation not generated, run swipe\" }\n\n// JSONRPCPath ...\nfunc JSONRPCPath(value string) GokitOption { return \"implementation not generated, run swipe\" }\n\n// JSONRPCDocEnable ...\nfunc JSONRPCDocEnable() GokitOption { return \"implementation not generated, run swipe\" }\n\n// JSONRPCDocOutput ...\nfunc JSONRPCDocOutput(value string) GokitOption { return \"implementation not generated, run swipe\" }\n\n// InterfaceOption ...\ntype InterfaceOption string\n\n// ClientName ...\nfunc ClientName(value string) InterfaceOption { return \"implementation not generated, run swipe\" }\n\n// Gateway ...\nfunc Gateway() InterfaceOption { return \"implementation not generated, run swipe\" }\n\n// Interface ...\n// @type:\"repeat\"\nfunc Interface(iface interface{}, ns string, opts ...InterfaceOption) GokitOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// OpenapiEnable ...\nfunc OpenapiEnable() GokitOption { return \"implementation not generated, run swipe\" }\n\n// OpenapiTags ...\n// @type:\"repeat\"\nfunc OpenapiTags(methods []interface{}, tags []string) GokitOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// OpenapiOutput ...\nfunc OpenapiOutput(value string) GokitOption { return \"implementation not generated, run swipe\" }\n\n// OpenapiInfo ...\nfunc OpenapiInfo(title string, description string, version interface{}) GokitOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// OpenapiContact ...\nfunc OpenapiContact(name string, email string, url string) GokitOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// OpenapiLicence ...\nfunc OpenapiLicence(name string, url string) GokitOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// OpenapiServer ...\n// @type:\"repeat\"\nfunc OpenapiServer(description string, url string) GokitOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// MethodOptionsOption ...\ntype MethodOptionsOption string\n\n// Instrumenting ...\nfunc Instrumenting(value bool) MethodOptionsOption { return \"implementation not generated, run swipe\" }\n\n// Logging ...\nfunc Logging(value bool) MethodOptionsOption { return \"implementation not generated, run swipe\" }\n\n// LoggingParams ...\nfunc LoggingParams(includes []string, excludes []string) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// LoggingContext ...\n// @type:\"repeat\"\nfunc LoggingContext(key interface{}, name string) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTMethod ...\nfunc RESTMethod(value interface{}) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTWrapResponse ...\nfunc RESTWrapResponse(value string) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTWrapRequest ...\nfunc RESTWrapRequest(value string) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTPath ...\nfunc RESTPath(value interface{}) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTMultipartMaxMemory ...\nfunc RESTMultipartMaxMemory(value int64) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTHeaderVars ...\nfunc RESTHeaderVars(value []string) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTQueryVars ...\nfunc RESTQueryVars(value []string) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTQueryValues ...\nfunc RESTQueryValues(value []string) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTBodyType ...\nfunc RESTBodyType(value string) MethodOptionsOption { return \"implementation not generated, run swipe\" }\n\n// ErrorDecode ...\nfunc ErrorDecode(fn interface{}) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// MethodOptions ...\n// @type:\"repeat\"\nfunc MethodOptions(signature interface{}, opts ...MethodOptionsOption) GokitOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// MethodDefaultOptions ...\nfunc MethodDefaultOptions(opts ...MethodOptionsOption) GokitOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// InstrumentingLabels ...\n// @type:\"repeat\"\nfunc InstrumentingLabels(key interface{}, name string) GokitOption {\n\treturn \"implementation not generated, run swipe\"\n}\n")
+	return []byte("// Gokit\nfunc Gokit(opts ...GokitOption) {}\n\n// GokitOption ...\ntype GokitOption string\n\n// HTTPServer ...\nfunc HTTPServer() GokitOption { return \"implementation not generated, run swipe\" }\n\n// HTTPFast ...\nfunc HTTPFast() GokitOption { return \"implementation not generated, run swipe\" }\n\n// HTTPCORS ...\nfunc HTTPCORS(origins []string, methods []string, headers []string, maxAge int64) GokitOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// HealthEndpoints ...\nfunc HealthEndpoints() GokitOption { return \"implementation not generated, run swipe\" }\n\n// RESTErrorFormat ...\nfunc RESTErrorFormat(value string) GokitOption { return \"implementation not generated, run swipe\" }\n\n// CodecsEnable ...\nfunc CodecsEnable(codecs []string) GokitOption { return \"implementation not generated, run swipe\" }\n\n// ClientsEnable ...\nfunc ClientsEnable(langs []string) GokitOption { return \"implementation not generated, run swipe\" }\n\n// ClientOutput ...\nfunc ClientOutput(value string) GokitOption { return \"implementation not generated, run swipe\" }\n\n// PythonClientOutput ...\nfunc PythonClientOutput(value string) GokitOption { return \"implementation not generated, run swipe\" }\n\n// KotlinClientOutput ...\nfunc KotlinClientOutput(value string) GokitOption { return \"implementation not generated, run swipe\" }\n\n// SwiftClientOutput ...\nfunc SwiftClientOutput(value string) GokitOption { return \"implementation not generated, run swipe\" }\n\n// CURLEnable ...\nfunc CURLEnable() GokitOption { return \"implementation not generated, run swipe\" }\n\n// CURLOutput ...\nfunc CURLOutput(value string) GokitOption { return \"implementation not generated, run swipe\" }\n\n// CURLURL ...\nfunc CURLURL(value string) GokitOption { return \"implementation not generated, run swipe\" }\n\n// PostmanEnable ...\nfunc PostmanEnable() GokitOption { return \"implementation not generated, run swipe\" }\n\n// PostmanOutput ...\nfunc PostmanOutput(value string) GokitOption { return \"implementation not generated, run swipe\" }\n\n// HTTPFileEnable ...\nfunc HTTPFileEnable() GokitOption { return \"implementation not generated, run swipe\" }\n\n// HTTPFileOutput ...\nfunc HTTPFileOutput(value string) GokitOption { return \"implementation not generated, run swipe\" }\n\n// JSONRPCEnable ...\nfunc JSONRPCEnable() GokitOption { return \"implementation not generated, run swipe\" }\n\n// JSONRPCPath ...\nfunc JSONRPCPath(value string) GokitOption { return \"implementation not generated, run swipe\" }\n\n// JSONRPCDocEnable ...\nfunc JSONRPCDocEnable() GokitOption { return \"implementation not generated, run swipe\" }\n\n// JSONRPCDocOutput ...\nfunc JSONRPCDocOutput(value string) GokitOption { return \"implementation not generated, run swipe\" }\n\n// OpenRPCEnable ...\nfunc OpenRPCEnable() GokitOption { return \"implementation not generated, run swipe\" }\n\n// OpenRPCOutput ...\nfunc OpenRPCOutput(value string) GokitOption { return \"implementation not generated, run swipe\" }\n\n// MockEnable ...\nfunc MockEnable() GokitOption { return \"implementation not generated, run swipe\" }\n\n// MockOutput ...\nfunc MockOutput(value string) GokitOption { return \"implementation not generated, run swipe\" }\n\n// ContractTestsEnable ...\nfunc ContractTestsEnable() GokitOption { return \"implementation not generated, run swipe\" }\n\n// FuzzEnable ...\nfunc FuzzEnable() GokitOption { return \"implementation not generated, run swipe\" }\n\n// InterfaceOption ...\ntype InterfaceOption string\n\n// ClientName ...\nfunc ClientName(value string) InterfaceOption { return \"implementation not generated, run swipe\" }\n\n// Gateway ...\nfunc Gateway() InterfaceOption { return \"implementation not generated, run swipe\" }\n\n// InterfaceVersion ...\nfunc InterfaceVersion(value string) InterfaceOption { return \"implementation not generated, run swipe\" }\n\n// Deprecated ...\n// @type:\"repeat\"\nfunc Deprecated(methods []interface{}, since string, sunset string) InterfaceOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// Interface ...\n// @type:\"repeat\"\nfunc Interface(iface interface{}, ns string, opts ...InterfaceOption) GokitOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// OpenapiEnable ...\nfunc OpenapiEnable() GokitOption { return \"implementation not generated, run swipe\" }\n\n// OpenapiTags ...\n// @type:\"repeat\"\nfunc OpenapiTags(methods []interface{}, tags []string) GokitOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// OpenapiOutput ...\nfunc OpenapiOutput(value string) GokitOption { return \"implementation not generated, run swipe\" }\n\n// OpenapiInfo ...\nfunc OpenapiInfo(title string, description string, version interface{}) GokitOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// OpenapiContact ...\nfunc OpenapiContact(name string, email string, url string) GokitOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// OpenapiLicence ...\nfunc OpenapiLicence(name string, url string) GokitOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// OpenapiServer ...\n// @type:\"repeat\"\nfunc OpenapiServer(description string, url string) GokitOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// OpenapiVersion ...\nfunc OpenapiVersion(value string) GokitOption { return \"implementation not generated, run swipe\" }\n\n// OpenapiWebhook ...\n// @type:\"repeat\"\nfunc OpenapiWebhook(method interface{}, name string) GokitOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// MethodOptionsOption ...\ntype MethodOptionsOption string\n\n// Instrumenting ...\nfunc Instrumenting(value bool) MethodOptionsOption { return \"implementation not generated, run swipe\" }\n\n// Logging ...\nfunc Logging(value bool) MethodOptionsOption { return \"implementation not generated, run swipe\" }\n\n// LoggingParams ...\nfunc LoggingParams(includes []string, excludes []string) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// LoggingContext ...\n// @type:\"repeat\"\nfunc LoggingContext(key interface{}, name string) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// Tracing ...\nfunc Tracing(value bool) MethodOptionsOption { return \"implementation not generated, run swipe\" }\n\n// TracingParams ...\nfunc TracingParams(includes []string, excludes []string) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// Validation ...\nfunc Validation(value bool) MethodOptionsOption { return \"implementation not generated, run swipe\" }\n\n// BearerAuth ...\nfunc BearerAuth() MethodOptionsOption { return \"implementation not generated, run swipe\" }\n\n// APIKeyAuth ...\nfunc APIKeyAuth(in string, name string) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// BasicAuth ...\nfunc BasicAuth() MethodOptionsOption { return \"implementation not generated, run swipe\" }\n\n// RateLimit ...\nfunc RateLimit(rps float64, burst int64) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RateLimitKeyHeader ...\nfunc RateLimitKeyHeader(value string) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTMethod ...\nfunc RESTMethod(value interface{}) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTWrapResponse ...\nfunc RESTWrapResponse(value string) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTWrapRequest ...\nfunc RESTWrapRequest(value string) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTPath ...\nfunc RESTPath(value interface{}) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTMultipartMaxMemory ...\nfunc RESTMultipartMaxMemory(value int64) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTHeaderVars ...\nfunc RESTHeaderVars(value []string) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTQueryVars ...\nfunc RESTQueryVars(value []string) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTQueryValues ...\nfunc RESTQueryValues(value []string) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// RESTBodyType ...\nfunc RESTBodyType(value string) MethodOptionsOption { return \"implementation not generated, run swipe\" }\n\n// RESTCodec ...\nfunc RESTCodec(value string) MethodOptionsOption { return \"implementation not generated, run swipe\" }\n\n// Paginated ...\nfunc Paginated(value string) MethodOptionsOption { return \"implementation not generated, run swipe\" }\n\n// Idempotent ...\nfunc Idempotent() MethodOptionsOption { return \"implementation not generated, run swipe\" }\n\n// ErrorDecode ...\nfunc ErrorDecode(fn interface{}) MethodOptionsOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// MethodOptions ...\n// @type:\"repeat\"\nfunc MethodOptions(signature interface{}, opts ...MethodOptionsOption) GokitOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// MethodDefaultOptions ...\nfunc MethodDefaultOptions(opts ...MethodOptionsOption) GokitOption {\n\treturn \"implementation not generated, run swipe\"\n}\n\n// InstrumentingLabels ...\n// @type:\"repeat\"\nfunc InstrumentingLabels(key interface{}, name string) GokitOption {\n\treturn \"implementation not generated, run swipe\"\n}\n")
 }
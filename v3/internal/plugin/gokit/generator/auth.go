@@ -0,0 +1,379 @@
+package generator
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/swipe-io/strcase"
+	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/config"
+	"github.com/swipe-io/swipe/v3/option"
+	"github.com/swipe-io/swipe/v3/swipe"
+	"github.com/swipe-io/swipe/v3/writer"
+)
+
+type authSchemes struct {
+	bearer  bool
+	basic   bool
+	apiKeys []config.APIKeyAuth
+}
+
+func (s authSchemes) enabled() bool {
+	return s.bearer || s.basic || len(s.apiKeys) > 0
+}
+
+func (s authSchemes) apiKey() bool {
+	return len(s.apiKeys) > 0
+}
+
+func makeAuthSchemes(ifaces []*config.Interface, methodOptions map[string]config.MethodOptions) (s authSchemes) {
+	apiKeys := map[config.APIKeyAuth]struct{}{}
+	for _, iface := range ifaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+		for _, m := range ifaceType.Methods {
+			mopt := methodOptions[iface.Named.Name.Value+m.Name.Value]
+			if mopt.BearerAuth != nil {
+				s.bearer = true
+			}
+			if mopt.BasicAuth != nil {
+				s.basic = true
+			}
+			if mopt.APIKeyAuth != nil {
+				if _, ok := apiKeys[*mopt.APIKeyAuth]; !ok {
+					apiKeys[*mopt.APIKeyAuth] = struct{}{}
+					s.apiKeys = append(s.apiKeys, *mopt.APIKeyAuth)
+				}
+			}
+		}
+	}
+	sort.Slice(s.apiKeys, func(i, j int) bool {
+		if s.apiKeys[i].In != s.apiKeys[j].In {
+			return s.apiKeys[i].In < s.apiKeys[j].In
+		}
+		return s.apiKeys[i].Name < s.apiKeys[j].Name
+	})
+	return
+}
+
+func hasMethodAuth(mopt config.MethodOptions) bool {
+	return mopt.BearerAuth != nil || mopt.APIKeyAuth != nil || mopt.BasicAuth != nil
+}
+
+func apiKeySchemeName(a config.APIKeyAuth) string {
+	return "apiKey" + strcase.ToCamel(a.Name)
+}
+
+func writeAuthServerOptions(w *writer.GoWriter, schemes authSchemes) {
+	if schemes.bearer {
+		w.W("func BearerAuthOption(v BearerAuthValidator) ServerOption {\nreturn func(c *serverOpts) {\nc.bearerAuthValidator = v\n}\n}\n\n")
+	}
+	if schemes.apiKey() {
+		w.W("func APIKeyAuthOption(v APIKeyAuthValidator) ServerOption {\nreturn func(c *serverOpts) {\nc.apiKeyAuthValidator = v\n}\n}\n\n")
+	}
+	if schemes.basic {
+		w.W("func BasicAuthOption(v BasicAuthValidator) ServerOption {\nreturn func(c *serverOpts) {\nc.basicAuthValidator = v\n}\n}\n\n")
+	}
+}
+
+func writeAuthServerOptsFields(w *writer.GoWriter, schemes authSchemes) {
+	if schemes.bearer {
+		w.W("bearerAuthValidator BearerAuthValidator\n")
+	}
+	if schemes.apiKey() {
+		w.W("apiKeyAuthValidator APIKeyAuthValidator\n")
+	}
+	if schemes.basic {
+		w.W("basicAuthValidator BasicAuthValidator\n")
+	}
+}
+
+func writeAuthServer(w *writer.GoWriter, importer swipe.Importer, useFast, jsonRPC bool, schemes authSchemes) {
+	contextPkg := importer.Import("context", "context")
+	endpointPkg := importer.Import("endpoint", "github.com/go-kit/kit/endpoint")
+
+	var stringsPkg string
+	if schemes.bearer || (schemes.basic && useFast) {
+		stringsPkg = importer.Import("strings", "strings")
+	}
+
+	w.W("type authContextKey string\n\n")
+
+	if schemes.bearer {
+		w.W("const bearerTokenContextKey authContextKey = \"bearerToken\"\n\n")
+		w.W("// BearerTokenFromContext returns the bearer token extracted from the request Authorization header.\n")
+		w.W("func BearerTokenFromContext(ctx %s.Context) (string, bool) {\n", contextPkg)
+		w.W("token, ok := ctx.Value(bearerTokenContextKey).(string)\nreturn token, ok\n}\n\n")
+		w.W("type BearerAuthValidator func(ctx %[1]s.Context, token string) (%[1]s.Context, error)\n\n", contextPkg)
+	}
+	if schemes.apiKey() {
+		w.W("// APIKeyFromContext returns the api key with the given name extracted from the request.\n")
+		w.W("func APIKeyFromContext(ctx %s.Context, name string) (string, bool) {\n", contextPkg)
+		w.W("key, ok := ctx.Value(authContextKey(\"apiKey:\" + name)).(string)\nreturn key, ok\n}\n\n")
+		w.W("type APIKeyAuthValidator func(ctx %[1]s.Context, name, key string) (%[1]s.Context, error)\n\n", contextPkg)
+	}
+	if schemes.basic {
+		w.W("const basicCredentialsContextKey authContextKey = \"basicCredentials\"\n\n")
+		w.W("type BasicCredentials struct {\nUsername string\nPassword string\n}\n\n")
+		w.W("// BasicCredentialsFromContext returns the credentials extracted from the request Authorization header.\n")
+		w.W("func BasicCredentialsFromContext(ctx %s.Context) (BasicCredentials, bool) {\n", contextPkg)
+		w.W("credentials, ok := ctx.Value(basicCredentialsContextKey).(BasicCredentials)\nreturn credentials, ok\n}\n\n")
+		w.W("type BasicAuthValidator func(ctx %[1]s.Context, username, password string) (%[1]s.Context, error)\n\n", contextPkg)
+	}
+
+	w.W("type UnauthorizedError struct {\nreason string\n}\n\n")
+	w.W("func (e *UnauthorizedError) Error() string {\nreturn \"unauthorized: \" + e.reason\n}\n\n")
+	w.W("func (*UnauthorizedError) StatusCode() int {\nreturn 401\n}\n\n")
+	w.W("func (*UnauthorizedError) ErrorCode() int {\nreturn -32001\n}\n\n")
+	w.W("func (*UnauthorizedError) Code() string {\nreturn \"unauthorized\"\n}\n\n")
+
+	if useFast {
+		httpPkg := importer.Import("fasthttp", "github.com/valyala/fasthttp")
+		w.W("func authServerBefore(ctx %s.Context, r *%s.Request) %s.Context {\n", contextPkg, httpPkg, contextPkg)
+		if schemes.bearer || schemes.basic {
+			w.W("authorization := string(r.Header.Peek(\"Authorization\"))\n")
+		}
+	} else {
+		httpPkg := importer.Import("http", "net/http")
+		w.W("func authServerBefore(ctx %s.Context, r *%s.Request) %s.Context {\n", contextPkg, httpPkg, contextPkg)
+		if schemes.bearer {
+			w.W("authorization := r.Header.Get(\"Authorization\")\n")
+		}
+	}
+	if schemes.bearer {
+		w.W("if %s.HasPrefix(authorization, \"Bearer \") {\n", stringsPkg)
+		w.W("ctx = %s.WithValue(ctx, bearerTokenContextKey, %s.TrimPrefix(authorization, \"Bearer \"))\n", contextPkg, stringsPkg)
+		w.W("}\n")
+	}
+	if schemes.basic {
+		if useFast {
+			base64Pkg := importer.Import("base64", "encoding/base64")
+			w.W("if %s.HasPrefix(authorization, \"Basic \") {\n", stringsPkg)
+			w.W("if data, err := %s.StdEncoding.DecodeString(%s.TrimPrefix(authorization, \"Basic \")); err == nil {\n", base64Pkg, stringsPkg)
+			w.W("if parts := %s.SplitN(string(data), \":\", 2); len(parts) == 2 {\n", stringsPkg)
+			w.W("ctx = %s.WithValue(ctx, basicCredentialsContextKey, BasicCredentials{Username: parts[0], Password: parts[1]})\n", contextPkg)
+			w.W("}\n}\n}\n")
+		} else {
+			w.W("if username, password, ok := r.BasicAuth(); ok {\n")
+			w.W("ctx = %s.WithValue(ctx, basicCredentialsContextKey, BasicCredentials{Username: username, Password: password})\n", contextPkg)
+			w.W("}\n")
+		}
+	}
+	for _, a := range schemes.apiKeys {
+		var value string
+		switch a.In {
+		case "header":
+			if useFast {
+				value = "string(r.Header.Peek(" + strconv.Quote(a.Name) + "))"
+			} else {
+				value = "r.Header.Get(" + strconv.Quote(a.Name) + ")"
+			}
+		case "query":
+			if useFast {
+				value = "string(r.URI().QueryArgs().Peek(" + strconv.Quote(a.Name) + "))"
+			} else {
+				value = "r.URL.Query().Get(" + strconv.Quote(a.Name) + ")"
+			}
+		}
+		w.W("if v := %s; v != \"\" {\n", value)
+		w.W("ctx = %s.WithValue(ctx, authContextKey(%s), v)\n", contextPkg, strconv.Quote("apiKey:"+a.Name))
+		w.W("}\n")
+	}
+	w.W("return ctx\n}\n\n")
+
+	w.W("type authCheck func(ctx %[1]s.Context) (%[1]s.Context, bool, error)\n\n", contextPkg)
+
+	if schemes.bearer {
+		w.W("func bearerAuthCheck(validator BearerAuthValidator) authCheck {\n")
+		w.W("return func(ctx %[1]s.Context) (%[1]s.Context, bool, error) {\n", contextPkg)
+		w.W("token, ok := BearerTokenFromContext(ctx)\n")
+		w.W("if !ok {\nreturn ctx, false, nil\n}\n")
+		w.W("ctx, err := validator(ctx, token)\n")
+		w.W("return ctx, true, err\n")
+		w.W("}\n}\n\n")
+	}
+	if schemes.apiKey() {
+		w.W("func apiKeyAuthCheck(validator APIKeyAuthValidator, name string) authCheck {\n")
+		w.W("return func(ctx %[1]s.Context) (%[1]s.Context, bool, error) {\n", contextPkg)
+		w.W("key, ok := APIKeyFromContext(ctx, name)\n")
+		w.W("if !ok {\nreturn ctx, false, nil\n}\n")
+		w.W("ctx, err := validator(ctx, name, key)\n")
+		w.W("return ctx, true, err\n")
+		w.W("}\n}\n\n")
+	}
+	if schemes.basic {
+		w.W("func basicAuthCheck(validator BasicAuthValidator) authCheck {\n")
+		w.W("return func(ctx %[1]s.Context) (%[1]s.Context, bool, error) {\n", contextPkg)
+		w.W("credentials, ok := BasicCredentialsFromContext(ctx)\n")
+		w.W("if !ok {\nreturn ctx, false, nil\n}\n")
+		w.W("ctx, err := validator(ctx, credentials.Username, credentials.Password)\n")
+		w.W("return ctx, true, err\n")
+		w.W("}\n}\n\n")
+	}
+
+	coder := "interface{ StatusCode() int }"
+	if jsonRPC {
+		coder = "interface{ ErrorCode() int }"
+	}
+
+	w.W("// authMiddleware accepts the request if any of the checks finds the credentials and validates them.\n")
+	w.W("func authMiddleware(checks ...authCheck) %s.Middleware {\n", endpointPkg)
+	w.W("return func(next %[1]s.Endpoint) %[1]s.Endpoint {\n", endpointPkg)
+	w.W("return func(ctx %s.Context, request interface{}) (interface{}, error) {\n", contextPkg)
+	w.W("for _, check := range checks {\n")
+	w.W("authCtx, ok, err := check(ctx)\n")
+	w.W("if !ok {\ncontinue\n}\n")
+	w.W("if err != nil {\n")
+	w.W("if _, ok := err.(%s); ok {\nreturn nil, err\n}\n", coder)
+	w.W("return nil, &UnauthorizedError{reason: err.Error()}\n")
+	w.W("}\n")
+	w.W("return next(authCtx, request)\n")
+	w.W("}\n")
+	w.W("return nil, &UnauthorizedError{reason: \"missing credentials\"}\n")
+	w.W("}\n}\n}\n\n")
+}
+
+// writeAuthValidatorsCheck writes the check that every validator required by the methods is set.
+func writeAuthValidatorsCheck(w *writer.GoWriter, importer swipe.Importer, schemes authSchemes) {
+	errorsPkg := importer.Import("errors", "errors")
+	if schemes.bearer {
+		w.W("if opts.bearerAuthValidator == nil {\n")
+		w.W("return nil, %s.New(\"bearer auth validator is not set, use BearerAuthOption\")\n", errorsPkg)
+		w.W("}\n")
+	}
+	if schemes.apiKey() {
+		w.W("if opts.apiKeyAuthValidator == nil {\n")
+		w.W("return nil, %s.New(\"api key auth validator is not set, use APIKeyAuthOption\")\n", errorsPkg)
+		w.W("}\n")
+	}
+	if schemes.basic {
+		w.W("if opts.basicAuthValidator == nil {\n")
+		w.W("return nil, %s.New(\"basic auth validator is not set, use BasicAuthOption\")\n", errorsPkg)
+		w.W("}\n")
+	}
+}
+
+func writeAuthEndpointMiddleware(w *writer.GoWriter, epSetName string, m *option.FuncType, mopt config.MethodOptions) {
+	if !hasMethodAuth(mopt) {
+		return
+	}
+	w.W("%[1]s.%[2]sEndpoint = authMiddleware(", epSetName, m.Name)
+	var checks int
+	writeCheck := func(format string, a ...interface{}) {
+		if checks > 0 {
+			w.W(", ")
+		}
+		w.W(format, a...)
+		checks++
+	}
+	if mopt.BearerAuth != nil {
+		writeCheck("bearerAuthCheck(opts.bearerAuthValidator)")
+	}
+	if mopt.APIKeyAuth != nil {
+		writeCheck("apiKeyAuthCheck(opts.apiKeyAuthValidator, %s)", strconv.Quote(mopt.APIKeyAuth.Name))
+	}
+	if mopt.BasicAuth != nil {
+		writeCheck("basicAuthCheck(opts.basicAuthValidator)")
+	}
+	w.W(")(%[1]s.%[2]sEndpoint)\n", epSetName, m.Name)
+}
+
+func writeAuthClientOptions(w *writer.GoWriter, schemes authSchemes) {
+	if schemes.bearer {
+		w.W("func BearerTokenOption(p BearerTokenProvider) ClientOption {\nreturn func(c *clientOpts) {\nc.bearerTokenProvider = p\n}\n}\n\n")
+	}
+	if schemes.apiKey() {
+		w.W("func APIKeyOption(p APIKeyProvider) ClientOption {\nreturn func(c *clientOpts) {\nc.apiKeyProvider = p\n}\n}\n\n")
+	}
+	if schemes.basic {
+		w.W("func BasicAuthOption(p BasicAuthProvider) ClientOption {\nreturn func(c *clientOpts) {\nc.basicAuthProvider = p\n}\n}\n\n")
+	}
+}
+
+func writeAuthClientOptsFields(w *writer.GoWriter, schemes authSchemes) {
+	if schemes.bearer {
+		w.W("bearerTokenProvider BearerTokenProvider\n")
+	}
+	if schemes.apiKey() {
+		w.W("apiKeyProvider APIKeyProvider\n")
+	}
+	if schemes.basic {
+		w.W("basicAuthProvider BasicAuthProvider\n")
+	}
+}
+
+func writeAuthClient(w *writer.GoWriter, importer swipe.Importer, useFast bool, schemes authSchemes) {
+	contextPkg := importer.Import("context", "context")
+
+	var requestType string
+	if useFast {
+		requestType = "*" + importer.Import("fasthttp", "github.com/valyala/fasthttp") + ".Request"
+	} else {
+		requestType = "*" + importer.Import("http", "net/http") + ".Request"
+	}
+	requestFunc := "func(" + contextPkg + ".Context, " + requestType + ") " + contextPkg + ".Context"
+
+	if schemes.bearer {
+		w.W("// BearerTokenProvider returns the token sent in the Authorization header.\n")
+		w.W("type BearerTokenProvider func(ctx %s.Context) string\n\n", contextPkg)
+		w.W("func bearerAuthClientBefore(p BearerTokenProvider) %s {\n", requestFunc)
+		w.W("return func(ctx %s.Context, r %s) %s.Context {\n", contextPkg, requestType, contextPkg)
+		w.W("if p == nil {\nreturn ctx\n}\n")
+		w.W("if token := p(ctx); token != \"\" {\n")
+		w.W("r.Header.Set(\"Authorization\", \"Bearer \"+token)\n")
+		w.W("}\n")
+		w.W("return ctx\n}\n}\n\n")
+	}
+	if schemes.apiKey() {
+		w.W("// APIKeyProvider returns the api key with the given name.\n")
+		w.W("type APIKeyProvider func(ctx %s.Context, name string) string\n\n", contextPkg)
+		w.W("func apiKeyAuthClientBefore(p APIKeyProvider, in, name string) %s {\n", requestFunc)
+		w.W("return func(ctx %s.Context, r %s) %s.Context {\n", contextPkg, requestType, contextPkg)
+		w.W("if p == nil {\nreturn ctx\n}\n")
+		w.W("key := p(ctx, name)\n")
+		w.W("if key == \"\" {\nreturn ctx\n}\n")
+		w.W("switch in {\n")
+		w.W("case \"header\":\n")
+		w.W("r.Header.Set(name, key)\n")
+		w.W("case \"query\":\n")
+		if useFast {
+			w.W("r.URI().QueryArgs().Set(name, key)\n")
+		} else {
+			w.W("q := r.URL.Query()\n")
+			w.W("q.Set(name, key)\n")
+			w.W("r.URL.RawQuery = q.Encode()\n")
+		}
+		w.W("}\n")
+		w.W("return ctx\n}\n}\n\n")
+	}
+	if schemes.basic {
+		w.W("// BasicAuthProvider returns the credentials sent in the Authorization header.\n")
+		w.W("type BasicAuthProvider func(ctx %s.Context) (username, password string)\n\n", contextPkg)
+		w.W("func basicAuthClientBefore(p BasicAuthProvider) %s {\n", requestFunc)
+		w.W("return func(ctx %s.Context, r %s) %s.Context {\n", contextPkg, requestType, contextPkg)
+		w.W("if p == nil {\nreturn ctx\n}\n")
+		w.W("username, password := p(ctx)\n")
+		if useFast {
+			base64Pkg := importer.Import("base64", "encoding/base64")
+			w.W("r.Header.Set(\"Authorization\", \"Basic \"+%s.StdEncoding.EncodeToString([]byte(username+\":\"+password)))\n", base64Pkg)
+		} else {
+			w.W("r.SetBasicAuth(username, password)\n")
+		}
+		w.W("return ctx\n}\n}\n\n")
+	}
+}
+
+func writeAuthClientBefore(w *writer.GoWriter, kitPkg, optsName string, mopt config.MethodOptions) {
+	if !hasMethodAuth(mopt) {
+		return
+	}
+	w.W("opts.%[1]s.clientOption = append(opts.%[1]s.clientOption", optsName)
+	if mopt.BearerAuth != nil {
+		w.W(", %s.ClientBefore(bearerAuthClientBefore(opts.bearerTokenProvider))", kitPkg)
+	}
+	if mopt.APIKeyAuth != nil {
+		w.W(", %s.ClientBefore(apiKeyAuthClientBefore(opts.apiKeyProvider, %s, %s))", kitPkg, strconv.Quote(mopt.APIKeyAuth.In), strconv.Quote(mopt.APIKeyAuth.Name))
+	}
+	if mopt.BasicAuth != nil {
+		w.W(", %s.ClientBefore(basicAuthClientBefore(opts.basicAuthProvider))", kitPkg)
+	}
+	w.W(")\n")
+}
@@ -16,6 +16,9 @@ type ClientHelpers struct {
 	Interfaces    []*config.Interface
 	JSONRPCEnable bool
 	UseFast       bool
+	TracingEnable bool
+	Codecs        *config.CodecsEnable
+	MethodOptions map[string]config.MethodOptions
 	IfaceErrors   map[string]map[string][]config.Error
 	Output        string
 	Pkg           string
@@ -43,6 +46,8 @@ func (g *ClientHelpers) Generate(ctx context.Context) []byte {
 	}
 	endpointPkg := importer.Import("endpoint", "github.com/go-kit/kit/endpoint")
 
+	authSchemes := makeAuthSchemes(g.Interfaces, g.MethodOptions)
+
 	kitHTTPClientOption := fmt.Sprintf("%s.ClientOption", kitHTTPPkg)
 	endpointMiddlewareOption := fmt.Sprintf("%s.Middleware", endpointPkg)
 	clientOptionType := "ClientOption"
@@ -91,8 +96,13 @@ func (g *ClientHelpers) Generate(ctx context.Context) []byte {
 
 	g.w.W("func GenericClientOptions(opt ...Option) %s {\nreturn func(c *clientOpts) {\nfor _, o := range opt {\no(&c.genericOpts)\n}\n}\n}\n\n", clientOptionType)
 
+	writeAuthClientOptions(&g.w, authSchemes)
+	writeCodecClientOptions(&g.w, g.Codecs)
+
 	g.w.W("type clientOpts struct {\n")
 	g.w.W("genericOpts opts\n")
+	writeAuthClientOptsFields(&g.w, authSchemes)
+	writeCodecClientOptsFields(&g.w, g.Codecs)
 
 	for _, iface := range g.Interfaces {
 		ifaceType := iface.Named.Type.(*option.IfaceType)
@@ -112,6 +122,22 @@ func (g *ClientHelpers) Generate(ctx context.Context) []byte {
 		}
 	}
 
+	if g.TracingEnable {
+		writeTracingPropagation(&g.w, importer, g.UseFast, "tracingClientBefore", true)
+	}
+	if authSchemes.enabled() {
+		writeAuthClient(&g.w, importer, g.UseFast, authSchemes)
+	}
+	if g.Codecs != nil {
+		writeCodecs(&g.w, importer, g.UseFast, false, g.Codecs)
+		if g.JSONRPCEnable {
+			writeCodecJSONRPC(&g.w, importer, g.UseFast, false)
+		}
+	}
+	if !g.JSONRPCEnable && hasIdempotency(g.Interfaces, g.MethodOptions) {
+		writeIdempotencyClient(&g.w, importer, g.UseFast)
+	}
+
 	g.w.W("type httpError struct {\n")
 	g.w.W("code int\n")
 	if g.JSONRPCEnable {
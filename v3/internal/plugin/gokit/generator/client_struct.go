@@ -17,6 +17,7 @@ type ClientStruct struct {
 	UseFast       bool
 	JSONRPCEnable bool
 	Interfaces    []*config.Interface
+	MethodOptions map[string]config.MethodOptions
 	Output        string
 	Pkg           string
 }
@@ -145,6 +146,10 @@ func (g *ClientStruct) Generate(ctx context.Context) []byte {
 				}
 				g.w.W("return\n")
 				g.w.W("}\n")
+
+				if pagination, ok := makePagination(m, g.MethodOptions[iface.Named.Name.Value+m.Name.Value]); ok {
+					writePaginationIterator(&g.w, importer, clientType, iface, m, pagination)
+				}
 			}
 		}
 	}
@@ -0,0 +1,393 @@
+package generator
+
+import (
+	"strconv"
+	stdstrings "strings"
+
+	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/config"
+	"github.com/swipe-io/swipe/v3/swipe"
+	"github.com/swipe-io/swipe/v3/writer"
+)
+
+// CodecMediaTypes are the media types of the built-in codecs.
+var CodecMediaTypes = map[string]string{
+	"json":    "application/json",
+	"msgpack": "application/msgpack",
+	"cbor":    "application/cbor",
+}
+
+// codecMediaType returns the media type of the method codec,
+// the custom codecs are set by the media type instead of the name.
+func codecMediaType(mopt config.MethodOptions) string {
+	name := mopt.RESTCodec.Take()
+	if name == "" {
+		name = "json"
+	}
+	if mediaType, ok := CodecMediaTypes[name]; ok {
+		return mediaType
+	}
+	return name
+}
+
+// codecVarName returns the name of the variable that holds the default codec of the method.
+func codecVarName(lcName string) string {
+	return lcName + "Codec"
+}
+
+func writeCodecServerOptions(w *writer.GoWriter, codecs *config.CodecsEnable) {
+	if codecs == nil {
+		return
+	}
+	w.W("// CodecOption registers the codec of the request and response bodies,\n")
+	w.W("// the codec replaces the built-in codec of the same media type.\n")
+	w.W("func CodecOption(codec Codec) ServerOption {\nreturn func(c *serverOpts) {\nc.codecs = append(c.codecs, codec)\n}\n}\n\n")
+}
+
+func writeCodecServerOptsFields(w *writer.GoWriter, codecs *config.CodecsEnable) {
+	if codecs == nil {
+		return
+	}
+	w.W("codecs []Codec\n")
+}
+
+func writeCodecClientOptions(w *writer.GoWriter, codecs *config.CodecsEnable) {
+	if codecs == nil {
+		return
+	}
+	w.W("// CodecOption registers the codec of the request and response bodies,\n")
+	w.W("// the codec replaces the built-in codec of the same media type.\n")
+	w.W("func CodecOption(codec Codec) ClientOption {\nreturn func(c *clientOpts) {\nc.codecs = append(c.codecs, codec)\n}\n}\n\n")
+}
+
+func writeCodecClientOptsFields(w *writer.GoWriter, codecs *config.CodecsEnable) {
+	if codecs == nil {
+		return
+	}
+	w.W("codecs []Codec\n")
+}
+
+// writeCodecs writes the codec interface, the built-in codecs and the registry that selects the codec
+// by the Content-Type and Accept headers.
+func writeCodecs(w *writer.GoWriter, importer swipe.Importer, useFast, server bool, codecs *config.CodecsEnable) {
+	ffjsonPkg := importer.Import("ffjson", "github.com/pquerna/ffjson/ffjson")
+	mimePkg := importer.Import("mime", "mime")
+	fmtPkg := importer.Import("fmt", "fmt")
+
+	w.W("// Codec marshals and unmarshals the request and response bodies of the content type.\n")
+	w.W("type Codec interface {\n")
+	w.W("ContentType() string\n")
+	w.W("Marshal(v interface{}) ([]byte, error)\n")
+	w.W("Unmarshal(data []byte, v interface{}) error\n")
+	w.W("}\n\n")
+
+	builtins := []string{"jsonCodec{}"}
+
+	w.W("type jsonCodec struct{}\n\n")
+	w.W("func (jsonCodec) ContentType() string {\nreturn \"application/json; charset=utf-8\"\n}\n\n")
+	w.W("func (jsonCodec) Marshal(v interface{}) ([]byte, error) {\nreturn %s.Marshal(v)\n}\n\n", ffjsonPkg)
+	w.W("func (jsonCodec) Unmarshal(data []byte, v interface{}) error {\nreturn %s.Unmarshal(data, v)\n}\n\n", ffjsonPkg)
+
+	for _, name := range codecs.Codecs {
+		switch name {
+		case "msgpack":
+			msgpackPkg := importer.Import("msgpack", "github.com/vmihailenco/msgpack/v5")
+			bytesPkg := importer.Import("bytes", "bytes")
+
+			builtins = append(builtins, "msgpackCodec{}")
+
+			w.W("// msgpackCodec uses the json struct tags, the generated types have no msgpack tags.\n")
+			w.W("type msgpackCodec struct{}\n\n")
+			w.W("func (msgpackCodec) ContentType() string {\nreturn %s\n}\n\n", strconv.Quote(CodecMediaTypes["msgpack"]))
+			w.W("func (msgpackCodec) Marshal(v interface{}) ([]byte, error) {\n")
+			w.W("var buf %s.Buffer\n", bytesPkg)
+			w.W("enc := %s.NewEncoder(&buf)\n", msgpackPkg)
+			w.W("enc.SetCustomStructTag(\"json\")\n")
+			w.W("if err := enc.Encode(v); err != nil {\nreturn nil, err\n}\n")
+			w.W("return buf.Bytes(), nil\n")
+			w.W("}\n\n")
+			w.W("func (msgpackCodec) Unmarshal(data []byte, v interface{}) error {\n")
+			w.W("dec := %s.NewDecoder(%s.NewReader(data))\n", msgpackPkg, bytesPkg)
+			w.W("dec.SetCustomStructTag(\"json\")\n")
+			w.W("return dec.Decode(v)\n")
+			w.W("}\n\n")
+		case "cbor":
+			cborPkg := importer.Import("cbor", "github.com/fxamacker/cbor/v2")
+
+			builtins = append(builtins, "cborCodec{}")
+
+			w.W("// cborCodec falls back to the json struct tags, the generated types have no cbor tags.\n")
+			w.W("type cborCodec struct{}\n\n")
+			w.W("func (cborCodec) ContentType() string {\nreturn %s\n}\n\n", strconv.Quote(CodecMediaTypes["cbor"]))
+			w.W("func (cborCodec) Marshal(v interface{}) ([]byte, error) {\nreturn %s.Marshal(v)\n}\n\n", cborPkg)
+			w.W("func (cborCodec) Unmarshal(data []byte, v interface{}) error {\nreturn %s.Unmarshal(data, v)\n}\n\n", cborPkg)
+		}
+	}
+
+	w.W("// codecRegistry holds the registered codecs by the media type.\n")
+	w.W("type codecRegistry map[string]Codec\n\n")
+
+	w.W("func newCodecRegistry(custom []Codec) codecRegistry {\n")
+	w.W("c := codecRegistry{}\n")
+	w.W("for _, codec := range []Codec{%s} {\nc.add(codec)\n}\n", stdstrings.Join(builtins, ", "))
+	w.W("for _, codec := range custom {\nc.add(codec)\n}\n")
+	w.W("return c\n")
+	w.W("}\n\n")
+
+	w.W("func (c codecRegistry) add(codec Codec) {\n")
+	w.W("mediaType, _, err := %s.ParseMediaType(codec.ContentType())\n", mimePkg)
+	w.W("if err != nil {\nmediaType = codec.ContentType()\n}\n")
+	w.W("c[mediaType] = codec\n")
+	w.W("}\n\n")
+
+	w.W("func (c codecRegistry) get(mediaType string) (Codec, error) {\n")
+	w.W("codec, ok := c[mediaType]\n")
+	w.W("if !ok {\nreturn nil, %s.Errorf(\"codec for %%s is not registered\", mediaType)\n}\n", fmtPkg)
+	w.W("return codec, nil\n")
+	w.W("}\n\n")
+
+	w.W("// lookup returns the codec of the Content-Type header, the default codec is used when the header is empty.\n")
+	w.W("func (c codecRegistry) lookup(contentType string, defaultCodec Codec) (Codec, bool) {\n")
+	w.W("if contentType == \"\" {\nreturn defaultCodec, true\n}\n")
+	w.W("mediaType, _, err := %s.ParseMediaType(contentType)\n", mimePkg)
+	w.W("if err != nil {\nreturn nil, false\n}\n")
+	w.W("codec, ok := c[mediaType]\n")
+	w.W("return codec, ok\n")
+	w.W("}\n\n")
+
+	if !server {
+		return
+	}
+
+	stringsPkg := importer.Import("strings", "strings")
+	strconvPkg := importer.Import("strconv", "strconv")
+
+	w.W("// negotiate returns the registered codec with the highest quality in the Accept header,\n")
+	w.W("// JSON is used when the header is empty, accepts any media type or names no registered codec.\n")
+	w.W("func (c codecRegistry) negotiate(accept string) Codec {\n")
+	w.W("var (\nbest Codec\nbestQ float64\n)\n")
+	w.W("for _, part := range %s.Split(accept, \",\") {\n", stringsPkg)
+	w.W("mediaType, params, err := %s.ParseMediaType(%s.TrimSpace(part))\n", mimePkg, stringsPkg)
+	w.W("if err != nil {\ncontinue\n}\n")
+	w.W("q := 1.0\n")
+	w.W("if v, ok := params[\"q\"]; ok {\n")
+	w.W("if q, err = %s.ParseFloat(v, 64); err != nil {\ncontinue\n}\n", strconvPkg)
+	w.W("}\n")
+	w.W("if codec, ok := c[mediaType]; ok && q > bestQ {\nbest, bestQ = codec, q\n}\n")
+	w.W("}\n")
+	w.W("if best == nil {\nreturn c[%s]\n}\n", strconv.Quote(CodecMediaTypes["json"]))
+	w.W("return best\n")
+	w.W("}\n\n")
+
+	w.W("type UnsupportedMediaTypeError struct {\nContentType string\n}\n\n")
+	w.W("func (e *UnsupportedMediaTypeError) Error() string {\nreturn \"unsupported media type \" + %s.Quote(e.ContentType)\n}\n\n", strconvPkg)
+	w.W("func (*UnsupportedMediaTypeError) StatusCode() int {\nreturn 415\n}\n\n")
+	w.W("func (*UnsupportedMediaTypeError) Code() string {\nreturn \"unsupported_media_type\"\n}\n\n")
+
+	contextPkg := importer.Import("context", "context")
+
+	var httpPkg string
+	if useFast {
+		httpPkg = importer.Import("fasthttp", "github.com/valyala/fasthttp")
+	} else {
+		httpPkg = importer.Import("http", "net/http")
+	}
+
+	w.W("type codecAcceptContextKey struct{}\n\n")
+
+	w.W("// codecServerBefore keeps the Accept header for the response encoder, the encoder has no access to the request.\n")
+	w.W("func codecServerBefore(ctx %s.Context, r *%s.Request) %s.Context {\n", contextPkg, httpPkg, contextPkg)
+	if useFast {
+		w.W("return %s.WithValue(ctx, codecAcceptContextKey{}, string(r.Header.Peek(\"Accept\")))\n", contextPkg)
+	} else {
+		w.W("return %s.WithValue(ctx, codecAcceptContextKey{}, r.Header.Get(\"Accept\"))\n", contextPkg)
+	}
+	w.W("}\n\n")
+}
+
+// writeCodecJSONRPC writes the conversion of the JSON-RPC messages between JSON and the registered codecs,
+// the JSON-RPC transport reads and writes JSON only.
+func writeCodecJSONRPC(w *writer.GoWriter, importer swipe.Importer, useFast, server bool) {
+	contextPkg := importer.Import("context", "context")
+	jsonPkg := importer.Import("json", "encoding/json")
+	bytesPkg := importer.Import("bytes", "bytes")
+	mimePkg := importer.Import("mime", "mime")
+	fmtPkg := importer.Import("fmt", "fmt")
+
+	w.W("func (c codecRegistry) isJSON(codec Codec) bool {\n")
+	w.W("mediaType, _, _ := %s.ParseMediaType(codec.ContentType())\n", mimePkg)
+	w.W("return mediaType == %s\n", strconv.Quote(CodecMediaTypes["json"]))
+	w.W("}\n\n")
+
+	w.W("// transcode converts the message between the codecs, the message is decoded without the generated types.\n")
+	w.W("func (c codecRegistry) transcode(data []byte, from, to Codec) ([]byte, error) {\n")
+	w.W("var v interface{}\n")
+	w.W("if c.isJSON(from) {\n")
+	w.W("dec := %s.NewDecoder(%s.NewReader(data))\n", jsonPkg, bytesPkg)
+	w.W("dec.UseNumber()\n")
+	w.W("if err := dec.Decode(&v); err != nil {\nreturn nil, err\n}\n")
+	w.W("} else if err := from.Unmarshal(data, &v); err != nil {\nreturn nil, err\n}\n")
+	w.W("return to.Marshal(codecValue(v))\n")
+	w.W("}\n\n")
+
+	w.W("// codecValue converts the decoded value to the value every codec marshals,\n")
+	w.W("// the JSON numbers keep the integer precision and the map keys become strings.\n")
+	w.W("func codecValue(v interface{}) interface{} {\n")
+	w.W("switch v := v.(type) {\n")
+	w.W("case %s.Number:\n", jsonPkg)
+	w.W("if i, err := v.Int64(); err == nil {\nreturn i\n}\n")
+	w.W("f, _ := v.Float64()\n")
+	w.W("return f\n")
+	w.W("case map[interface{}]interface{}:\n")
+	w.W("m := make(map[string]interface{}, len(v))\n")
+	w.W("for k, e := range v {\nm[%s.Sprint(k)] = codecValue(e)\n}\n", fmtPkg)
+	w.W("return m\n")
+	w.W("case map[string]interface{}:\n")
+	w.W("for k, e := range v {\nv[k] = codecValue(e)\n}\n")
+	w.W("case []interface{}:\n")
+	w.W("for i, e := range v {\nv[i] = codecValue(e)\n}\n")
+	w.W("}\n")
+	w.W("return v\n")
+	w.W("}\n\n")
+
+	jsonCodec := "c[" + strconv.Quote(CodecMediaTypes["json"]) + "]"
+
+	if useFast {
+		httpPkg := importer.Import("fasthttp", "github.com/valyala/fasthttp")
+		if server {
+			w.W("// codecJSONRPCHandler converts the JSON-RPC messages of the registered codecs to JSON and back,\n")
+			w.W("// the request codec is selected by the Content-Type header and the response codec by the Accept header.\n")
+			w.W("func codecJSONRPCHandler(c codecRegistry, next %[1]s.RequestHandler) %[1]s.RequestHandler {\n", httpPkg)
+			w.W("return func(ctx *%s.RequestCtx) {\n", httpPkg)
+			w.W("contentType := string(ctx.Request.Header.ContentType())\n")
+			w.W("requestCodec, ok := c.lookup(contentType, %s)\n", jsonCodec)
+			w.W("if !ok {\nctx.Error((&UnsupportedMediaTypeError{ContentType: contentType}).Error(), %s.StatusUnsupportedMediaType)\nreturn\n}\n", httpPkg)
+			w.W("if !c.isJSON(requestCodec) {\n")
+			w.W("body, err := c.transcode(ctx.Request.Body(), requestCodec, %s)\n", jsonCodec)
+			w.W("if err != nil {\nctx.Error(err.Error(), %s.StatusBadRequest)\nreturn\n}\n", httpPkg)
+			w.W("ctx.Request.SetBody(body)\n")
+			w.W("ctx.Request.Header.SetContentType(%s.ContentType())\n", jsonCodec)
+			w.W("}\n")
+			w.W("responseCodec := c.negotiate(string(ctx.Request.Header.Peek(\"Accept\")))\n")
+			w.W("ctx.Response.Header.Add(\"Vary\", \"Accept\")\n")
+			w.W("next(ctx)\n")
+			w.W("if c.isJSON(responseCodec) || len(ctx.Response.Body()) == 0 {\nreturn\n}\n")
+			w.W("// the responses written before the JSON-RPC message, such as the transport errors, are kept as is\n")
+			w.W("if body, err := c.transcode(ctx.Response.Body(), %s, responseCodec); err == nil {\n", jsonCodec)
+			w.W("ctx.Response.SetBody(body)\n")
+			w.W("ctx.Response.Header.SetContentType(responseCodec.ContentType())\n")
+			w.W("}\n")
+			w.W("}\n")
+			w.W("}\n\n")
+			return
+		}
+
+		w.W("// codecJSONRPCClientBefore converts the JSON-RPC request to the codec and accepts the codec responses.\n")
+		w.W("func codecJSONRPCClientBefore(c codecRegistry, codec Codec) func(%[1]s.Context, *%[2]s.Request) %[1]s.Context {\n", contextPkg, httpPkg)
+		w.W("return func(ctx %s.Context, r *%s.Request) %s.Context {\n", contextPkg, httpPkg, contextPkg)
+		w.W("r.Header.Set(\"Accept\", codec.ContentType())\n")
+		w.W("if c.isJSON(codec) {\nreturn ctx\n}\n")
+		w.W("if body, err := c.transcode(r.Body(), %s, codec); err == nil {\n", jsonCodec)
+		w.W("r.SetBody(body)\n")
+		w.W("r.Header.SetContentType(codec.ContentType())\n")
+		w.W("}\n")
+		w.W("return ctx\n")
+		w.W("}\n")
+		w.W("}\n\n")
+
+		w.W("// codecJSONRPCClientAfter converts the JSON-RPC response of the registered codec to JSON.\n")
+		w.W("func codecJSONRPCClientAfter(c codecRegistry) func(%[1]s.Context, *%[2]s.Response) %[1]s.Context {\n", contextPkg, httpPkg)
+		w.W("return func(ctx %s.Context, r *%s.Response) %s.Context {\n", contextPkg, httpPkg, contextPkg)
+		w.W("codec, ok := c.lookup(string(r.Header.ContentType()), nil)\n")
+		w.W("if !ok || codec == nil || c.isJSON(codec) {\nreturn ctx\n}\n")
+		w.W("if body, err := c.transcode(r.Body(), codec, %s); err == nil {\n", jsonCodec)
+		w.W("r.SetBody(body)\n")
+		w.W("r.Header.SetContentType(%s.ContentType())\n", jsonCodec)
+		w.W("}\n")
+		w.W("return ctx\n")
+		w.W("}\n")
+		w.W("}\n\n")
+		return
+	}
+
+	httpPkg := importer.Import("http", "net/http")
+	ioPkg := importer.Import("io", "io")
+
+	if server {
+		w.W("// codecRecorder keeps the response written by the JSON-RPC handler for the conversion.\n")
+		w.W("type codecRecorder struct {\nheader %s.Header\nstatusCode int\nbody %s.Buffer\n}\n\n", httpPkg, bytesPkg)
+		w.W("func (r *codecRecorder) Header() %s.Header {\nreturn r.header\n}\n\n", httpPkg)
+		w.W("func (r *codecRecorder) WriteHeader(statusCode int) {\nr.statusCode = statusCode\n}\n\n")
+		w.W("func (r *codecRecorder) Write(b []byte) (int, error) {\nreturn r.body.Write(b)\n}\n\n")
+
+		w.W("// codecJSONRPCHandler converts the JSON-RPC messages of the registered codecs to JSON and back,\n")
+		w.W("// the request codec is selected by the Content-Type header and the response codec by the Accept header.\n")
+		w.W("func codecJSONRPCHandler(c codecRegistry, next %[1]s.Handler) %[1]s.Handler {\n", httpPkg)
+		w.W("return %[1]s.HandlerFunc(func(w %[1]s.ResponseWriter, r *%[1]s.Request) {\n", httpPkg)
+		w.W("contentType := r.Header.Get(\"Content-Type\")\n")
+		w.W("requestCodec, ok := c.lookup(contentType, %s)\n", jsonCodec)
+		w.W("if !ok {\n%[1]s.Error(w, (&UnsupportedMediaTypeError{ContentType: contentType}).Error(), %[1]s.StatusUnsupportedMediaType)\nreturn\n}\n", httpPkg)
+		w.W("if !c.isJSON(requestCodec) {\n")
+		w.W("body, err := %s.ReadAll(r.Body)\n", ioPkg)
+		w.W("if err == nil {\nbody, err = c.transcode(body, requestCodec, %s)\n}\n", jsonCodec)
+		w.W("if err != nil {\n%[1]s.Error(w, err.Error(), %[1]s.StatusBadRequest)\nreturn\n}\n", httpPkg)
+		w.W("r.Body = %s.NopCloser(%s.NewReader(body))\n", ioPkg, bytesPkg)
+		w.W("r.ContentLength = int64(len(body))\n")
+		w.W("r.Header.Set(\"Content-Type\", %s.ContentType())\n", jsonCodec)
+		w.W("}\n")
+		w.W("responseCodec := c.negotiate(r.Header.Get(\"Accept\"))\n")
+		w.W("w.Header().Add(\"Vary\", \"Accept\")\n")
+		w.W("if c.isJSON(responseCodec) {\nnext.ServeHTTP(w, r)\nreturn\n}\n")
+		w.W("rec := &codecRecorder{header: w.Header(), statusCode: %s.StatusOK}\n", httpPkg)
+		w.W("next.ServeHTTP(rec, r)\n")
+		w.W("body := rec.body.Bytes()\n")
+		w.W("// the responses written before the JSON-RPC message, such as the transport errors, are kept as is\n")
+		w.W("if len(body) > 0 {\n")
+		w.W("if data, err := c.transcode(body, %s, responseCodec); err == nil {\n", jsonCodec)
+		w.W("body = data\n")
+		w.W("w.Header().Set(\"Content-Type\", responseCodec.ContentType())\n")
+		w.W("}\n")
+		w.W("}\n")
+		w.W("w.Header().Del(\"Content-Length\")\n")
+		w.W("w.WriteHeader(rec.statusCode)\n")
+		w.W("_, _ = w.Write(body)\n")
+		w.W("})\n")
+		w.W("}\n\n")
+		return
+	}
+
+	w.W("// codecJSONRPCClientBefore converts the JSON-RPC request to the codec and accepts the codec responses.\n")
+	w.W("func codecJSONRPCClientBefore(c codecRegistry, codec Codec) func(%[1]s.Context, *%[2]s.Request) %[1]s.Context {\n", contextPkg, httpPkg)
+	w.W("return func(ctx %s.Context, r *%s.Request) %s.Context {\n", contextPkg, httpPkg, contextPkg)
+	w.W("r.Header.Set(\"Accept\", codec.ContentType())\n")
+	w.W("if c.isJSON(codec) {\nreturn ctx\n}\n")
+	w.W("body, err := %s.ReadAll(r.Body)\n", ioPkg)
+	w.W("if err == nil {\n")
+	w.W("if data, err := c.transcode(body, %s, codec); err == nil {\n", jsonCodec)
+	w.W("body = data\n")
+	w.W("r.Header.Set(\"Content-Type\", codec.ContentType())\n")
+	w.W("}\n")
+	w.W("}\n")
+	w.W("r.Body = %s.NopCloser(%s.NewReader(body))\n", ioPkg, bytesPkg)
+	w.W("r.ContentLength = int64(len(body))\n")
+	w.W("return ctx\n")
+	w.W("}\n")
+	w.W("}\n\n")
+
+	w.W("// codecJSONRPCClientAfter converts the JSON-RPC response of the registered codec to JSON.\n")
+	w.W("func codecJSONRPCClientAfter(c codecRegistry) func(%[1]s.Context, *%[2]s.Response) %[1]s.Context {\n", contextPkg, httpPkg)
+	w.W("return func(ctx %s.Context, r *%s.Response) %s.Context {\n", contextPkg, httpPkg, contextPkg)
+	w.W("codec, ok := c.lookup(r.Header.Get(\"Content-Type\"), nil)\n")
+	w.W("if !ok || codec == nil || c.isJSON(codec) {\nreturn ctx\n}\n")
+	w.W("body, err := %s.ReadAll(r.Body)\n", ioPkg)
+	w.W("if err == nil {\n")
+	w.W("if data, err := c.transcode(body, codec, %s); err == nil {\n", jsonCodec)
+	w.W("body = data\n")
+	w.W("r.Header.Set(\"Content-Type\", %s.ContentType())\n", jsonCodec)
+	w.W("}\n")
+	w.W("}\n")
+	w.W("// the transport closes the original body\n")
+	w.W("r.Body = %s.NopCloser(%s.NewReader(body))\n", ioPkg, bytesPkg)
+	w.W("return ctx\n")
+	w.W("}\n")
+	w.W("}\n\n")
+}
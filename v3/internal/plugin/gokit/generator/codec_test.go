@@ -0,0 +1,242 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"os/exec"
+	"reflect"
+	"testing"
+
+	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/config"
+	"github.com/swipe-io/swipe/v3/swipe"
+	"github.com/swipe-io/swipe/v3/writer"
+)
+
+// codecHarness registers the test codec with the generated codec registry, it reads the cases from stdin
+// and writes the negotiated media types or the JSON-RPC handler responses to stdout.
+const codecHarness = `package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"os"
+)
+
+// testCodec prefixes the JSON with "test:".
+type testCodec struct{}
+
+func (testCodec) ContentType() string {
+	return "application/x-test"
+}
+
+func (testCodec) Marshal(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte("test:"), data...), nil
+}
+
+func (testCodec) Unmarshal(data []byte, v interface{}) error {
+	if !bytes.HasPrefix(data, []byte("test:")) {
+		return errors.New("no test prefix")
+	}
+	return json.Unmarshal(data[len("test:"):], v)
+}
+
+type request struct {
+	ContentType string
+	Accept      string
+	Body        string
+}
+
+type response struct {
+	Status      int
+	ContentType string
+	Body        string
+}
+
+func main() {
+	c := newCodecRegistry([]Codec{testCodec{}})
+	switch os.Args[1] {
+	case "negotiate":
+		var accepts []string
+		if err := json.NewDecoder(os.Stdin).Decode(&accepts); err != nil {
+			panic(err)
+		}
+		mediaTypes := make([]string, 0, len(accepts))
+		for _, accept := range accepts {
+			mediaType, _, _ := mime.ParseMediaType(c.negotiate(accept).ContentType())
+			mediaTypes = append(mediaTypes, mediaType)
+		}
+		if err := json.NewEncoder(os.Stdout).Encode(mediaTypes); err != nil {
+			panic(err)
+		}
+	case "handler":
+		var requests []request
+		if err := json.NewDecoder(os.Stdin).Decode(&requests); err != nil {
+			panic(err)
+		}
+		handler := codecJSONRPCHandler(c, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != "application/json" || !json.Valid(body) {
+				w.WriteHeader(http.StatusTeapot)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			_, _ = w.Write(body)
+		}))
+		responses := make([]response, 0, len(requests))
+		for _, req := range requests {
+			r := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader([]byte(req.Body)))
+			r.Header.Set("Content-Type", req.ContentType)
+			r.Header.Set("Accept", req.Accept)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			responses = append(responses, response{Status: w.Code, ContentType: w.Header().Get("Content-Type"), Body: w.Body.String()})
+		}
+		if err := json.NewEncoder(os.Stdout).Encode(responses); err != nil {
+			panic(err)
+		}
+	}
+}
+`
+
+func buildCodecHarness(t *testing.T) string {
+	return buildHarness(t, func(w *writer.GoWriter, importer swipe.Importer) {
+		writeCodecs(w, importer, false, true, &config.CodecsEnable{})
+		writeCodecJSONRPC(w, importer, false, true)
+	}, codecHarness, ffjsonStub)
+}
+
+func runCodecHarness(t *testing.T, bin, mode string, in, out interface{}) {
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command(bin, mode)
+	cmd.Stdin = bytes.NewReader(data)
+	data, err = cmd.Output()
+	if err != nil {
+		t.Fatalf("run the harness: %v", err)
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func Test_codecRegistryNegotiate(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds the generated code")
+	}
+	bin := buildCodecHarness(t)
+
+	tests := []struct {
+		name   string
+		accept string
+		want   string
+	}{
+		{"empty", "", "application/json"},
+		{"any", "*/*", "application/json"},
+		{"json", "application/json", "application/json"},
+		{"registered codec", "application/x-test", "application/x-test"},
+		{"highest quality", "application/json;q=0.5, application/x-test", "application/x-test"},
+		{"highest quality first", "application/x-test;q=0.2, application/json;q=0.9", "application/json"},
+		{"unregistered", "text/html", "application/json"},
+		{"unregistered with registered", "text/html, application/x-test;q=0.1", "application/x-test"},
+		{"zero quality", "application/x-test;q=0", "application/json"},
+		{"invalid quality", "application/x-test;q=high", "application/json"},
+		{"invalid", "application/x-test;;", "application/json"},
+	}
+	accepts := make([]string, 0, len(tests))
+	for _, tt := range tests {
+		accepts = append(accepts, tt.accept)
+	}
+	var got []string
+	runCodecHarness(t, bin, "negotiate", accepts, &got)
+	if len(got) != len(tests) {
+		t.Fatalf("negotiate() returned %d media types, want %d", len(got), len(tests))
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got[i] != tt.want {
+				t.Errorf("negotiate(%q) = %v, want %v", tt.accept, got[i], tt.want)
+			}
+		})
+	}
+}
+
+func Test_codecJSONRPCHandler(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds the generated code")
+	}
+	bin := buildCodecHarness(t)
+
+	type request struct {
+		ContentType string
+		Accept      string
+		Body        string
+	}
+	type response struct {
+		Status      int
+		ContentType string
+		Body        string
+	}
+	tests := []struct {
+		name    string
+		request request
+		want    response
+	}{
+		{
+			"json",
+			request{"application/json", "", `{"a":1}`},
+			response{200, "application/json; charset=utf-8", `{"a":1}`},
+		},
+		{
+			"codec request",
+			request{"application/x-test", "", `test:{"a":1}`},
+			response{200, "application/json; charset=utf-8", `{"a":1}`},
+		},
+		{
+			"codec response",
+			request{"application/json", "application/x-test", `{"a":1}`},
+			response{200, "application/x-test", `test:{"a":1}`},
+		},
+		{
+			"codec request and response",
+			request{"application/x-test", "application/x-test", `test:{"a":1.5}`},
+			response{200, "application/x-test", `test:{"a":1.5}`},
+		},
+		{
+			"unsupported media type",
+			request{"text/xml", "", `<a>1</a>`},
+			response{415, "text/plain; charset=utf-8", "unsupported media type \"text/xml\"\n"},
+		},
+		{
+			"malformed codec request",
+			request{"application/x-test", "", `{"a":1}`},
+			response{400, "text/plain; charset=utf-8", "no test prefix\n"},
+		},
+	}
+	requests := make([]request, 0, len(tests))
+	for _, tt := range tests {
+		requests = append(requests, tt.request)
+	}
+	var got []response
+	runCodecHarness(t, bin, "handler", requests, &got)
+	if len(got) != len(tests) {
+		t.Fatalf("the handler returned %d responses, want %d", len(got), len(tests))
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(got[i], tt.want) {
+				t.Errorf("response = %+v, want %+v", got[i], tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,322 @@
+package generator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/swipe-io/strcase"
+	"github.com/swipe-io/swipe/v3/internal/plugin"
+	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/config"
+	"github.com/swipe-io/swipe/v3/option"
+	"github.com/swipe-io/swipe/v3/swipe"
+	"github.com/swipe-io/swipe/v3/writer"
+)
+
+// ContractTestGenerator generates the test that calls every method of the interface through
+// the generated Go client and the generated server with a mock service.
+type ContractTestGenerator struct {
+	w             writer.GoWriter
+	Interface     *config.Interface
+	Interfaces    []*config.Interface
+	MethodOptions map[string]config.MethodOptions
+	IfaceErrors   map[string]map[string][]config.Error
+	JSONRPCEnable bool
+	JSONRPCPath   string
+	ClientPkgName string
+	ClientPkgPath string
+	WriteHelpers  bool
+}
+
+func (g *ContractTestGenerator) Generate(ctx context.Context) []byte {
+	importer := ctx.Value(swipe.ImporterKey).(swipe.Importer)
+
+	testingPkg := importer.Import("testing", "testing")
+	clientPkg := importer.Import(g.ClientPkgName, g.ClientPkgPath)
+
+	if g.WriteHelpers {
+		g.writeHelpers(importer)
+	}
+
+	iface := g.Interface
+	ifaceType := iface.Named.Type.(*option.IfaceType)
+	contextPkg := importer.Import("context", "context")
+
+	g.w.W("func Test%sContract(t *%s.T) {\n", UcNameWithAppPrefix(iface), testingPkg)
+
+	var handlerArgs []string
+	for _, other := range g.Interfaces {
+		mockVar := ServicePropName(other)
+		g.w.W("%s := &Mock%s{}\n", mockVar, UcNameWithAppPrefix(other))
+		handlerArgs = append(handlerArgs, mockVar)
+	}
+
+	schemes := makeAuthSchemes(g.Interfaces, g.MethodOptions)
+	limits := makeRateLimits(g.Interfaces, g.MethodOptions)
+
+	serverOptions := testServerOptions(importer, schemes, limits, "contractKey()")
+
+	var clientOptions []string
+	if schemes.bearer {
+		clientOptions = append(clientOptions, fmt.Sprintf("%s.BearerTokenOption(func(%s.Context) string { return \"contract\" })", clientPkg, contextPkg))
+	}
+	if schemes.apiKey() {
+		clientOptions = append(clientOptions, fmt.Sprintf("%s.APIKeyOption(func(%s.Context, string) string { return \"contract\" })", clientPkg, contextPkg))
+	}
+	if schemes.basic {
+		clientOptions = append(clientOptions, fmt.Sprintf("%s.BasicAuthOption(func(%s.Context) (string, string) { return \"contract\", \"contract\" })", clientPkg, contextPkg))
+	}
+	if len(limits.keyHeaders) > 0 {
+		var (
+			kitPkg  string
+			httpPkg = importer.Import("http", "net/http")
+		)
+		if g.JSONRPCEnable {
+			kitPkg = importer.Import("jsonrpc", "github.com/l-vitaly/go-kit/transport/http/jsonrpc")
+		} else {
+			kitPkg = importer.Import("http", "github.com/go-kit/kit/transport/http")
+		}
+		before := fmt.Sprintf("func(ctx %s.Context, r *%s.Request) %s.Context {\n", contextPkg, httpPkg, contextPkg)
+		for _, name := range limits.keyHeaders {
+			before += fmt.Sprintf("r.Header.Set(%s, contractKey())\n", strconv.Quote(name))
+		}
+		before += "return ctx\n}"
+		clientOptions = append(clientOptions, fmt.Sprintf("%[1]s.GenericClientOptions(%[1]s.ClientOptions(%[2]s.ClientBefore(%[3]s)))", clientPkg, kitPkg, before))
+	}
+
+	handlerFunc, clientFunc := "MakeHandlerREST", "NewClientREST"
+	target := "srv.URL"
+	if g.JSONRPCEnable {
+		handlerFunc, clientFunc = "MakeHandlerJSONRPC", "NewClientJSONRPC"
+		if g.JSONRPCPath != "" {
+			target += " + " + strconv.Quote(g.JSONRPCPath)
+		}
+	}
+	if len(g.Interfaces) > 1 {
+		clientFunc += UcNameWithAppPrefix(iface)
+	}
+
+	g.w.W("h, err := %s(%s", handlerFunc, strings.Join(handlerArgs, ", "))
+	for _, o := range serverOptions {
+		g.w.W(",\n%s", o)
+	}
+	g.w.W(")\n")
+	g.w.W("if err != nil {\nt.Fatal(err)\n}\n")
+	g.w.W("srv := %s.NewServer(h)\n", importer.Import("httptest", "net/http/httptest"))
+	g.w.W("defer srv.Close()\n")
+	g.w.W("c, err := %s.%s(%s", clientPkg, clientFunc, target)
+	for _, o := range clientOptions {
+		g.w.W(",\n%s", o)
+	}
+	g.w.W(")\n")
+	g.w.W("if err != nil {\nt.Fatal(err)\n}\n")
+
+	svcVar := ServicePropName(iface)
+
+	for _, m := range ifaceType.Methods {
+		if g.hasFileTransfer(m) {
+			g.w.W("t.Run(%s, func(t *%s.T) {\n", strconv.Quote(m.Name.Value), testingPkg)
+			g.w.W("t.Skip(\"file transfer is not covered by contract tests\")\n")
+			g.w.W("})\n")
+			continue
+		}
+		g.writeMethodTest(importer, iface, m, svcVar, testingPkg, contextPkg, nil)
+
+		mopt := g.MethodOptions[iface.Named.Name.Value+m.Name.Value]
+		if mopt.ErrorDecode.Fn != nil || plugin.Error(m.Sig.Results) == nil {
+			continue
+		}
+		errorsDub := map[string]struct{}{}
+		for _, e := range g.IfaceErrors[iface.Named.Name.Value][m.Name.Value] {
+			key := e.PkgPath + "." + e.Name
+			if _, ok := errorsDub[key]; ok {
+				continue
+			}
+			errorsDub[key] = struct{}{}
+			e := e
+			g.writeMethodTest(importer, iface, m, svcVar, testingPkg, contextPkg, &e)
+		}
+	}
+	g.w.W("}\n\n")
+
+	return g.w.Bytes()
+}
+
+func (g *ContractTestGenerator) writeHelpers(importer swipe.Importer) {
+	testingPkg := importer.Import("testing", "testing")
+	jsonPkg := importer.Import("json", "encoding/json")
+	atomicPkg := importer.Import("atomic", "sync/atomic")
+	strconvPkg := importer.Import("strconv", "strconv")
+
+	g.w.W("var contractRequestID int64\n\n")
+
+	g.w.W("// contractKey returns a unique key, it keeps the requests of contract tests out of the rate limit buckets of each other.\n")
+	g.w.W("func contractKey() string {\n")
+	g.w.W("return \"contract-\" + %s.FormatInt(%s.AddInt64(&contractRequestID, 1), 10)\n", strconvPkg, atomicPkg)
+	g.w.W("}\n\n")
+
+	g.w.W("func contractUnmarshal(t *%s.T, data string, v interface{}) {\n", testingPkg)
+	g.w.W("t.Helper()\n")
+	g.w.W("if err := %s.Unmarshal([]byte(data), v); err != nil {\n", jsonPkg)
+	g.w.W("t.Fatalf(\"contract value %%s: %%v\", data, err)\n")
+	g.w.W("}\n")
+	g.w.W("}\n\n")
+
+	g.w.W("// contractEqual compares the JSON representation of the values, it is what goes over the wire.\n")
+	g.w.W("func contractEqual(t *%s.T, name string, want, got interface{}) {\n", testingPkg)
+	g.w.W("t.Helper()\n")
+	g.w.W("wantData, err := %s.Marshal(want)\n", jsonPkg)
+	g.w.W("if err != nil {\nt.Errorf(\"%%s: %%v\", name, err)\nreturn\n}\n")
+	g.w.W("gotData, err := %s.Marshal(got)\n", jsonPkg)
+	g.w.W("if err != nil {\nt.Errorf(\"%%s: %%v\", name, err)\nreturn\n}\n")
+	g.w.W("if string(wantData) != string(gotData) {\n")
+	g.w.W("t.Errorf(\"%%s: want %%s, got %%s\", name, wantData, gotData)\n")
+	g.w.W("}\n")
+	g.w.W("}\n\n")
+}
+
+func (g *ContractTestGenerator) writeMethodTest(
+	importer swipe.Importer,
+	iface *config.Interface,
+	m *option.FuncType,
+	svcVar, testingPkg, contextPkg string,
+	e *config.Error,
+) {
+	mopt := g.MethodOptions[iface.Named.Name.Value+m.Name.Value]
+
+	name := m.Name.Value
+	if e != nil {
+		name += "/" + e.Name
+	}
+	g.w.W("t.Run(%s, func(t *%s.T) {\n", strconv.Quote(name), testingPkg)
+	g.w.W("%s.Reset()\n", svcVar)
+
+	var (
+		stubParams, stubResults, callArgs, gotVars []string
+		returns                                    []string
+	)
+	for i, p := range m.Sig.Params {
+		argName := fmt.Sprintf("a%d", i)
+		if plugin.IsContext(p) {
+			stubParams = append(stubParams, argName+" "+swipe.TypeString(p.Type, false, importer))
+			callArgs = append(callArgs, contextPkg+".Background()")
+			continue
+		}
+		wantName := fmt.Sprintf("p%d", i)
+		typeStr := swipe.TypeString(p.Type, false, importer)
+		g.w.W("var %s %s\n", wantName, typeStr)
+		g.w.W("contractUnmarshal(t, %s, &%s)\n", strconv.Quote(sampleJSON(p.Type, g.pathVarRegexp(mopt, p))), wantName)
+		if st, ok := p.Type.(*option.SliceType); ok && p.IsVariadic {
+			stubParams = append(stubParams, argName+" ..."+swipe.TypeString(st.Value, false, importer))
+			callArgs = append(callArgs, wantName+"...")
+		} else {
+			stubParams = append(stubParams, argName+" "+typeStr)
+			callArgs = append(callArgs, wantName)
+		}
+	}
+
+	var errorVar string
+	for i, r := range m.Sig.Results {
+		typeStr := swipe.TypeString(r.Type, false, importer)
+		stubResults = append(stubResults, typeStr)
+		if plugin.IsError(r) {
+			errorVar = "err"
+			gotVars = append(gotVars, errorVar)
+			if e != nil {
+				pkgName := importer.Import(e.PkgName, e.PkgPath)
+				if pkgName != "" {
+					pkgName += "."
+				}
+				g.w.W("wantErr := &%s%s{}\n", pkgName, e.Name)
+				returns = append(returns, "wantErr")
+			} else {
+				returns = append(returns, "nil")
+			}
+			continue
+		}
+		wantName := fmt.Sprintf("r%d", i)
+		g.w.W("var %s %s\n", wantName, typeStr)
+		if e == nil {
+			g.w.W("contractUnmarshal(t, %s, &%s)\n", strconv.Quote(sampleJSON(r.Type, "")), wantName)
+		}
+		returns = append(returns, wantName)
+		gotVars = append(gotVars, fmt.Sprintf("got%d", i))
+	}
+
+	g.w.W("%s.On%s(func(%s) (%s) {\n", svcVar, m.Name.Value, strings.Join(stubParams, ", "), strings.Join(stubResults, ", "))
+	for i, p := range m.Sig.Params {
+		if plugin.IsContext(p) {
+			continue
+		}
+		g.w.W("contractEqual(t, %s, p%d, a%d)\n", strconv.Quote(p.Name.Value), i, i)
+	}
+	if len(returns) > 0 {
+		g.w.W("return %s\n", strings.Join(returns, ", "))
+	}
+	g.w.W("})\n")
+
+	call := fmt.Sprintf("c.%s(%s)", m.Name.Value, strings.Join(callArgs, ", "))
+	if len(gotVars) > 0 {
+		g.w.W("%s := %s\n", strings.Join(gotVars, ", "), call)
+	} else {
+		g.w.W("%s\n", call)
+	}
+
+	if errorVar != "" {
+		if e != nil {
+			reflectPkg := importer.Import("reflect", "reflect")
+			g.w.W("if %[1]s.TypeOf(err) != %[1]s.TypeOf(wantErr) {\n", reflectPkg)
+			g.w.W("t.Fatalf(\"want error %%T, got %%T: %%v\", wantErr, err, err)\n")
+			g.w.W("}\n")
+		} else {
+			g.w.W("if err != nil {\nt.Fatal(err)\n}\n")
+		}
+	}
+	if e == nil {
+		for i, r := range m.Sig.Results {
+			if plugin.IsError(r) {
+				continue
+			}
+			g.w.W("contractEqual(t, %s, r%d, got%d)\n", strconv.Quote(r.Name.Value), i, i)
+		}
+	} else {
+		for i, r := range m.Sig.Results {
+			if !plugin.IsError(r) {
+				g.w.W("_ = got%d\n", i)
+			}
+		}
+	}
+	g.w.W("%s.Assert%sCalled(t, 1)\n", svcVar, m.Name.Value)
+	g.w.W("})\n")
+}
+
+func (g *ContractTestGenerator) pathVarRegexp(mopt config.MethodOptions, p *option.VarType) string {
+	if g.JSONRPCEnable {
+		return ""
+	}
+	if re, ok := mopt.RESTPathVars[p.Name.Value]; ok {
+		if re == "" {
+			return "[^/]+"
+		}
+		return re
+	}
+	return ""
+}
+
+func (g *ContractTestGenerator) hasFileTransfer(m *option.FuncType) bool {
+	for _, p := range m.Sig.Params {
+		if isFileUploadType(p.Type) {
+			return true
+		}
+	}
+	return plugin.DownloadFile(m.Sig.Results) != nil
+}
+
+func (g *ContractTestGenerator) OutputPath() string {
+	return ""
+}
+
+func (g *ContractTestGenerator) Filename() string {
+	return strcase.ToSnake(UcNameWithAppPrefix(g.Interface)) + "_contract_test.go"
+}
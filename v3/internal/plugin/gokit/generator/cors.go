@@ -0,0 +1,229 @@
+package generator
+
+import (
+	"strconv"
+	stdstrings "strings"
+
+	"github.com/swipe-io/swipe/v3/internal/plugin"
+	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/config"
+	"github.com/swipe-io/swipe/v3/option"
+	"github.com/swipe-io/swipe/v3/swipe"
+	"github.com/swipe-io/swipe/v3/writer"
+)
+
+type corsRoute struct {
+	path    string
+	methods []string
+	headers []string
+}
+
+type corsRoutes []*corsRoute
+
+func (r *corsRoutes) add(path, method string, headers []string) {
+	for _, route := range *r {
+		if route.path == path {
+			route.methods = appendCORSValues(route.methods, method)
+			route.headers = appendCORSValues(route.headers, headers...)
+			return
+		}
+	}
+	*r = append(*r, &corsRoute{path: path, methods: []string{method}, headers: appendCORSValues(nil, headers...)})
+}
+
+// containsCORSValue reports whether the list contains the value, the header and the method names are case-insensitive.
+func containsCORSValue(list []string, v string) bool {
+	for _, l := range list {
+		if stdstrings.EqualFold(l, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func appendCORSValues(list []string, values ...string) []string {
+	for _, v := range values {
+		if !containsCORSValue(list, v) {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+// corsAuthHeaders returns the credential headers sent to the method.
+func corsAuthHeaders(mopt config.MethodOptions) (headers []string) {
+	if mopt.BearerAuth != nil || mopt.BasicAuth != nil {
+		headers = append(headers, "Authorization")
+	}
+	if mopt.APIKeyAuth != nil && mopt.APIKeyAuth.In == "header" {
+		headers = append(headers, mopt.APIKeyAuth.Name)
+	}
+	return
+}
+
+// corsRESTHeaders returns the request headers sent to the REST method by the generated clients.
+func corsRESTHeaders(httpMethod string, m *option.FuncType, mopt config.MethodOptions) []string {
+	headers := corsAuthHeaders(mopt)
+	switch httpMethod {
+	case "POST", "PUT", "PATCH":
+		for _, p := range m.Sig.Params {
+			if !plugin.IsContext(p) {
+				headers = append(headers, "Content-Type")
+				break
+			}
+		}
+	}
+	for i := 0; i < len(mopt.RESTHeaderVars.Value); i += 2 {
+		headers = append(headers, mopt.RESTHeaderVars.Value[i])
+	}
+	if mopt.Idempotent != nil {
+		headers = append(headers, idempotencyKeyHeader)
+	}
+	return headers
+}
+
+// corsAllowMethods returns the configured methods served by the route,
+// all the route methods are allowed when the methods are not configured.
+func corsAllowMethods(cors *config.HTTPCORS, route *corsRoute) string {
+	methods := make([]string, 0, len(route.methods)+1)
+	for _, m := range route.methods {
+		if len(cors.Methods) == 0 || containsCORSValue(cors.Methods, m) {
+			methods = append(methods, m)
+		}
+	}
+	return stdstrings.Join(append(methods, "OPTIONS"), ", ")
+}
+
+// corsAllowHeaders returns the configured headers extended by the headers sent to the route.
+func corsAllowHeaders(cors *config.HTTPCORS, route *corsRoute) string {
+	return stdstrings.Join(appendCORSValues(append([]string{}, cors.Headers...), route.headers...), ", ")
+}
+
+// writeCORSServer writes the CORS middleware and the preflight handler,
+// the expose headers are the response headers readable by the browser clients.
+func writeCORSServer(w *writer.GoWriter, importer swipe.Importer, useFast bool, cors *config.HTTPCORS, exposeHeaders []string) {
+	var (
+		httpPkg   string
+		routerPkg string
+	)
+	if useFast {
+		httpPkg = importer.Import("fasthttp", "github.com/valyala/fasthttp")
+		routerPkg = importer.Import("routing", "github.com/qiangxue/fasthttp-routing")
+	} else {
+		httpPkg = importer.Import("http", "net/http")
+	}
+
+	w.W("var corsAllowedOrigins = []string{")
+	for i, origin := range cors.Origins {
+		if i > 0 {
+			w.W(", ")
+		}
+		w.W(strconv.Quote(origin))
+	}
+	w.W("}\n\n")
+
+	w.W("func corsAllowOrigin(origin string) (string, bool) {\n")
+	w.W("if origin == \"\" {\nreturn \"\", false\n}\n")
+	w.W("for _, o := range corsAllowedOrigins {\n")
+	w.W("if o == \"*\" {\nreturn \"*\", true\n}\n")
+	w.W("if o == origin {\nreturn origin, true\n}\n")
+	w.W("}\n")
+	w.W("return \"\", false\n")
+	w.W("}\n\n")
+
+	if useFast {
+		w.W("func corsMiddleware(c *%s.Context) error {\n", routerPkg)
+		w.W("if origin, ok := corsAllowOrigin(string(c.Request.Header.Peek(\"Origin\"))); ok {\n")
+		w.W("c.Response.Header.Set(\"Access-Control-Allow-Origin\", origin)\n")
+		w.W("c.Response.Header.Add(\"Vary\", \"Origin\")\n")
+		if len(exposeHeaders) > 0 {
+			w.W("c.Response.Header.Set(\"Access-Control-Expose-Headers\", %s)\n", strconv.Quote(stdstrings.Join(exposeHeaders, ", ")))
+		}
+		w.W("}\n")
+		w.W("return nil\n")
+		w.W("}\n\n")
+
+		if len(cors.Headers) > 0 {
+			w.W("func corsPreflightHandler(methods, headers string) %s.Handler {\n", routerPkg)
+		} else {
+			w.W("func corsPreflightHandler(methods string) %s.Handler {\n", routerPkg)
+		}
+		w.W("return func(c *%s.Context) error {\n", routerPkg)
+		w.W("if _, ok := corsAllowOrigin(string(c.Request.Header.Peek(\"Origin\"))); !ok {\n")
+		w.W("c.SetStatusCode(%s.StatusForbidden)\n", httpPkg)
+		w.W("return nil\n")
+		w.W("}\n")
+		w.W("c.Response.Header.Set(\"Access-Control-Allow-Methods\", methods)\n")
+		if len(cors.Headers) > 0 {
+			w.W("c.Response.Header.Set(\"Access-Control-Allow-Headers\", headers)\n")
+		} else {
+			w.W("if headers := c.Request.Header.Peek(\"Access-Control-Request-Headers\"); len(headers) > 0 {\n")
+			w.W("c.Response.Header.SetBytesV(\"Access-Control-Allow-Headers\", headers)\n")
+			w.W("}\n")
+		}
+		if cors.MaxAge > 0 {
+			w.W("c.Response.Header.Set(\"Access-Control-Max-Age\", %s)\n", strconv.Quote(strconv.FormatInt(cors.MaxAge, 10)))
+		}
+		w.W("c.SetStatusCode(%s.StatusNoContent)\n", httpPkg)
+		w.W("return nil\n")
+		w.W("}\n")
+		w.W("}\n\n")
+		return
+	}
+
+	w.W("func corsMiddleware(next %[1]s.Handler) %[1]s.Handler {\n", httpPkg)
+	w.W("return %[1]s.HandlerFunc(func(w %[1]s.ResponseWriter, r *%[1]s.Request) {\n", httpPkg)
+	w.W("if origin, ok := corsAllowOrigin(r.Header.Get(\"Origin\")); ok {\n")
+	w.W("w.Header().Set(\"Access-Control-Allow-Origin\", origin)\n")
+	w.W("w.Header().Add(\"Vary\", \"Origin\")\n")
+	if len(exposeHeaders) > 0 {
+		w.W("w.Header().Set(\"Access-Control-Expose-Headers\", %s)\n", strconv.Quote(stdstrings.Join(exposeHeaders, ", ")))
+	}
+	w.W("}\n")
+	w.W("next.ServeHTTP(w, r)\n")
+	w.W("})\n")
+	w.W("}\n\n")
+
+	if len(cors.Headers) > 0 {
+		w.W("func corsPreflightHandler(methods, headers string) %s.Handler {\n", httpPkg)
+	} else {
+		w.W("func corsPreflightHandler(methods string) %s.Handler {\n", httpPkg)
+	}
+	w.W("return %[1]s.HandlerFunc(func(w %[1]s.ResponseWriter, r *%[1]s.Request) {\n", httpPkg)
+	w.W("if _, ok := corsAllowOrigin(r.Header.Get(\"Origin\")); !ok {\n")
+	w.W("w.WriteHeader(%s.StatusForbidden)\n", httpPkg)
+	w.W("return\n")
+	w.W("}\n")
+	w.W("w.Header().Set(\"Access-Control-Allow-Methods\", methods)\n")
+	if len(cors.Headers) > 0 {
+		w.W("w.Header().Set(\"Access-Control-Allow-Headers\", headers)\n")
+	} else {
+		w.W("if headers := r.Header.Get(\"Access-Control-Request-Headers\"); headers != \"\" {\n")
+		w.W("w.Header().Set(\"Access-Control-Allow-Headers\", headers)\n")
+		w.W("}\n")
+	}
+	if cors.MaxAge > 0 {
+		w.W("w.Header().Set(\"Access-Control-Max-Age\", %s)\n", strconv.Quote(strconv.FormatInt(cors.MaxAge, 10)))
+	}
+	w.W("w.WriteHeader(%s.StatusNoContent)\n", httpPkg)
+	w.W("})\n")
+	w.W("}\n\n")
+}
+
+func writeCORSPreflightRoutes(w *writer.GoWriter, useFast bool, cors *config.HTTPCORS, routes corsRoutes) {
+	for _, route := range routes {
+		args := strconv.Quote(corsAllowMethods(cors, route))
+		// the configured allowed headers are extended, otherwise the preflight allows the requested ones
+		if len(cors.Headers) > 0 {
+			args += ", " + strconv.Quote(corsAllowHeaders(cors, route))
+		}
+		if useFast {
+			w.W("r.To(\"OPTIONS\", %s, corsPreflightHandler(%s))\n", strconv.Quote(route.path), args)
+			continue
+		}
+		w.W("r.Methods(\"OPTIONS\").")
+		if route.path != "" {
+			w.W("Path(%s).", strconv.Quote(route.path))
+		}
+		w.W("Handler(corsPreflightHandler(%s))\n", args)
+	}
+}
@@ -54,8 +54,8 @@ func (g *CURL) writeCURLJSONRPC(iface *config.Interface) {
 	ifaceType := iface.Named.Type.(*option.IfaceType)
 	for _, m := range ifaceType.Methods {
 		methodName := m.Name.Lower()
-		if iface.Namespace != "" {
-			methodName = iface.Namespace + "." + methodName
+		if prefix := rpcPrefix(iface); prefix != "" {
+			methodName = prefix + "." + methodName
 		}
 
 		body := g.buildBody(m.Sig.Params)
@@ -81,8 +81,8 @@ func (g *CURL) writeCURLREST(iface *config.Interface) {
 		mopt := g.MethodOptions[iface.Named.Name.Value+m.Name.Value]
 		body := g.buildBody(m.Sig.Params)
 		methodName := m.Name.Lower()
-		if iface.Namespace != "" {
-			methodName = iface.Namespace + "." + methodName
+		if prefix := rpcPrefix(iface); prefix != "" {
+			methodName = prefix + "." + methodName
 		}
 		result := curlbuilder.New().
 			SetMethod(mopt.RESTMethod.Take()).
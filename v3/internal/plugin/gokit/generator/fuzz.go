@@ -0,0 +1,321 @@
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+	stdstrings "strings"
+
+	"github.com/swipe-io/strcase"
+	"github.com/swipe-io/swipe/v3/internal/plugin"
+	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/config"
+	"github.com/swipe-io/swipe/v3/option"
+	"github.com/swipe-io/swipe/v3/swipe"
+	"github.com/swipe-io/swipe/v3/writer"
+)
+
+const fuzzMultipartBoundary = "swipefuzz"
+
+// FuzzTestGenerator generates the native fuzz targets for the server request decoders.
+type FuzzTestGenerator struct {
+	w             writer.GoWriter
+	Interface     *config.Interface
+	Interfaces    []*config.Interface
+	MethodOptions map[string]config.MethodOptions
+	JSONRPCEnable bool
+	UseFast       bool
+}
+
+func (g *FuzzTestGenerator) Generate(ctx context.Context) []byte {
+	importer := ctx.Value(swipe.ImporterKey).(swipe.Importer)
+
+	ifaceType := g.Interface.Named.Type.(*option.IfaceType)
+	for _, m := range ifaceType.Methods {
+		if g.JSONRPCEnable {
+			g.writeJSONRPCTarget(importer, m)
+		} else {
+			g.writeRESTTarget(importer, m)
+		}
+	}
+	return g.w.Bytes()
+}
+
+// writeJSONRPCTarget writes the target that calls the decoder of the endpoint codec map directly,
+// the endpoints are never called so the service is not needed.
+func (g *FuzzTestGenerator) writeJSONRPCTarget(importer swipe.Importer, m *option.FuncType) {
+	testingPkg := importer.Import("testing", "testing")
+	contextPkg := importer.Import("context", "context")
+
+	// the decoder does not read the params, there is nothing to fuzz.
+	if len(m.Sig.Params) == 0 {
+		return
+	}
+
+	params := map[string]interface{}{}
+	for _, p := range m.Sig.Params {
+		if plugin.IsContext(p) {
+			continue
+		}
+		params[p.Name.Value] = sampleValue(p.Type, "", map[string]int{})
+	}
+	seed, _ := json.Marshal(params)
+
+	g.w.W("func FuzzDecode%s(f *%s.F) {\n", NameRequest(m, g.Interface), testingPkg)
+	g.w.W("ecm := Make%sEndpointCodecMap(Make%s(nil))\n", UcNameWithAppPrefix(g.Interface), NameEndpointSetName(g.Interface))
+	g.w.W("f.Add([]byte(%s))\n", strconv.Quote(string(seed)))
+	g.w.W("f.Add([]byte(\"null\"))\n")
+	g.w.W("f.Fuzz(func(t *%s.T, params []byte) {\n", testingPkg)
+	g.w.W("_, _ = ecm[%s].Decode(%s.Background(), params)\n", strconv.Quote(m.Name.Lower()), contextPkg)
+	g.w.W("})\n")
+	g.w.W("}\n\n")
+}
+
+// writeRESTTarget writes the target that sends the fuzzed path vars, query vars, headers and body
+// to the handler, the decoders are inlined into the handler.
+func (g *FuzzTestGenerator) writeRESTTarget(importer swipe.Importer, m *option.FuncType) {
+	testingPkg := importer.Import("testing", "testing")
+
+	mopt := g.MethodOptions[g.Interface.Named.Name.Value+m.Name.Value]
+
+	bodyType := mopt.RESTBodyType.Take()
+	if bodyType == "" {
+		bodyType = "json"
+	}
+
+	var (
+		pathVars, queryVars, headerVars []plugin.VarType
+		paramVars                       []*option.VarType
+		fuzzArgs                        []string
+		seeds                           []string
+		argNames                        = map[string]string{}
+	)
+	for i, p := range m.Sig.Params {
+		if plugin.IsContext(p) {
+			continue
+		}
+		argName := fmt.Sprintf("p%d", i)
+		if v, ok := plugin.FindParam(p, mopt.RESTQueryVars.Value); ok {
+			queryVars = append(queryVars, v)
+		} else if v, ok := plugin.FindParam(p, mopt.RESTHeaderVars.Value); ok {
+			headerVars = append(headerVars, v)
+		} else if regexp, ok := mopt.RESTPathVars[p.Name.Value]; ok {
+			pathVars = append(pathVars, plugin.VarType{Param: p, Value: regexp})
+		} else {
+			paramVars = append(paramVars, p)
+			continue
+		}
+		argNames[p.Name.Value] = argName
+		fuzzArgs = append(fuzzArgs, argName+" string")
+		re := ""
+		if regexp, ok := mopt.RESTPathVars[p.Name.Value]; ok {
+			re = regexp
+			if re == "" {
+				re = "[^/]+"
+			}
+		}
+		seeds = append(seeds, strconv.Quote(sampleString(p.Type, re)))
+	}
+
+	var hasBody bool
+	switch stdstrings.ToUpper(mopt.RESTMethod.Take()) {
+	case "POST", "PUT", "PATCH":
+		hasBody = len(paramVars) > 0
+	}
+	if hasBody {
+		fuzzArgs = append(fuzzArgs, "body []byte")
+		seeds = append(seeds, "[]byte("+strconv.Quote(g.bodySeed(bodyType, paramVars))+")")
+	}
+
+	// the decoder does not read the request, there is nothing to fuzz.
+	if len(fuzzArgs) == 0 {
+		return
+	}
+
+	var urlPath string
+	if mopt.RESTPath.IsValid() {
+		urlPath = mopt.RESTPath.Take()
+	} else {
+		urlPath = strcase.ToKebab(m.Name.Value)
+	}
+	if prefix := restPrefix(g.Interface, g.Interface.Namespace); prefix != "" {
+		urlPath = path.Join(prefix, urlPath)
+	}
+	if !stdstrings.HasPrefix(urlPath, "/") {
+		urlPath = "/" + urlPath
+	}
+
+	httpMethod := "GET"
+	if mopt.RESTMethod.Take() != "" {
+		httpMethod = mopt.RESTMethod.Take()
+	}
+
+	var handlerArgs []string
+	for _, iface := range g.Interfaces {
+		handlerArgs = append(handlerArgs, "&Mock"+UcNameWithAppPrefix(iface)+"{}")
+	}
+	serverOptions := testServerOptions(
+		importer,
+		makeAuthSchemes(g.Interfaces, g.MethodOptions),
+		makeRateLimits(g.Interfaces, g.MethodOptions),
+		strconv.Quote("fuzz"),
+	)
+
+	g.w.W("func FuzzDecode%s(f *%s.F) {\n", NameRequest(m, g.Interface), testingPkg)
+	g.w.W("h, err := MakeHandlerREST(%s", stdstrings.Join(handlerArgs, ", "))
+	for _, o := range serverOptions {
+		g.w.W(",\n%s", o)
+	}
+	g.w.W(")\n")
+	g.w.W("if err != nil {\nf.Fatal(err)\n}\n")
+	if len(seeds) > 0 {
+		g.w.W("f.Add(%s)\n", stdstrings.Join(seeds, ", "))
+	}
+	g.w.W("f.Fuzz(func(t *%s.T", testingPkg)
+	for _, a := range fuzzArgs {
+		g.w.W(", %s", a)
+	}
+	g.w.W(") {\n")
+
+	pathExpr := g.pathExpr(urlPath, argNames)
+
+	var queryExpr string
+	if len(queryVars) > 0 {
+		urlPkg := importer.Import("url", "net/url")
+		g.w.W("q := %s.Values{}\n", urlPkg)
+		for _, v := range queryVars {
+			g.w.W("q.Set(%s, %s)\n", strconv.Quote(v.Value), argNames[v.Param.Name.Value])
+		}
+		queryExpr = "q.Encode()"
+	}
+
+	var contentType string
+	if hasBody {
+		switch bodyType {
+		case "json":
+			contentType = "application/json"
+		case "urlencoded":
+			contentType = "application/x-www-form-urlencoded"
+		case "multipart":
+			contentType = "multipart/form-data; boundary=" + fuzzMultipartBoundary
+		}
+	}
+
+	if g.UseFast {
+		fasthttpPkg := importer.Import("fasthttp", "github.com/valyala/fasthttp")
+		g.w.W("var req %s.Request\n", fasthttpPkg)
+		g.w.W("req.Header.SetMethod(%s)\n", strconv.Quote(httpMethod))
+		g.w.W("req.URI().SetPath(%s)\n", pathExpr)
+		if queryExpr != "" {
+			g.w.W("req.URI().SetQueryString(%s)\n", queryExpr)
+		}
+		for _, v := range headerVars {
+			g.w.W("req.Header.Set(%s, %s)\n", strconv.Quote(v.Value), argNames[v.Param.Name.Value])
+		}
+		if hasBody {
+			g.w.W("req.Header.SetContentType(%s)\n", strconv.Quote(contentType))
+			g.w.W("req.SetBody(body)\n")
+		}
+		g.w.W("var ctx %s.RequestCtx\n", fasthttpPkg)
+		g.w.W("ctx.Init(&req, nil, nil)\n")
+		g.w.W("h(&ctx)\n")
+	} else {
+		httpPkg := importer.Import("http", "net/http")
+		urlPkg := importer.Import("url", "net/url")
+		g.w.W("r := &%s.Request{\n", httpPkg)
+		g.w.W("Method: %s,\n", strconv.Quote(httpMethod))
+		g.w.W("URL: &%s.URL{Path: %s", urlPkg, pathExpr)
+		if queryExpr != "" {
+			g.w.W(", RawQuery: %s", queryExpr)
+		}
+		g.w.W("},\n")
+		g.w.W("Header: %s.Header{},\n", httpPkg)
+		if hasBody {
+			ioPkg := importer.Import("io", "io")
+			bytesPkg := importer.Import("bytes", "bytes")
+			g.w.W("Body: %s.NopCloser(%s.NewReader(body)),\n", ioPkg, bytesPkg)
+		} else {
+			g.w.W("Body: %s.NoBody,\n", httpPkg)
+		}
+		g.w.W("}\n")
+		for _, v := range headerVars {
+			g.w.W("r.Header.Set(%s, %s)\n", strconv.Quote(v.Value), argNames[v.Param.Name.Value])
+		}
+		if hasBody {
+			g.w.W("r.Header.Set(\"Content-Type\", %s)\n", strconv.Quote(contentType))
+		}
+		g.w.W("h.ServeHTTP(%s.NewRecorder(), r)\n", importer.Import("httptest", "net/http/httptest"))
+	}
+	g.w.W("})\n")
+	g.w.W("}\n\n")
+}
+
+// pathExpr returns the expression that substitutes the fuzz arguments into the path template.
+func (g *FuzzTestGenerator) pathExpr(urlPath string, argNames map[string]string) string {
+	var (
+		parts []string
+		text  string
+	)
+	for _, part := range splitPathTemplate(urlPath) {
+		argName, ok := argNames[part.name]
+		if !ok {
+			text += part.text
+			continue
+		}
+		if text != "" {
+			parts = append(parts, strconv.Quote(text))
+			text = ""
+		}
+		parts = append(parts, argName)
+	}
+	if text != "" {
+		parts = append(parts, strconv.Quote(text))
+	}
+	return stdstrings.Join(parts, " + ")
+}
+
+func (g *FuzzTestGenerator) bodySeed(bodyType string, paramVars []*option.VarType) string {
+	switch bodyType {
+	case "urlencoded":
+		values := url.Values{}
+		for _, p := range paramVars {
+			values.Set(p.Name.Value, sampleString(p.Type, ""))
+		}
+		return values.Encode()
+	case "multipart":
+		var buf stdstrings.Builder
+		for _, p := range paramVars {
+			if isFileUploadType(p.Type) {
+				continue
+			}
+			buf.WriteString("--" + fuzzMultipartBoundary + "\r\n")
+			buf.WriteString("Content-Disposition: form-data; name=\"" + p.Name.Value + "\"\r\n\r\n")
+			buf.WriteString(sampleString(p.Type, "") + "\r\n")
+		}
+		buf.WriteString("--" + fuzzMultipartBoundary + "--\r\n")
+		return buf.String()
+	}
+	if len(paramVars) == 1 {
+		if s, ok := paramVars[0].Type.(*option.SliceType); ok {
+			if b, ok := s.Value.(*option.BasicType); ok && b.IsByte() {
+				return "\x01\x02\x03"
+			}
+		}
+	}
+	values := map[string]interface{}{}
+	for _, p := range paramVars {
+		values[p.Name.Value] = sampleValue(p.Type, "", map[string]int{})
+	}
+	data, _ := json.Marshal(values)
+	return string(data)
+}
+
+func (g *FuzzTestGenerator) OutputPath() string {
+	return ""
+}
+
+func (g *FuzzTestGenerator) Filename() string {
+	return strcase.ToSnake(UcNameWithAppPrefix(g.Interface)) + "_fuzz_test.go"
+}
@@ -0,0 +1,238 @@
+package generator
+
+import (
+	"path"
+	"strconv"
+	stdstrings "strings"
+
+	"github.com/swipe-io/strcase"
+
+	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/config"
+	"github.com/swipe-io/swipe/v3/option"
+	"github.com/swipe-io/swipe/v3/swipe"
+	"github.com/swipe-io/swipe/v3/writer"
+)
+
+const (
+	healthLivePath  = "/healthz"
+	healthReadyPath = "/readyz"
+	serviceInfoPath = "/info"
+)
+
+type serviceMethod struct {
+	name       string
+	httpMethod string
+	path       string
+}
+
+// makeServiceMethods returns the methods exposed by the server, the REST methods are listed with the route.
+func makeServiceMethods(ifaces []*config.Interface, methodOptions map[string]config.MethodOptions, jsonRPCEnable bool) (methods []serviceMethod) {
+	for _, iface := range ifaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+		for _, m := range ifaceType.Methods {
+			methodName := m.Name.Lower()
+			if prefix := rpcPrefix(iface); prefix != "" {
+				methodName = prefix + "." + methodName
+			}
+			if jsonRPCEnable {
+				methods = append(methods, serviceMethod{name: methodName})
+				continue
+			}
+			mopt := methodOptions[iface.Named.Name.Value+m.Name.Value]
+
+			var urlPath string
+			if mopt.RESTPath.IsValid() {
+				urlPath = mopt.RESTPath.Take()
+			} else {
+				urlPath = strcase.ToKebab(m.Name.Value)
+			}
+			if prefix := restPrefix(iface, iface.Namespace); prefix != "" {
+				urlPath = path.Join(prefix, urlPath)
+			}
+			if !stdstrings.HasPrefix(urlPath, "/") {
+				urlPath = "/" + urlPath
+			}
+			httpMethod := "GET"
+			if mopt.RESTMethod.Take() != "" {
+				httpMethod = stdstrings.ToUpper(mopt.RESTMethod.Take())
+			}
+			methods = append(methods, serviceMethod{name: methodName, httpMethod: httpMethod, path: urlPath})
+		}
+	}
+	return
+}
+
+func writeHealthServerOptions(w *writer.GoWriter, importer swipe.Importer) {
+	timePkg := importer.Import("time", "time")
+
+	w.W("// HealthCheckOption registers the readiness checker, the check fails when the checker\n")
+	w.W("// does not return within the timeout, a zero timeout waits for the checker.\n")
+	w.W("func HealthCheckOption(name string, timeout %s.Duration, checker HealthChecker) ServerOption {\n", timePkg)
+	w.W("return func(c *serverOpts) {\n")
+	w.W("c.healthChecks = append(c.healthChecks, healthCheck{name: name, timeout: timeout, checker: checker})\n")
+	w.W("}\n}\n\n")
+
+	w.W("// VersionOption sets the service version of the info endpoint, the main module version is used by default.\n")
+	w.W("func VersionOption(version string) ServerOption {\nreturn func(c *serverOpts) {\nc.version = version\n}\n}\n\n")
+}
+
+func writeHealthServerOptsFields(w *writer.GoWriter) {
+	w.W("healthChecks []healthCheck\n")
+	w.W("version string\n")
+}
+
+func writeHealthServer(w *writer.GoWriter, importer swipe.Importer, useFast bool, appName string, methods []serviceMethod) {
+	contextPkg := importer.Import("context", "context")
+	debugPkg := importer.Import("debug", "runtime/debug")
+	jsonPkg := importer.Import("ffjson", "github.com/pquerna/ffjson/ffjson")
+	timePkg := importer.Import("time", "time")
+
+	w.W("// HealthChecker reports the readiness of a service dependency, a nil error means ready.\n")
+	w.W("type HealthChecker func(ctx %s.Context) error\n\n", contextPkg)
+
+	w.W("type healthCheck struct {\n")
+	w.W("name string\n")
+	w.W("timeout %s.Duration\n", timePkg)
+	w.W("checker HealthChecker\n")
+	w.W("}\n\n")
+
+	w.W("type healthStatus struct {\n")
+	w.W("Status string `json:\"status\"`\n")
+	w.W("Checks map[string]string `json:\"checks,omitempty\"`\n")
+	w.W("}\n\n")
+
+	w.W("type healthCheckResult struct {\nname string\nerr error\n}\n\n")
+
+	w.W("// runHealthChecks runs the checkers concurrently, the service is ready when all the checks pass.\n")
+	w.W("func runHealthChecks(ctx %s.Context, checks []healthCheck) (healthStatus, bool) {\n", contextPkg)
+	w.W("status := healthStatus{Status: \"ok\"}\n")
+	w.W("if len(checks) == 0 {\nreturn status, true\n}\n")
+	w.W("results := make(chan healthCheckResult, len(checks))\n")
+	w.W("for _, c := range checks {\n")
+	w.W("go func(c healthCheck) {\n")
+	w.W("var (\ncheckCtx %[1]s.Context\ncancel %[1]s.CancelFunc\n)\n", contextPkg)
+	w.W("if c.timeout > 0 {\n")
+	w.W("checkCtx, cancel = %s.WithTimeout(ctx, c.timeout)\n", contextPkg)
+	w.W("} else {\n")
+	w.W("checkCtx, cancel = %s.WithCancel(ctx)\n", contextPkg)
+	w.W("}\n")
+	w.W("defer cancel()\n")
+	w.W("done := make(chan error, 1)\n")
+	w.W("go func() {\ndone <- c.checker(checkCtx)\n}()\n")
+	w.W("select {\n")
+	w.W("case err := <-done:\nresults <- healthCheckResult{name: c.name, err: err}\n")
+	w.W("case <-checkCtx.Done():\nresults <- healthCheckResult{name: c.name, err: checkCtx.Err()}\n")
+	w.W("}\n")
+	w.W("}(c)\n")
+	w.W("}\n")
+	w.W("ready := true\n")
+	w.W("status.Checks = make(map[string]string, len(checks))\n")
+	w.W("for range checks {\n")
+	w.W("r := <-results\n")
+	w.W("if r.err != nil {\nready = false\nstatus.Checks[r.name] = r.err.Error()\ncontinue\n}\n")
+	w.W("status.Checks[r.name] = \"ok\"\n")
+	w.W("}\n")
+	w.W("if !ready {\nstatus.Status = \"unavailable\"\n}\n")
+	w.W("return status, ready\n")
+	w.W("}\n\n")
+
+	w.W("type serviceMethod struct {\n")
+	w.W("Name string `json:\"name\"`\n")
+	w.W("HTTPMethod string `json:\"httpMethod,omitempty\"`\n")
+	w.W("Path string `json:\"path,omitempty\"`\n")
+	w.W("}\n\n")
+
+	w.W("type serviceInfo struct {\n")
+	w.W("Name string `json:\"name\"`\n")
+	w.W("Version string `json:\"version\"`\n")
+	w.W("Methods []serviceMethod `json:\"methods\"`\n")
+	w.W("}\n\n")
+
+	w.W("var serviceMethods = []serviceMethod{\n")
+	for _, m := range methods {
+		w.W("{Name: %s", strconv.Quote(m.name))
+		if m.httpMethod != "" {
+			w.W(", HTTPMethod: %s, Path: %s", strconv.Quote(m.httpMethod), strconv.Quote(m.path))
+		}
+		w.W("},\n")
+	}
+	w.W("}\n\n")
+
+	w.W("func newServiceInfo(version string) serviceInfo {\n")
+	w.W("if version == \"\" {\n")
+	w.W("if info, ok := %s.ReadBuildInfo(); ok {\nversion = info.Main.Version\n}\n", debugPkg)
+	w.W("}\n")
+	w.W("return serviceInfo{Name: %s, Version: version, Methods: serviceMethods}\n", strconv.Quote(appName))
+	w.W("}\n\n")
+
+	if useFast {
+		httpPkg := importer.Import("fasthttp", "github.com/valyala/fasthttp")
+		routerPkg := importer.Import("routing", "github.com/qiangxue/fasthttp-routing")
+
+		w.W("func writeHealthResponse(r *%s.RequestCtx, code int, v interface{}) error {\n", httpPkg)
+		w.W("data, err := %s.Marshal(v)\n", jsonPkg)
+		w.W("if err != nil {\nreturn err\n}\n")
+		w.W("r.SetContentType(\"application/json; charset=utf-8\")\n")
+		w.W("r.SetStatusCode(code)\n")
+		w.W("r.SetBody(data)\n")
+		w.W("return nil\n")
+		w.W("}\n\n")
+
+		w.W("func healthLiveHandler(c *%s.Context) error {\n", routerPkg)
+		w.W("return writeHealthResponse(c.RequestCtx, %s.StatusOK, healthStatus{Status: \"ok\"})\n", httpPkg)
+		w.W("}\n\n")
+
+		w.W("func healthReadyHandler(checks []healthCheck) %s.Handler {\n", routerPkg)
+		w.W("return func(c *%s.Context) error {\n", routerPkg)
+		w.W("status, ready := runHealthChecks(%s.Background(), checks)\n", contextPkg)
+		w.W("if !ready {\nreturn writeHealthResponse(c.RequestCtx, %s.StatusServiceUnavailable, status)\n}\n", httpPkg)
+		w.W("return writeHealthResponse(c.RequestCtx, %s.StatusOK, status)\n", httpPkg)
+		w.W("}\n}\n\n")
+
+		w.W("func serviceInfoHandler(info serviceInfo) %s.Handler {\n", routerPkg)
+		w.W("return func(c *%s.Context) error {\n", routerPkg)
+		w.W("return writeHealthResponse(c.RequestCtx, %s.StatusOK, info)\n", httpPkg)
+		w.W("}\n}\n\n")
+		return
+	}
+
+	httpPkg := importer.Import("http", "net/http")
+
+	w.W("func writeHealthResponse(w %s.ResponseWriter, code int, v interface{}) {\n", httpPkg)
+	w.W("data, err := %s.Marshal(v)\n", jsonPkg)
+	w.W("if err != nil {\n%s.Error(w, err.Error(), %s.StatusInternalServerError)\nreturn\n}\n", httpPkg, httpPkg)
+	w.W("w.Header().Set(\"Content-Type\", \"application/json; charset=utf-8\")\n")
+	w.W("w.WriteHeader(code)\n")
+	w.W("_, _ = w.Write(data)\n")
+	w.W("}\n\n")
+
+	w.W("func healthLiveHandler(w %s.ResponseWriter, _ *%s.Request) {\n", httpPkg, httpPkg)
+	w.W("writeHealthResponse(w, %s.StatusOK, healthStatus{Status: \"ok\"})\n", httpPkg)
+	w.W("}\n\n")
+
+	w.W("func healthReadyHandler(checks []healthCheck) %s.HandlerFunc {\n", httpPkg)
+	w.W("return func(w %s.ResponseWriter, r *%s.Request) {\n", httpPkg, httpPkg)
+	w.W("status, ready := runHealthChecks(r.Context(), checks)\n")
+	w.W("if !ready {\nwriteHealthResponse(w, %s.StatusServiceUnavailable, status)\nreturn\n}\n", httpPkg)
+	w.W("writeHealthResponse(w, %s.StatusOK, status)\n", httpPkg)
+	w.W("}\n}\n\n")
+
+	w.W("func serviceInfoHandler(info serviceInfo) %s.HandlerFunc {\n", httpPkg)
+	w.W("return func(w %s.ResponseWriter, _ *%s.Request) {\n", httpPkg, httpPkg)
+	w.W("writeHealthResponse(w, %s.StatusOK, info)\n", httpPkg)
+	w.W("}\n}\n\n")
+}
+
+// writeHealthRoutes registers the liveness, readiness and service info endpoints,
+// they are registered after the service routes so the service methods take precedence.
+func writeHealthRoutes(w *writer.GoWriter, useFast bool) {
+	if useFast {
+		w.W("r.Get(%s, healthLiveHandler)\n", strconv.Quote(healthLivePath))
+		w.W("r.Get(%s, healthReadyHandler(opts.healthChecks))\n", strconv.Quote(healthReadyPath))
+		w.W("r.Get(%s, serviceInfoHandler(newServiceInfo(opts.version)))\n", strconv.Quote(serviceInfoPath))
+		return
+	}
+	w.W("r.Methods(\"GET\").Path(%s).HandlerFunc(healthLiveHandler)\n", strconv.Quote(healthLivePath))
+	w.W("r.Methods(\"GET\").Path(%s).Handler(healthReadyHandler(opts.healthChecks))\n", strconv.Quote(healthReadyPath))
+	w.W("r.Methods(\"GET\").Path(%s).Handler(serviceInfoHandler(newServiceInfo(opts.version)))\n", strconv.Quote(serviceInfoPath))
+}
@@ -1,8 +1,12 @@
 package generator
 
 import (
+	"bytes"
 	"container/list"
+	"encoding/json"
 	"fmt"
+	"path"
+	"regexp"
 	"strconv"
 	stdstrings "strings"
 	"time"
@@ -140,14 +144,42 @@ func UcNameWithAppPrefix(iface *config.Interface, useServicePrefix ...bool) stri
 	}
 	if isUseServicePrefix && iface.Gateway != nil {
 		if iface.ClientName.Take() != "" {
-			return strcase.ToCamel(iface.Named.Pkg.Module.ID) + strcase.ToCamel(iface.ClientName.Take())
+			return strcase.ToCamel(iface.Named.Pkg.Module.ID) + strcase.ToCamel(iface.ClientName.Take()) + versionSuffix(iface)
 		}
-		return strcase.ToCamel(iface.Named.Pkg.Module.ID) + iface.Named.Name.Upper()
+		return strcase.ToCamel(iface.Named.Pkg.Module.ID) + iface.Named.Name.Upper() + versionSuffix(iface)
 	}
 	if iface.ClientName.Take() != "" {
-		return strcase.ToCamel(iface.ClientName.Take())
+		return strcase.ToCamel(iface.ClientName.Take()) + versionSuffix(iface)
 	}
-	return iface.Named.Name.Upper()
+	return iface.Named.Name.Upper() + versionSuffix(iface)
+}
+
+// versionSuffix returns the interface version for the generated names,
+// the versions of one interface are generated side by side.
+func versionSuffix(iface *config.Interface) string {
+	return strcase.ToCamel(iface.Version.Take())
+}
+
+// restPrefix returns the REST path prefix of the interface, the version goes before the namespace.
+func restPrefix(iface *config.Interface, namespace string) string {
+	return path.Join(iface.Version.Take(), namespace)
+}
+
+// rpcPrefix returns the JSON-RPC method namespace of the interface, the version goes before the namespace.
+func rpcPrefix(iface *config.Interface) string {
+	if v := iface.Version.Take(); v != "" {
+		if iface.Namespace != "" {
+			return v + "." + iface.Namespace
+		}
+		return v
+	}
+	return iface.Namespace
+}
+
+// isDeprecated reports whether the method is deprecated by the doc comment or in the interface version.
+func isDeprecated(iface *config.Interface, m *option.FuncType) bool {
+	_, ok := iface.DeprecatedMethods[m.Name.Value]
+	return m.Deprecated || ok
 }
 
 func UcAppName(iface *config.Interface) string {
@@ -160,16 +192,16 @@ func LcAppName(iface *config.Interface) string {
 
 func UcNameJS(iface *config.Interface) string {
 	if iface.ClientName.Take() != "" {
-		return strcase.ToCamel(iface.ClientName.Take())
+		return strcase.ToCamel(iface.ClientName.Take()) + versionSuffix(iface)
 	}
-	return iface.Named.Name.Upper()
+	return iface.Named.Name.Upper() + versionSuffix(iface)
 }
 
 func LcNameJS(iface *config.Interface) string {
 	if iface.ClientName.Take() != "" {
-		return strcase.ToLowerCamel(iface.ClientName.Take())
+		return strcase.ToLowerCamel(iface.ClientName.Take()) + versionSuffix(iface)
 	}
-	return iface.Named.Name.Lower()
+	return iface.Named.Name.Lower() + versionSuffix(iface)
 }
 
 func ServicePropName(iface *config.Interface) string {
@@ -177,7 +209,7 @@ func ServicePropName(iface *config.Interface) string {
 	if iface.ClientName.IsValid() {
 		name = strcase.ToCamel(iface.ClientName.Take())
 	}
-	return "svc" + name
+	return "svc" + name + versionSuffix(iface)
 }
 
 func NameInterface(iface *config.Interface) string {
@@ -493,6 +525,20 @@ func jsTypeDefRecursive(i interface{}, nested int, visited map[string]struct{})
 	return ""
 }
 
+// jsEnum returns a frozen object with the constants of the type.
+func jsEnum(t *option.NamedType) string {
+	result := "/**\n"
+	result += "* @readonly\n"
+	result += "* @enum {" + jsDocType(t.Type) + "}\n"
+	result += "**/\n"
+	result += "export const " + t.Name.Value + " = Object.freeze({\n"
+	for _, e := range t.Enums {
+		result += e.Name.Value + ": " + e.Literal + ",\n"
+	}
+	result += "});\n\n"
+	return result
+}
+
 func jsDocType(i interface{}) string {
 	return jsDocTypeRecursive(i, 0)
 }
@@ -570,11 +616,948 @@ func jsDocTypeRecursive(i interface{}, nested int) string {
 	return ""
 }
 
-func docMethodName(iface *config.Interface, method *option.FuncType) string {
-	if iface.ClientName.Take() != "" {
-		return "JSONRPCClient" + strcase.ToCamel(iface.ClientName.Take()) + "." + method.Name.Lower()
+func tsExternalType(t *option.NamedType) (string, bool) {
+	if t.Pkg == nil {
+		return "", false
+	}
+	switch t.Pkg.Path {
+	case "github.com/google/uuid", "github.com/pborman/uuid":
+		switch t.Name.Value {
+		case "UUID":
+			return "string", true
+		}
+	case "encoding/json":
+		switch t.Name.Value {
+		case "RawMessage":
+			return "unknown", true
+		}
+	case "time":
+		switch t.Name.Value {
+		case "Time":
+			return "string", true
+		case "Duration":
+			return "number", true
+		}
+	case "gopkg.in/guregu/null.v4":
+		switch t.Name.Value {
+		case "String", "Time":
+			return "string | null", true
+		case "Int", "Float":
+			return "number | null", true
+		case "Bool":
+			return "boolean | null", true
+		}
+	}
+	return "", false
+}
+
+func tsType(i interface{}) string {
+	s := tsTypeRecursive(i)
+	if plugin.IsPointer(i) {
+		s += " | null"
+	}
+	return s
+}
+
+func tsTypeRecursive(i interface{}) string {
+	switch t := i.(type) {
+	case *option.NamedType:
+		if isFileUploadType(t) || plugin.IsFileDownloadType(t) {
+			return "Blob"
+		}
+		if s, ok := tsExternalType(t); ok {
+			return s
+		}
+		if _, ok := t.Type.(*option.IfaceType); ok {
+			return "unknown"
+		}
+		return t.Name.Value
+	case *option.StructType:
+		fields, _ := tsStructFields(t)
+		return "{\n" + fields + "}"
+	case *option.IfaceType:
+		return "unknown"
+	case *option.MapType:
+		return "Record<string, " + tsType(t.Value) + ">"
+	case *option.SliceType:
+		if b, ok := t.Value.(*option.BasicType); ok && b.IsByte() {
+			return "string"
+		}
+		return "Array<" + tsType(t.Value) + ">"
+	case *option.ArrayType:
+		return "Array<" + tsType(t.Value) + ">"
+	case *option.BasicType:
+		if t.IsString() {
+			return "string"
+		}
+		if t.IsNumeric() {
+			return "number"
+		}
+		if t.IsBool() {
+			return "boolean"
+		}
+	}
+	return "unknown"
+}
+
+// tsStructFields returns the property list of the struct and the names of the embedded
+// structs whose fields are promoted into it.
+func tsStructFields(t *option.StructType) (out string, extends []string) {
+	for _, f := range t.Fields {
+		if !f.Var.Exported {
+			continue
+		}
+		name := f.Var.Name.Value
+		var optional bool
+		if jsonTag, err := f.Tags.Get("json"); err == nil {
+			if jsonTag.Name == "-" {
+				continue
+			}
+			if f.Var.Embedded && jsonTag.Name == "" {
+				name = ""
+			} else if jsonTag.Name != "" {
+				name = jsonTag.Name
+			}
+			optional = jsonTag.HasOption("omitempty")
+		} else if f.Var.Embedded {
+			name = ""
+		}
+		if name == "" {
+			switch em := f.Var.Type.(type) {
+			case *option.StructType:
+				fields, emExtends := tsStructFields(em)
+				out += fields
+				extends = append(extends, emExtends...)
+				continue
+			case *option.NamedType:
+				if _, ok := em.Type.(*option.StructType); ok {
+					extends = append(extends, em.Name.Value)
+					continue
+				}
+			}
+			name = f.Var.Name.Value
+		}
+		out += tsDoc(f.Var.Comment, f.Var.Deprecated)
+		out += strconv.Quote(name)
+		if optional {
+			out += "?"
+		}
+		out += ": " + tsType(f.Var.Type) + ";\n"
+	}
+	return
+}
+
+func tsDoc(comment string, deprecated bool) string {
+	comment = stdstrings.TrimSpace(comment)
+	if comment == "" && !deprecated {
+		return ""
+	}
+	out := "/**\n"
+	if comment != "" {
+		for _, line := range stdstrings.Split(comment, "\n") {
+			out += " * " + stdstrings.ReplaceAll(line, "*/", "*\\/") + "\n"
+		}
+	}
+	if deprecated {
+		out += " * @deprecated\n"
+	}
+	return out + " */\n"
+}
+
+func tsTypeDef(t *option.NamedType) string {
+	if len(t.Enums) > 0 {
+		return tsEnum(t)
+	}
+	if st, ok := t.Type.(*option.StructType); ok {
+		fields, extends := tsStructFields(st)
+		result := "export interface " + t.Name.Value
+		if len(extends) > 0 {
+			result += " extends " + stdstrings.Join(extends, ", ")
+		}
+		return result + " {\n" + fields + "}\n\n"
+	}
+	return "export type " + t.Name.Value + " = " + tsType(t.Type) + ";\n\n"
+}
+
+func tsEnum(t *option.NamedType) string {
+	result := "export const " + t.Name.Value + " = {\n"
+	for _, e := range t.Enums {
+		result += e.Name.Value + ": " + e.Literal + ",\n"
+	}
+	result += "} as const;\n\n"
+	result += "export type " + t.Name.Value + " = typeof " + t.Name.Value + "[keyof typeof " + t.Name.Value + "];\n\n"
+	return result
+}
+
+var pyKeywords = map[string]struct{}{
+	"False": {}, "None": {}, "True": {}, "and": {}, "as": {}, "assert": {}, "async": {}, "await": {},
+	"break": {}, "class": {}, "continue": {}, "def": {}, "del": {}, "elif": {}, "else": {}, "except": {},
+	"finally": {}, "for": {}, "from": {}, "global": {}, "if": {}, "import": {}, "in": {}, "is": {},
+	"lambda": {}, "nonlocal": {}, "not": {}, "or": {}, "pass": {}, "raise": {}, "return": {}, "try": {},
+	"while": {}, "with": {}, "yield": {},
+}
+
+var pyIdentRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func pyName(name string) string {
+	name = strcase.ToSnake(name)
+	if _, ok := pyKeywords[name]; ok {
+		return name + "_"
+	}
+	return name
+}
+
+func pyIsIdent(name string) bool {
+	if _, ok := pyKeywords[name]; ok {
+		return false
+	}
+	return pyIdentRegexp.MatchString(name)
+}
+
+func pyExternalType(t *option.NamedType) (string, bool) {
+	if t.Pkg == nil {
+		return "", false
+	}
+	switch t.Pkg.Path {
+	case "github.com/google/uuid", "github.com/pborman/uuid":
+		switch t.Name.Value {
+		case "UUID":
+			return "str", true
+		}
+	case "encoding/json":
+		switch t.Name.Value {
+		case "RawMessage":
+			return "typing.Any", true
+		}
+	case "time":
+		switch t.Name.Value {
+		case "Time":
+			return "str", true
+		case "Duration":
+			return "int", true
+		}
+	case "gopkg.in/guregu/null.v4":
+		switch t.Name.Value {
+		case "String", "Time":
+			return "typing.Optional[str]", true
+		case "Int":
+			return "typing.Optional[int]", true
+		case "Float":
+			return "typing.Optional[float]", true
+		case "Bool":
+			return "typing.Optional[bool]", true
+		}
+	}
+	return "", false
+}
+
+func pyType(i interface{}) string {
+	s := pyTypeRecursive(i)
+	if plugin.IsPointer(i) {
+		s = "typing.Optional[" + s + "]"
+	}
+	return s
+}
+
+func pyTypeRecursive(i interface{}) string {
+	switch t := i.(type) {
+	case *option.NamedType:
+		if isFileUploadType(t) {
+			return "typing.BinaryIO"
+		}
+		if plugin.IsFileDownloadType(t) {
+			return "bytes"
+		}
+		if s, ok := pyExternalType(t); ok {
+			return s
+		}
+		if _, ok := t.Type.(*option.IfaceType); ok {
+			return "typing.Any"
+		}
+		return t.Name.Value
+	case *option.StructType, *option.IfaceType:
+		return "typing.Any"
+	case *option.MapType:
+		return "typing.Dict[str, " + pyType(t.Value) + "]"
+	case *option.SliceType:
+		if b, ok := t.Value.(*option.BasicType); ok && b.IsByte() {
+			return "str"
+		}
+		return "typing.List[" + pyType(t.Value) + "]"
+	case *option.ArrayType:
+		return "typing.List[" + pyType(t.Value) + "]"
+	case *option.BasicType:
+		if t.IsString() {
+			return "str"
+		}
+		if t.IsAnyInt() || t.IsAnyUint() {
+			return "int"
+		}
+		if t.IsNumeric() {
+			return "float"
+		}
+		if t.IsBool() {
+			return "bool"
+		}
+	}
+	return "typing.Any"
+}
+
+// pyStructFields returns the json names and types of the struct fields, the names of the embedded
+// structs whose fields are promoted and whether the struct has optional fields.
+func pyStructFields(t *option.StructType) (names, types, bases []string, optional bool) {
+	for _, f := range t.Fields {
+		if !f.Var.Exported {
+			continue
+		}
+		name := f.Var.Name.Value
+		if jsonTag, err := f.Tags.Get("json"); err == nil {
+			if jsonTag.Name == "-" {
+				continue
+			}
+			if f.Var.Embedded && jsonTag.Name == "" {
+				name = ""
+			} else if jsonTag.Name != "" {
+				name = jsonTag.Name
+			}
+			if jsonTag.HasOption("omitempty") {
+				optional = true
+			}
+		} else if f.Var.Embedded {
+			name = ""
+		}
+		if name == "" {
+			switch em := f.Var.Type.(type) {
+			case *option.StructType:
+				emNames, emTypes, emBases, emOptional := pyStructFields(em)
+				names = append(names, emNames...)
+				types = append(types, emTypes...)
+				bases = append(bases, emBases...)
+				optional = optional || emOptional
+				continue
+			case *option.NamedType:
+				if _, ok := em.Type.(*option.StructType); ok {
+					bases = append(bases, em.Name.Value)
+					continue
+				}
+			}
+			name = f.Var.Name.Value
+		}
+		names = append(names, name)
+		types = append(types, pyType(f.Var.Type))
+	}
+	return
+}
+
+func pyTypedDict(name string, names, types, bases []string, optional bool) string {
+	valid := true
+	for _, n := range names {
+		if !pyIsIdent(n) {
+			valid = false
+			break
+		}
+	}
+	if !valid {
+		fields := make([]string, 0, len(names))
+		for i, n := range names {
+			fields = append(fields, strconv.Quote(n)+": "+strconv.Quote(types[i]))
+		}
+		result := name + " = typing.TypedDict(" + strconv.Quote(name) + ", {" + stdstrings.Join(fields, ", ") + "}"
+		if optional {
+			result += ", total=False"
+		}
+		return result + ")\n\n\n"
+	}
+	if len(bases) == 0 {
+		bases = []string{"typing.TypedDict"}
+	}
+	result := "class " + name + "(" + stdstrings.Join(bases, ", ")
+	if optional {
+		result += ", total=False"
+	}
+	result += "):\n"
+	if len(names) == 0 {
+		result += "    pass\n"
+	}
+	for i, n := range names {
+		result += "    " + n + ": " + types[i] + "\n"
+	}
+	return result + "\n\n"
+}
+
+func pyTypeDef(t *option.NamedType) string {
+	if len(t.Enums) > 0 {
+		return pyEnum(t)
+	}
+	if st, ok := t.Type.(*option.StructType); ok {
+		names, types, bases, optional := pyStructFields(st)
+		return pyTypedDict(t.Name.Value, names, types, bases, optional)
+	}
+	return t.Name.Value + " = " + pyType(t.Type) + "\n\n\n"
+}
+
+func pyEnum(t *option.NamedType) string {
+	result := "class " + t.Name.Value + "(" + pyType(t.Type) + ", enum.Enum):\n"
+	for _, e := range t.Enums {
+		value := e.Literal
+		if s, ok := e.Value.(string); ok {
+			value = strconv.Quote(s)
+		}
+		result += "    " + strcase.ToScreamingSnake(e.Name.Value) + " = " + value + "\n"
+	}
+	return result + "\n\n"
+}
+
+type structField struct {
+	Name     string
+	Var      *option.VarType
+	Optional bool
+}
+
+// flatStructFields returns the fields of the struct as they are seen in JSON, the fields
+// of embedded structs are promoted into the result.
+func flatStructFields(t *option.StructType) (fields []structField) {
+	for _, f := range t.Fields {
+		if !f.Var.Exported {
+			continue
+		}
+		name := f.Var.Name.Value
+		embedded := f.Var.Embedded
+		var optional bool
+		if jsonTag, err := f.Tags.Get("json"); err == nil {
+			if jsonTag.Name == "-" {
+				continue
+			}
+			if jsonTag.Name != "" {
+				name = jsonTag.Name
+				embedded = false
+			}
+			optional = jsonTag.HasOption("omitempty")
+		}
+		if embedded {
+			switch em := f.Var.Type.(type) {
+			case *option.StructType:
+				fields = append(fields, flatStructFields(em)...)
+				continue
+			case *option.NamedType:
+				if st, ok := em.Type.(*option.StructType); ok {
+					fields = append(fields, flatStructFields(st)...)
+					continue
+				}
+			}
+		}
+		fields = append(fields, structField{Name: name, Var: f.Var, Optional: optional})
+	}
+	return
+}
+
+var ktKeywords = map[string]struct{}{
+	"as": {}, "break": {}, "class": {}, "continue": {}, "do": {}, "else": {}, "false": {}, "for": {}, "fun": {},
+	"if": {}, "in": {}, "interface": {}, "is": {}, "null": {}, "object": {}, "package": {}, "return": {}, "super": {},
+	"this": {}, "throw": {}, "true": {}, "try": {}, "typealias": {}, "typeof": {}, "val": {}, "var": {}, "when": {}, "while": {},
+}
+
+func ktName(name string) string {
+	name = strcase.ToLowerCamel(name)
+	if _, ok := ktKeywords[name]; ok {
+		return "`" + name + "`"
+	}
+	return name
+}
+
+func ktQuote(s string) string {
+	return stdstrings.ReplaceAll(strconv.Quote(s), "$", "\\$")
+}
+
+func ktExternalType(t *option.NamedType) (string, bool) {
+	if t.Pkg == nil {
+		return "", false
+	}
+	switch t.Pkg.Path {
+	case "github.com/google/uuid", "github.com/pborman/uuid":
+		switch t.Name.Value {
+		case "UUID":
+			return "String", true
+		}
+	case "encoding/json":
+		switch t.Name.Value {
+		case "RawMessage":
+			return "Any?", true
+		}
+	case "time":
+		switch t.Name.Value {
+		case "Time":
+			return "String", true
+		case "Duration":
+			return "Long", true
+		}
+	case "gopkg.in/guregu/null.v4":
+		switch t.Name.Value {
+		case "String", "Time":
+			return "String?", true
+		case "Int":
+			return "Long?", true
+		case "Float":
+			return "Double?", true
+		case "Bool":
+			return "Boolean?", true
+		}
+	}
+	return "", false
+}
+
+// ktDecodeKind returns the expression that converts the org.json value to the Kotlin type,
+// nullable types are decoded from JSONObject.NULL as null.
+func ktDecodeKind(expr, kind string, depth int) string {
+	if stdstrings.HasSuffix(kind, "?") {
+		if kind == "Any?" {
+			return expr
+		}
+		v := fmt.Sprintf("v%d", depth)
+		return fmt.Sprintf("%s.let { %s -> if (isJSONNull(%s)) null else %s }", expr, v, v, ktDecodeKind(v, stdstrings.TrimSuffix(kind, "?"), depth+1))
+	}
+	switch kind {
+	case "String":
+		return "(" + expr + " as String)"
+	case "Long":
+		return "(" + expr + " as Number).toLong()"
+	case "Double":
+		return "(" + expr + " as Number).toDouble()"
+	case "Boolean":
+		return "(" + expr + " as Boolean)"
+	}
+	return expr
+}
+
+func ktType(i interface{}) string {
+	s := ktTypeRecursive(i)
+	if plugin.IsPointer(i) && !stdstrings.HasSuffix(s, "?") {
+		s += "?"
+	}
+	return s
+}
+
+func ktTypeRecursive(i interface{}) string {
+	switch t := i.(type) {
+	case *option.NamedType:
+		if isFileUploadType(t) || plugin.IsFileDownloadType(t) {
+			return "ByteArray"
+		}
+		if s, ok := ktExternalType(t); ok {
+			return s
+		}
+		if _, ok := t.Type.(*option.IfaceType); ok {
+			return "Any?"
+		}
+		return t.Name.Value
+	case *option.StructType, *option.IfaceType:
+		return "Any?"
+	case *option.MapType:
+		return "Map<String, " + ktType(t.Value) + ">"
+	case *option.SliceType:
+		if b, ok := t.Value.(*option.BasicType); ok && b.IsByte() {
+			return "String"
+		}
+		return "List<" + ktType(t.Value) + ">"
+	case *option.ArrayType:
+		return "List<" + ktType(t.Value) + ">"
+	case *option.BasicType:
+		if t.IsString() {
+			return "String"
+		}
+		if t.IsAnyInt() || t.IsAnyUint() {
+			return "Long"
+		}
+		if t.IsNumeric() {
+			return "Double"
+		}
+		if t.IsBool() {
+			return "Boolean"
+		}
+	}
+	return "Any?"
+}
+
+// ktEncode returns the expression that converts the Kotlin value to the value accepted by org.json.
+func ktEncode(expr string, i interface{}, depth int) string {
+	if plugin.IsPointer(i) {
+		v := fmt.Sprintf("v%d", depth)
+		value := ktEncodeValue(v, i, depth+1)
+		if value == v {
+			return expr + " ?: JSONObject.NULL"
+		}
+		return fmt.Sprintf("%s?.let { %s -> %s } ?: JSONObject.NULL", expr, v, value)
 	}
-	return "JSONRPCClient" + iface.Named.Name.Upper() + "." + method.Name.Lower()
+	return ktEncodeValue(expr, i, depth)
+}
+
+func ktEncodeValue(expr string, i interface{}, depth int) string {
+	switch t := i.(type) {
+	case *option.NamedType:
+		if kind, ok := ktExternalType(t); ok {
+			if kind == "Any?" {
+				return "JSONObject.wrap(" + expr + ") ?: JSONObject.NULL"
+			}
+			if stdstrings.HasSuffix(kind, "?") {
+				return expr + " ?: JSONObject.NULL"
+			}
+			return expr
+		}
+		if _, ok := t.Type.(*option.IfaceType); ok {
+			return "JSONObject.wrap(" + expr + ") ?: JSONObject.NULL"
+		}
+		if len(t.Enums) > 0 {
+			return expr + ".value"
+		}
+		if _, ok := t.Type.(*option.StructType); ok {
+			return expr + ".toJSON()"
+		}
+		return ktEncodeValue(expr, t.Type, depth)
+	case *option.StructType, *option.IfaceType:
+		return "JSONObject.wrap(" + expr + ") ?: JSONObject.NULL"
+	case *option.MapType:
+		k, v := fmt.Sprintf("k%d", depth), fmt.Sprintf("v%d", depth)
+		return fmt.Sprintf("JSONObject().apply { %s.forEach { (%s, %s) -> put(%s, %s) } }", expr, k, v, k, ktEncode(v, t.Value, depth+1))
+	case *option.SliceType:
+		if b, ok := t.Value.(*option.BasicType); ok && b.IsByte() {
+			return expr
+		}
+		v := fmt.Sprintf("v%d", depth)
+		return fmt.Sprintf("JSONArray().apply { %s.forEach { %s -> put(%s) } }", expr, v, ktEncode(v, t.Value, depth+1))
+	case *option.ArrayType:
+		v := fmt.Sprintf("v%d", depth)
+		return fmt.Sprintf("JSONArray().apply { %s.forEach { %s -> put(%s) } }", expr, v, ktEncode(v, t.Value, depth+1))
+	}
+	return expr
+}
+
+// ktDecode returns the expression that converts the value read by org.json to the Kotlin type.
+func ktDecode(expr string, i interface{}, depth int) string {
+	if plugin.IsPointer(i) {
+		v := fmt.Sprintf("v%d", depth)
+		return fmt.Sprintf("%s.let { %s -> if (isJSONNull(%s)) null else %s }", expr, v, v, ktDecodeValue(v, i, depth+1))
+	}
+	return ktDecodeValue(expr, i, depth)
+}
+
+func ktDecodeValue(expr string, i interface{}, depth int) string {
+	switch t := i.(type) {
+	case *option.NamedType:
+		if kind, ok := ktExternalType(t); ok {
+			return ktDecodeKind(expr, kind, depth)
+		}
+		if _, ok := t.Type.(*option.IfaceType); ok {
+			return expr
+		}
+		if len(t.Enums) > 0 {
+			return t.Name.Value + ".fromValue(" + ktDecodeValue(expr, t.Type, depth) + ")"
+		}
+		if _, ok := t.Type.(*option.StructType); ok {
+			return t.Name.Value + ".fromJSON(" + expr + " as JSONObject)"
+		}
+		return ktDecodeValue(expr, t.Type, depth)
+	case *option.StructType, *option.IfaceType:
+		return expr
+	case *option.MapType:
+		v := fmt.Sprintf("v%d", depth)
+		return fmt.Sprintf("jsonMap(%s) { %s -> %s }", expr, v, ktDecode(v, t.Value, depth+1))
+	case *option.SliceType:
+		if b, ok := t.Value.(*option.BasicType); ok && b.IsByte() {
+			return expr + " as String"
+		}
+		v := fmt.Sprintf("v%d", depth)
+		return fmt.Sprintf("jsonList(%s) { %s -> %s }", expr, v, ktDecode(v, t.Value, depth+1))
+	case *option.ArrayType:
+		v := fmt.Sprintf("v%d", depth)
+		return fmt.Sprintf("jsonList(%s) { %s -> %s }", expr, v, ktDecode(v, t.Value, depth+1))
+	case *option.BasicType:
+		if t.IsString() {
+			return "(" + expr + " as String)"
+		}
+		if t.IsAnyInt() || t.IsAnyUint() {
+			return "(" + expr + " as Number).toLong()"
+		}
+		if t.IsNumeric() {
+			return "(" + expr + " as Number).toDouble()"
+		}
+		if t.IsBool() {
+			return "(" + expr + " as Boolean)"
+		}
+	}
+	return expr
+}
+
+// ktDataClass returns the data class with the JSON conversion functions for the fields.
+func ktDataClass(name string, fields []structField) string {
+	if len(fields) == 0 {
+		return "class " + name + " {\n" +
+			"    fun toJSON(): JSONObject = JSONObject()\n\n" +
+			"    companion object {\n" +
+			"        fun fromJSON(o: JSONObject): " + name + " = " + name + "()\n" +
+			"    }\n" +
+			"}\n\n"
+	}
+	result := "data class " + name + "(\n"
+	for _, f := range fields {
+		t := ktType(f.Var.Type)
+		if f.Optional {
+			if !stdstrings.HasSuffix(t, "?") {
+				t += "?"
+			}
+			t += " = null"
+		}
+		result += "    val " + ktName(f.Name) + ": " + t + ",\n"
+	}
+	result += ") {\n"
+	result += "    fun toJSON(): JSONObject = JSONObject().apply {\n"
+	for _, f := range fields {
+		if f.Optional {
+			result += "        if (" + ktName(f.Name) + " != null) put(" + ktQuote(f.Name) + ", " + ktEncodeValue(ktName(f.Name), f.Var.Type, 0) + ")\n"
+			continue
+		}
+		result += "        put(" + ktQuote(f.Name) + ", " + ktEncode(ktName(f.Name), f.Var.Type, 0) + ")\n"
+	}
+	result += "    }\n\n"
+	result += "    companion object {\n"
+	result += "        fun fromJSON(o: JSONObject): " + name + " = " + name + "(\n"
+	for _, f := range fields {
+		expr := "o.opt(" + ktQuote(f.Name) + ")"
+		if f.Optional && !plugin.IsPointer(f.Var.Type) {
+			expr = "o.opt(" + ktQuote(f.Name) + ").let { v -> if (isJSONNull(v)) null else " + ktDecodeValue("v", f.Var.Type, 0) + " }"
+		} else {
+			expr = ktDecode(expr, f.Var.Type, 0)
+		}
+		result += "            " + ktName(f.Name) + " = " + expr + ",\n"
+	}
+	result += "        )\n"
+	result += "    }\n"
+	return result + "}\n\n"
+}
+
+func ktTypeDef(t *option.NamedType) string {
+	if len(t.Enums) > 0 {
+		return ktEnum(t)
+	}
+	if st, ok := t.Type.(*option.StructType); ok {
+		return ktDataClass(t.Name.Value, flatStructFields(st))
+	}
+	return "typealias " + t.Name.Value + " = " + ktType(t.Type) + "\n\n"
+}
+
+func ktEnum(t *option.NamedType) string {
+	valueType := ktType(t.Type)
+	result := "enum class " + t.Name.Value + "(override val value: " + valueType + ") : JSONEnum {\n"
+	for i, e := range t.Enums {
+		value := e.Literal
+		if s, ok := e.Value.(string); ok {
+			value = ktQuote(s)
+		}
+		sep := ","
+		if i == len(t.Enums)-1 {
+			sep = ";"
+		}
+		result += "    " + strcase.ToScreamingSnake(e.Name.Value) + "(" + value + ")" + sep + "\n"
+	}
+	result += "\n    companion object {\n"
+	result += "        fun fromValue(value: " + valueType + "): " + t.Name.Value + " = values().first { it.value == value }\n"
+	result += "    }\n"
+	return result + "}\n\n"
+}
+
+var swiftKeywords = map[string]struct{}{
+	"associatedtype": {}, "class": {}, "deinit": {}, "enum": {}, "extension": {}, "fileprivate": {}, "func": {},
+	"import": {}, "init": {}, "inout": {}, "internal": {}, "let": {}, "open": {}, "operator": {}, "private": {},
+	"protocol": {}, "public": {}, "rethrows": {}, "static": {}, "struct": {}, "subscript": {}, "typealias": {},
+	"var": {}, "break": {}, "case": {}, "continue": {}, "default": {}, "defer": {}, "do": {}, "else": {},
+	"fallthrough": {}, "for": {}, "guard": {}, "if": {}, "in": {}, "repeat": {}, "return": {}, "switch": {},
+	"where": {}, "while": {}, "as": {}, "Any": {}, "catch": {}, "false": {}, "is": {}, "nil": {}, "super": {},
+	"self": {}, "Self": {}, "throw": {}, "throws": {}, "true": {}, "try": {},
+}
+
+func swiftName(name string) string {
+	name = strcase.ToLowerCamel(name)
+	if _, ok := swiftKeywords[name]; ok {
+		return "`" + name + "`"
+	}
+	return name
+}
+
+func swiftExternalType(t *option.NamedType) (string, bool) {
+	if t.Pkg == nil {
+		return "", false
+	}
+	switch t.Pkg.Path {
+	case "github.com/google/uuid", "github.com/pborman/uuid":
+		switch t.Name.Value {
+		case "UUID":
+			return "String", true
+		}
+	case "encoding/json":
+		switch t.Name.Value {
+		case "RawMessage":
+			return "JSONValue", true
+		}
+	case "time":
+		switch t.Name.Value {
+		case "Time":
+			return "String", true
+		case "Duration":
+			return "Int64", true
+		}
+	case "gopkg.in/guregu/null.v4":
+		switch t.Name.Value {
+		case "String", "Time":
+			return "String?", true
+		case "Int":
+			return "Int64?", true
+		case "Float":
+			return "Double?", true
+		case "Bool":
+			return "Bool?", true
+		}
+	}
+	return "", false
+}
+
+func swiftType(i interface{}) string {
+	s := swiftTypeRecursive(i)
+	if plugin.IsPointer(i) && !stdstrings.HasSuffix(s, "?") {
+		s += "?"
+	}
+	return s
+}
+
+func swiftTypeRecursive(i interface{}) string {
+	switch t := i.(type) {
+	case *option.NamedType:
+		if isFileUploadType(t) || plugin.IsFileDownloadType(t) {
+			return "Data"
+		}
+		if s, ok := swiftExternalType(t); ok {
+			return s
+		}
+		if _, ok := t.Type.(*option.IfaceType); ok {
+			return "JSONValue"
+		}
+		return t.Name.Value
+	case *option.StructType, *option.IfaceType:
+		return "JSONValue"
+	case *option.MapType:
+		return "[String: " + swiftType(t.Value) + "]"
+	case *option.SliceType:
+		if b, ok := t.Value.(*option.BasicType); ok && b.IsByte() {
+			return "Data"
+		}
+		return "[" + swiftType(t.Value) + "]"
+	case *option.ArrayType:
+		return "[" + swiftType(t.Value) + "]"
+	case *option.BasicType:
+		switch {
+		case t.IsString():
+			return "String"
+		case t.IsAnyInt():
+			return "Int"
+		case t.IsAnyUint():
+			return "UInt"
+		case t.IsFloat32():
+			return "Float"
+		case t.IsNumeric():
+			return "Double"
+		case t.IsBool():
+			return "Bool"
+		}
+	}
+	return "JSONValue"
+}
+
+// swiftNullable reports whether Go can encode the value of the type as null,
+// nil slices and maps are encoded as null.
+func swiftNullable(i interface{}) bool {
+	if plugin.IsPointer(i) {
+		return true
+	}
+	switch t := i.(type) {
+	case *option.NamedType:
+		if _, ok := t.Type.(*option.StructType); ok || len(t.Enums) > 0 {
+			return false
+		}
+		if s, ok := swiftExternalType(t); ok {
+			return stdstrings.HasSuffix(s, "?")
+		}
+		return swiftNullable(t.Type)
+	case *option.MapType:
+		return true
+	case *option.SliceType:
+		if b, ok := t.Value.(*option.BasicType); ok && b.IsByte() {
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+// swiftStruct returns the struct with the coding keys for the fields, protocols
+// are the conformances of the struct.
+func swiftStruct(name, protocols string, access string, fields []structField) string {
+	result := access + "struct " + name + ": " + protocols + " {\n"
+	if len(fields) == 0 {
+		if access != "" {
+			result += "    " + access + "init() {}\n"
+		}
+		return result + "}\n\n"
+	}
+	params := make([]string, 0, len(fields))
+	for _, f := range fields {
+		t := swiftType(f.Var.Type)
+		defaultValue := ""
+		if f.Optional || swiftNullable(f.Var.Type) {
+			if !stdstrings.HasSuffix(t, "?") {
+				t += "?"
+			}
+			defaultValue = " = nil"
+		}
+		result += "    " + access + "var " + swiftName(f.Name) + ": " + t + "\n"
+		params = append(params, swiftName(f.Name)+": "+t+defaultValue)
+	}
+	if access != "" {
+		result += "\n    " + access + "init(" + stdstrings.Join(params, ", ") + ") {\n"
+		for _, f := range fields {
+			result += "        self." + stdstrings.Trim(swiftName(f.Name), "`") + " = " + swiftName(f.Name) + "\n"
+		}
+		result += "    }\n"
+	}
+	result += "\n    enum CodingKeys: String, CodingKey {\n"
+	for _, f := range fields {
+		result += "        case " + swiftName(f.Name) + " = " + strconv.Quote(f.Name) + "\n"
+	}
+	result += "    }\n"
+	return result + "}\n\n"
+}
+
+func swiftTypeDef(t *option.NamedType) string {
+	if len(t.Enums) > 0 {
+		return swiftEnum(t)
+	}
+	if st, ok := t.Type.(*option.StructType); ok {
+		return swiftStruct(t.Name.Value, "Codable", "public ", flatStructFields(st))
+	}
+	return "public typealias " + t.Name.Value + " = " + swiftType(t.Type) + "\n\n"
+}
+
+func swiftEnum(t *option.NamedType) string {
+	result := "public enum " + t.Name.Value + ": " + swiftType(t.Type) + ", Codable {\n"
+	for _, e := range t.Enums {
+		value := e.Literal
+		if s, ok := e.Value.(string); ok {
+			value = strconv.Quote(s)
+		}
+		result += "    case " + swiftName(e.Name.Value) + " = " + value + "\n"
+	}
+	return result + "}\n\n"
+}
+
+func docMethodName(iface *config.Interface, method *option.FuncType) string {
+	return "JSONRPCClient" + UcNameJS(iface) + "." + method.Name.Lower()
 }
 
 func jsErrorName(iface *config.Interface, e config.Error) (errorName string) {
@@ -688,6 +1671,40 @@ func makeOpenapiSchemaRESTError(errCode string) *openapi.Schema {
 	}
 }
 
+func makeOpenapiSchemaRESTProblem(errCode string) *openapi.Schema {
+	return &openapi.Schema{
+		Type: "object",
+		Properties: openapi.Properties{
+			"type": &openapi.Schema{
+				Type:    "string",
+				Format:  "uri-reference",
+				Example: "about:blank",
+			},
+			"title": &openapi.Schema{
+				Type: "string",
+			},
+			"status": &openapi.Schema{
+				Type: "integer",
+			},
+			"detail": &openapi.Schema{
+				Type: "string",
+			},
+			"instance": &openapi.Schema{
+				Type:   "string",
+				Format: "uri-reference",
+			},
+			"code": &openapi.Schema{
+				Type:    "string",
+				Example: errCode,
+			},
+			"data": &openapi.Schema{
+				Type: "object",
+			},
+		},
+		Required: []string{"type", "title", "status"},
+	}
+}
+
 func makeOpenapiSchemaJRPCError(code int64) *openapi.Schema {
 	return &openapi.Schema{
 		Type: "object",
@@ -787,3 +1804,239 @@ func curlTypeValueRecursive(v interface{}, nested map[string]int) interface{} {
 	}
 	return nil
 }
+
+// sampleBaseURL is the base URL of the generated request samples when the servers are not set.
+const sampleBaseURL = "http://localhost:8080"
+
+// sampleAPIKeyVar returns the name of the variable that holds the API key in the request samples.
+func sampleAPIKeyVar(a config.APIKeyAuth) string {
+	return "apiKey" + strcase.ToCamel(a.Name)
+}
+
+// sampleRequestBody returns the JSON body of the REST request with the sample values,
+// the wrapRequest is the dot separated path of the wrapping objects.
+func sampleRequestBody(paramVars []*option.VarType, wrapRequest string) interface{} {
+	values := map[string]interface{}{}
+	for _, p := range paramVars {
+		values[p.Name.Value] = sampleValue(p.Type, "", map[string]int{})
+	}
+	var body interface{} = values
+	if wrapRequest != "" {
+		parts := stdstrings.Split(wrapRequest, ".")
+		for i := len(parts) - 1; i >= 0; i-- {
+			body = map[string]interface{}{parts[i]: body}
+		}
+	}
+	return body
+}
+
+// sampleIndentJSON returns the indented JSON, the HTML characters are not escaped to keep the sample readable.
+func sampleIndentJSON(v interface{}) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	_ = enc.Encode(v)
+	return stdstrings.TrimSuffix(buf.String(), "\n")
+}
+
+// sampleJSON returns the JSON of the sample value of the type.
+func sampleJSON(i interface{}, pathVar string) string {
+	data, _ := json.Marshal(sampleValue(i, pathVar, map[string]int{}))
+	return string(data)
+}
+
+// sampleValue returns a representative value of the type, strings contain the characters
+// that must be escaped in the path, query and form values. The pathVar is the regexp of
+// the path variable the value is used for.
+func sampleValue(i interface{}, pathVar string, nested map[string]int) interface{} {
+	switch t := i.(type) {
+	case *option.NamedType:
+		if t.Pkg != nil {
+			switch t.Pkg.Path + "." + t.Name.Value {
+			case "github.com/google/uuid.UUID", "github.com/pborman/uuid.UUID":
+				return "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+			case "time.Time", "gopkg.in/guregu/null.v4.Time":
+				return "2021-02-03T04:05:06Z"
+			case "time.Duration":
+				return 1500000000
+			case "encoding/json.RawMessage":
+				return map[string]interface{}{"key": "value"}
+			case "gopkg.in/guregu/null.v4.String":
+				return "a b?c&d"
+			case "gopkg.in/guregu/null.v4.Int":
+				return 42
+			case "gopkg.in/guregu/null.v4.Float":
+				return 1.5
+			case "gopkg.in/guregu/null.v4.Bool":
+				return true
+			}
+		}
+		if len(t.Enums) > 0 {
+			return t.Enums[0].Value
+		}
+		if _, ok := t.Type.(*option.IfaceType); ok {
+			return "value"
+		}
+		if st, ok := t.Type.(*option.StructType); ok {
+			result := map[string]interface{}{}
+			nested[t.ID()]++
+			defer func() { nested[t.ID()]-- }()
+			if nested[t.ID()] > 1 {
+				return nil
+			}
+			for _, f := range flatStructFields(st) {
+				if v := sampleValue(f.Var.Type, "", nested); v != nil {
+					result[f.Name] = v
+				}
+			}
+			return result
+		}
+		return sampleValue(t.Type, pathVar, nested)
+	case *option.StructType:
+		result := map[string]interface{}{}
+		for _, f := range flatStructFields(t) {
+			if v := sampleValue(f.Var.Type, "", nested); v != nil {
+				result[f.Name] = v
+			}
+		}
+		return result
+	case *option.IfaceType:
+		return "value"
+	case *option.SliceType:
+		if b, ok := t.Value.(*option.BasicType); ok && b.IsByte() {
+			return []byte{1, 2, 3}
+		}
+		return []interface{}{sampleValue(t.Value, "", nested)}
+	case *option.ArrayType:
+		result := make([]interface{}, t.Len)
+		for i := range result {
+			result[i] = sampleValue(t.Value, "", nested)
+		}
+		return result
+	case *option.MapType:
+		key := "key"
+		if b, ok := t.Key.(*option.BasicType); ok && b.IsNumeric() {
+			key = "7"
+		}
+		return map[string]interface{}{key: sampleValue(t.Value, "", nested)}
+	case *option.BasicType:
+		switch {
+		case t.IsString():
+			switch {
+			case pathVar == "":
+				return "a b?c&d"
+			case stdstrings.Contains(pathVar, "0-9") || stdstrings.Contains(pathVar, `\d`):
+				return "1"
+			default:
+				return "a"
+			}
+		case t.IsAnyInt(), t.IsAnyUint():
+			return 42
+		case t.IsNumeric():
+			return 1.5
+		case t.IsBool():
+			return true
+		}
+	}
+	return nil
+}
+
+// sampleString returns the sample value of the type as it is sent in the path, query and header.
+func sampleString(i interface{}, pathVar string) string {
+	if named, ok := i.(*option.NamedType); ok && named.Pkg != nil && named.Pkg.Path == "time" && named.Name.Value == "Duration" {
+		return "1.5s"
+	}
+	return sampleValueString(sampleValue(i, pathVar, map[string]int{}))
+}
+
+func sampleValueString(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case []byte:
+		return string(v)
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, e := range v {
+			values = append(values, sampleValueString(e))
+		}
+		return stdstrings.Join(values, ",")
+	}
+	data, _ := json.Marshal(v)
+	return string(data)
+}
+
+// testServerOptions returns the server options of the generated tests, auth validators accept
+// any credentials and the rate limit bucket is selected by the rateLimitKey expression.
+func testServerOptions(importer swipe.Importer, schemes authSchemes, limits rateLimits, rateLimitKey string) (options []string) {
+	contextPkg := importer.Import("context", "context")
+	if schemes.bearer {
+		options = append(options, fmt.Sprintf("BearerAuthOption(func(ctx %[1]s.Context, token string) (%[1]s.Context, error) { return ctx, nil })", contextPkg))
+	}
+	if schemes.apiKey() {
+		options = append(options, fmt.Sprintf("APIKeyAuthOption(func(ctx %[1]s.Context, name, key string) (%[1]s.Context, error) { return ctx, nil })", contextPkg))
+	}
+	if schemes.basic {
+		options = append(options, fmt.Sprintf("BasicAuthOption(func(ctx %[1]s.Context, username, password string) (%[1]s.Context, error) { return ctx, nil })", contextPkg))
+	}
+	if limits.enabled {
+		options = append(options, fmt.Sprintf("RateLimitKeyOption(func(%s.Context) string { return %s })", contextPkg, rateLimitKey))
+	}
+	return
+}
+
+type pathTemplatePart struct {
+	text string
+	// name is the path variable name, it is empty for the text parts.
+	name   string
+	regexp string
+}
+
+// splitPathTemplate splits the REST path into the text and the variables, the variable
+// regexp can contain braces, for example {id:[0-9]{3}}.
+func splitPathTemplate(urlPath string) (parts []pathTemplatePart) {
+	var buf stdstrings.Builder
+	for i := 0; i < len(urlPath); i++ {
+		if urlPath[i] != '{' {
+			buf.WriteByte(urlPath[i])
+			continue
+		}
+		depth, j := 0, i
+		for ; j < len(urlPath); j++ {
+			if urlPath[j] == '{' {
+				depth++
+			} else if urlPath[j] == '}' {
+				depth--
+				if depth == 0 {
+					break
+				}
+			}
+		}
+		if j == len(urlPath) {
+			buf.WriteString(urlPath[i:])
+			break
+		}
+		if buf.Len() > 0 {
+			parts = append(parts, pathTemplatePart{text: buf.String()})
+			buf.Reset()
+		}
+		part := pathTemplatePart{text: urlPath[i : j+1], name: urlPath[i+1 : j]}
+		if idx := stdstrings.Index(part.name, ":"); idx != -1 {
+			part.name, part.regexp = part.name[:idx], part.name[idx+1:]
+		}
+		part.name = stdstrings.TrimSpace(part.name)
+		parts = append(parts, part)
+		i = j
+	}
+	if buf.Len() > 0 {
+		parts = append(parts, pathTemplatePart{text: buf.String()})
+	}
+	return
+}
@@ -1,6 +1,16 @@
 package generator
 
-import "testing"
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/swipe-io/swipe/v3/internal/importer"
+	"github.com/swipe-io/swipe/v3/swipe"
+	"github.com/swipe-io/swipe/v3/writer"
+)
 
 func Test_wrapData(t *testing.T) {
 	type args struct {
@@ -79,3 +89,82 @@ func Test_wrapDataClient(t *testing.T) {
 		})
 	}
 }
+
+// stubModule replaces the module required by the generated code, the harness builds offline.
+type stubModule struct {
+	path  string
+	files map[string]string
+}
+
+var (
+	goKitStub = stubModule{
+		path: "github.com/go-kit/kit",
+		files: map[string]string{
+			"endpoint/endpoint.go": "package endpoint\n\nimport \"context\"\n\ntype Endpoint func(ctx context.Context, request interface{}) (interface{}, error)\n\ntype Middleware func(Endpoint) Endpoint\n",
+		},
+	}
+	ffjsonStub = stubModule{
+		path: "github.com/pquerna/ffjson",
+		files: map[string]string{
+			"ffjson/ffjson.go": "package ffjson\n\nimport \"encoding/json\"\n\nfunc Marshal(v interface{}) ([]byte, error) {\n\treturn json.Marshal(v)\n}\n\nfunc Unmarshal(data []byte, v interface{}) error {\n\treturn json.Unmarshal(data, v)\n}\n",
+		},
+	}
+)
+
+// buildHarness builds the generated code with the harness main package and returns the binary path.
+func buildHarness(t *testing.T, generate func(w *writer.GoWriter, importer swipe.Importer), harness string, stubs ...stubModule) string {
+	t.Helper()
+
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("the go tool is not found")
+	}
+	dir := t.TempDir()
+
+	imp := importer.NewImporter("main")
+	var w writer.GoWriter
+	generate(&w, imp)
+
+	var src bytes.Buffer
+	src.WriteString("package main\n\nimport (\n")
+	for _, s := range imp.SortedImports() {
+		src.WriteString(s)
+	}
+	src.WriteString(")\n\n")
+	src.Write(w.Bytes())
+
+	var mod bytes.Buffer
+	mod.WriteString("module harness\n\ngo 1.18\n")
+	files := map[string]string{
+		"generated.go": src.String(),
+		"main.go":      harness,
+	}
+	for _, stub := range stubs {
+		stubDir := filepath.Join("stubs", filepath.FromSlash(stub.path))
+		mod.WriteString("\nrequire " + stub.path + " v0.0.0\n\nreplace " + stub.path + " => ./" + filepath.ToSlash(stubDir) + "\n")
+		files[filepath.Join(stubDir, "go.mod")] = "module " + stub.path + "\n\ngo 1.18\n"
+		for name, content := range stub.files {
+			files[filepath.Join(stubDir, filepath.FromSlash(name))] = content
+		}
+	}
+	files["go.mod"] = mod.String()
+
+	for name, content := range files {
+		filename := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filename, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	bin := filepath.Join(dir, "harness")
+	cmd := exec.Command(goBin, "build", "-o", bin, ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOWORK=off", "GOPROXY=off")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("build the generated code: %v\n%s\n%s", err, out, src.String())
+	}
+	return bin
+}
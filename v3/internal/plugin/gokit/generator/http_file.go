@@ -0,0 +1,252 @@
+package generator
+
+import (
+	"context"
+	"net/url"
+	"path"
+	stdstrings "strings"
+
+	"github.com/swipe-io/strcase"
+
+	"github.com/swipe-io/swipe/v3/internal/plugin"
+	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/config"
+	"github.com/swipe-io/swipe/v3/option"
+	"github.com/swipe-io/swipe/v3/writer"
+)
+
+const httpFileMultipartBoundary = "boundary"
+
+// HTTPFile generates the requests in the .http format of the JetBrains HTTP Client and the VS Code REST Client.
+type HTTPFile struct {
+	w             writer.TextWriter
+	Servers       []config.OpenapiServer
+	Interfaces    []*config.Interface
+	MethodOptions map[string]config.MethodOptions
+	JSONRPCEnable bool
+	JSONRPCPath   string
+	Output        string
+}
+
+func (g *HTTPFile) Generate(ctx context.Context) []byte {
+	host := sampleBaseURL
+	if len(g.Servers) > 0 {
+		host = stdstrings.TrimRight(g.Servers[0].Url, "/")
+	}
+
+	g.w.W("@host = %s\n", host)
+
+	schemes := makeAuthSchemes(g.Interfaces, g.MethodOptions)
+	if schemes.bearer {
+		g.w.W("@bearerToken =\n")
+	}
+	if schemes.basic {
+		g.w.W("@basicUsername =\n")
+		g.w.W("@basicPassword =\n")
+	}
+	apiKeyVars := map[string]struct{}{}
+	for _, a := range schemes.apiKeys {
+		key := sampleAPIKeyVar(a)
+		if _, ok := apiKeyVars[key]; ok {
+			continue
+		}
+		apiKeyVars[key] = struct{}{}
+		g.w.W("@%s =\n", key)
+	}
+
+	for _, iface := range g.Interfaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+		for _, m := range ifaceType.Methods {
+			mopt := g.MethodOptions[iface.Named.Name.Value+m.Name.Value]
+
+			methodName := m.Name.Lower()
+			if prefix := rpcPrefix(iface); prefix != "" {
+				methodName = prefix + "." + methodName
+			}
+
+			g.w.W("\n### %s\n", methodName)
+			for _, line := range stdstrings.Split(stdstrings.TrimSpace(m.Comment), "\n") {
+				if line = stdstrings.TrimSpace(line); line != "" {
+					g.w.W("# %s\n", line)
+				}
+			}
+			if g.JSONRPCEnable {
+				g.writeJSONRPCRequest(methodName, m, mopt)
+			} else {
+				g.writeRESTRequest(iface, m, mopt)
+			}
+		}
+	}
+	return g.w.Bytes()
+}
+
+func (g *HTTPFile) writeJSONRPCRequest(methodName string, m *option.FuncType, mopt config.MethodOptions) {
+	params := map[string]interface{}{}
+	for _, p := range m.Sig.Params {
+		if plugin.IsContext(p) {
+			continue
+		}
+		params[p.Name.Value] = sampleValue(p.Type, "", map[string]int{})
+	}
+	body := sampleIndentJSON(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  methodName,
+		"params":  params,
+	})
+
+	query, headers := g.authParams(mopt)
+	g.writeRequestLine("POST", g.JSONRPCPath, query)
+	g.w.W("Content-Type: application/json\n")
+	g.writeHeaders(headers)
+	g.w.W("\n%s\n", body)
+}
+
+func (g *HTTPFile) writeRESTRequest(iface *config.Interface, m *option.FuncType, mopt config.MethodOptions) {
+	bodyType := mopt.RESTBodyType.Take()
+	if bodyType == "" {
+		bodyType = "json"
+	}
+
+	var (
+		query      []string
+		headers    []string
+		pathValues = map[string]string{}
+		paramVars  []*option.VarType
+	)
+	for _, p := range m.Sig.Params {
+		if plugin.IsContext(p) {
+			continue
+		}
+		if v, ok := plugin.FindParam(p, mopt.RESTQueryVars.Value); ok {
+			query = append(query, v.Value+"="+url.QueryEscape(sampleString(p.Type, "")))
+			continue
+		}
+		if v, ok := plugin.FindParam(p, mopt.RESTHeaderVars.Value); ok {
+			headers = append(headers, v.Value+": "+sampleString(p.Type, ""))
+			continue
+		}
+		if re, ok := mopt.RESTPathVars[p.Name.Value]; ok {
+			if re == "" {
+				re = "[^/]+"
+			}
+			pathValues[p.Name.Value] = url.PathEscape(sampleString(p.Type, re))
+			continue
+		}
+		paramVars = append(paramVars, p)
+	}
+	for i := 0; i+1 < len(mopt.RESTQueryValues.Value); i += 2 {
+		query = append(query, mopt.RESTQueryValues.Value[i]+"="+url.QueryEscape(mopt.RESTQueryValues.Value[i+1]))
+	}
+
+	var urlPath string
+	if mopt.RESTPath.IsValid() {
+		urlPath = mopt.RESTPath.Take()
+	} else {
+		urlPath = strcase.ToKebab(m.Name.Value)
+	}
+	if prefix := restPrefix(iface, iface.Namespace); prefix != "" {
+		urlPath = path.Join(prefix, urlPath)
+	}
+	var buf stdstrings.Builder
+	for _, part := range splitPathTemplate(urlPath) {
+		if part.name == "" {
+			buf.WriteString(part.text)
+			continue
+		}
+		buf.WriteString(pathValues[part.name])
+	}
+
+	httpMethod := "GET"
+	if mopt.RESTMethod.Take() != "" {
+		httpMethod = stdstrings.ToUpper(mopt.RESTMethod.Take())
+	}
+
+	authQuery, authHeaders := g.authParams(mopt)
+	g.writeRequestLine(httpMethod, buf.String(), append(query, authQuery...))
+	g.writeHeaders(append(headers, authHeaders...))
+
+	switch httpMethod {
+	case "POST", "PUT", "PATCH":
+	default:
+		return
+	}
+	if len(paramVars) == 0 {
+		return
+	}
+	switch bodyType {
+	case "json":
+		if len(paramVars) == 1 {
+			if s, ok := paramVars[0].Type.(*option.SliceType); ok {
+				if b, ok := s.Value.(*option.BasicType); ok && b.IsByte() {
+					g.w.W("Content-Type: application/octet-stream\n\n")
+					g.w.W("< ./%s\n", paramVars[0].Name.Value)
+					return
+				}
+			}
+		}
+		g.w.W("Content-Type: application/json\n\n")
+		g.w.W("%s\n", sampleIndentJSON(sampleRequestBody(paramVars, mopt.RESTWrapRequest.Take())))
+	case "urlencoded":
+		values := make([]string, 0, len(paramVars))
+		for _, p := range paramVars {
+			values = append(values, p.Name.Value+"="+url.QueryEscape(sampleString(p.Type, "")))
+		}
+		g.w.W("Content-Type: application/x-www-form-urlencoded\n\n")
+		g.w.W("%s\n", stdstrings.Join(values, "&"))
+	case "multipart":
+		g.w.W("Content-Type: multipart/form-data; boundary=%s\n\n", httpFileMultipartBoundary)
+		for _, p := range paramVars {
+			g.w.W("--%s\n", httpFileMultipartBoundary)
+			if isFileUploadType(p.Type) {
+				g.w.W("Content-Disposition: form-data; name=\"%s\"; filename=\"%s\"\n\n", p.Name.Value, p.Name.Value)
+				g.w.W("< ./%s\n", p.Name.Value)
+				continue
+			}
+			g.w.W("Content-Disposition: form-data; name=\"%s\"\n\n", p.Name.Value)
+			g.w.W("%s\n", sampleString(p.Type, ""))
+		}
+		g.w.W("--%s--\n", httpFileMultipartBoundary)
+	}
+}
+
+func (g *HTTPFile) writeRequestLine(httpMethod, urlPath string, query []string) {
+	g.w.W("%s {{host}}/%s", httpMethod, stdstrings.TrimLeft(urlPath, "/"))
+	if len(query) > 0 {
+		g.w.W("?%s", stdstrings.Join(query, "&"))
+	}
+	g.w.W("\n")
+}
+
+func (g *HTTPFile) writeHeaders(headers []string) {
+	for _, h := range headers {
+		g.w.W("%s\n", h)
+	}
+}
+
+// authParams returns the query params and the headers with the placeholders of the auth variables.
+func (g *HTTPFile) authParams(mopt config.MethodOptions) (query []string, headers []string) {
+	switch {
+	case mopt.BearerAuth != nil:
+		headers = append(headers, "Authorization: Bearer {{bearerToken}}")
+	case mopt.BasicAuth != nil:
+		headers = append(headers, "Authorization: Basic {{basicUsername}} {{basicPassword}}")
+	}
+	if mopt.APIKeyAuth != nil {
+		value := "{{" + sampleAPIKeyVar(*mopt.APIKeyAuth) + "}}"
+		switch mopt.APIKeyAuth.In {
+		case "header":
+			headers = append(headers, mopt.APIKeyAuth.Name+": "+value)
+		case "query":
+			query = append(query, mopt.APIKeyAuth.Name+"="+value)
+		}
+	}
+	return
+}
+
+func (g *HTTPFile) OutputPath() string {
+	return g.Output
+}
+
+func (g *HTTPFile) Filename() string {
+	return "requests.http"
+}
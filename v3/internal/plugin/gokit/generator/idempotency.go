@@ -0,0 +1,363 @@
+package generator
+
+import (
+	"strconv"
+
+	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/config"
+	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/openapi"
+	"github.com/swipe-io/swipe/v3/option"
+	"github.com/swipe-io/swipe/v3/swipe"
+	"github.com/swipe-io/swipe/v3/writer"
+)
+
+const (
+	idempotencyKeyHeader      = "Idempotency-Key"
+	idempotencyReplayedHeader = "Idempotent-Replayed"
+)
+
+const restJSIdempotencyBase = `
+function newIdempotencyKey() {
+	if (globalThis.crypto && globalThis.crypto.randomUUID) {
+		return globalThis.crypto.randomUUID();
+	}
+	return Date.now().toString(36) + Math.random().toString(36).slice(2) + Math.random().toString(36).slice(2);
+}
+`
+
+const tsIdempotencyBase = `
+function newIdempotencyKey(): string {
+	if (globalThis.crypto && globalThis.crypto.randomUUID) {
+		return globalThis.crypto.randomUUID();
+	}
+	return Date.now().toString(36) + Math.random().toString(36).slice(2) + Math.random().toString(36).slice(2);
+}
+`
+
+func hasIdempotency(ifaces []*config.Interface, methodOptions map[string]config.MethodOptions) bool {
+	for _, iface := range ifaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+		for _, m := range ifaceType.Methods {
+			if methodOptions[iface.Named.Name.Value+m.Name.Value].Idempotent != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func idempotencyOpenapiParameter() openapi.Parameter {
+	return openapi.Parameter{
+		In:          "header",
+		Name:        idempotencyKeyHeader,
+		Description: "The unique key of the request, the repeated requests with the key return the stored response.",
+		Schema:      &openapi.Schema{Type: "string"},
+	}
+}
+
+func writeIdempotencyServerOptions(w *writer.GoWriter) {
+	w.W("// IdempotencyStoreOption sets the store of the idempotent method responses, the memory store is used by default.\n")
+	w.W("func IdempotencyStoreOption(store IdempotencyStore) ServerOption {\nreturn func(c *serverOpts) {\nc.idempotencyStore = store\n}\n}\n\n")
+}
+
+func writeIdempotencyServerOptsFields(w *writer.GoWriter) {
+	w.W("idempotencyStore IdempotencyStore\n")
+}
+
+func writeIdempotencyServer(w *writer.GoWriter, importer swipe.Importer, useFast bool, schemes authSchemes) {
+	contextPkg := importer.Import("context", "context")
+	endpointPkg := importer.Import("endpoint", "github.com/go-kit/kit/endpoint")
+	syncPkg := importer.Import("sync", "sync")
+	timePkg := importer.Import("time", "time")
+	sha256Pkg := importer.Import("sha256", "crypto/sha256")
+	hexPkg := importer.Import("hex", "encoding/hex")
+
+	var (
+		httpPkg   string
+		routerPkg string
+	)
+	if useFast {
+		httpPkg = importer.Import("fasthttp", "github.com/valyala/fasthttp")
+		routerPkg = importer.Import("routing", "github.com/qiangxue/fasthttp-routing")
+	} else {
+		httpPkg = importer.Import("http", "net/http")
+	}
+
+	w.W("// DefaultIdempotencyTTL is the time the default memory store keeps the responses for.\n")
+	w.W("const DefaultIdempotencyTTL = 24 * %s.Hour\n\n", timePkg)
+
+	w.W("// IdempotencyResponse is the stored response of the idempotent request.\n")
+	w.W("type IdempotencyResponse struct {\n")
+	w.W("StatusCode int\n")
+	w.W("Header map[string][]string\n")
+	w.W("Body []byte\n")
+	w.W("// Fingerprint is the hash of the request, the key repeated with another request is rejected.\n")
+	w.W("Fingerprint string\n")
+	w.W("}\n\n")
+
+	w.W("// IdempotencyStore stores the responses of the idempotent methods, the key holds the method, the caller\n")
+	w.W("// credentials hash and the %s header value.\n", idempotencyKeyHeader)
+	w.W("type IdempotencyStore interface {\n")
+	w.W("// Get returns the stored response of the key, a nil response means the key is not stored.\n")
+	w.W("Get(ctx %s.Context, key string) (*IdempotencyResponse, error)\n", contextPkg)
+	w.W("// Set stores the response of the key.\n")
+	w.W("Set(ctx %s.Context, key string, response *IdempotencyResponse) error\n", contextPkg)
+	w.W("}\n\n")
+
+	w.W("const memoryIdempotencyMaxEntries = 10000\n\n")
+
+	w.W("type memoryIdempotencyEntry struct {\nresponse *IdempotencyResponse\nexpires %s.Time\n}\n\n", timePkg)
+
+	w.W("type memoryIdempotencyStore struct {\n")
+	w.W("mu %s.Mutex\n", syncPkg)
+	w.W("ttl %s.Duration\n", timePkg)
+	w.W("entries map[string]memoryIdempotencyEntry\n")
+	w.W("}\n\n")
+
+	w.W("// NewMemoryIdempotencyStore returns the store that keeps the responses in memory for the ttl,\n")
+	w.W("// it suits the tests and the single instance services.\n")
+	w.W("func NewMemoryIdempotencyStore(ttl %s.Duration) IdempotencyStore {\n", timePkg)
+	w.W("return &memoryIdempotencyStore{ttl: ttl, entries: map[string]memoryIdempotencyEntry{}}\n")
+	w.W("}\n\n")
+
+	w.W("func (s *memoryIdempotencyStore) Get(_ %s.Context, key string) (*IdempotencyResponse, error) {\n", contextPkg)
+	w.W("s.mu.Lock()\n")
+	w.W("defer s.mu.Unlock()\n")
+	w.W("e, ok := s.entries[key]\n")
+	w.W("if !ok {\nreturn nil, nil\n}\n")
+	w.W("if %s.Now().After(e.expires) {\ndelete(s.entries, key)\nreturn nil, nil\n}\n", timePkg)
+	w.W("return e.response, nil\n")
+	w.W("}\n\n")
+
+	w.W("func (s *memoryIdempotencyStore) Set(_ %s.Context, key string, response *IdempotencyResponse) error {\n", contextPkg)
+	w.W("now := %s.Now()\n", timePkg)
+	w.W("s.mu.Lock()\n")
+	w.W("defer s.mu.Unlock()\n")
+	w.W("if len(s.entries) >= memoryIdempotencyMaxEntries {\n")
+	w.W("for k, e := range s.entries {\nif now.After(e.expires) {\ndelete(s.entries, k)\n}\n}\n")
+	w.W("}\n")
+	w.W("s.entries[key] = memoryIdempotencyEntry{response: response, expires: now.Add(s.ttl)}\n")
+	w.W("return nil\n")
+	w.W("}\n\n")
+
+	w.W("type IdempotencyKeyInProgressError struct{}\n\n")
+	w.W("func (*IdempotencyKeyInProgressError) Error() string {\nreturn \"a request with the idempotency key is in progress\"\n}\n\n")
+	w.W("func (*IdempotencyKeyInProgressError) StatusCode() int {\nreturn 409\n}\n\n")
+	w.W("func (*IdempotencyKeyInProgressError) Code() string {\nreturn \"idempotency_key_in_progress\"\n}\n\n")
+
+	w.W("type IdempotencyKeyReusedError struct{}\n\n")
+	w.W("func (*IdempotencyKeyReusedError) Error() string {\nreturn \"the idempotency key is used by another request\"\n}\n\n")
+	w.W("func (*IdempotencyKeyReusedError) StatusCode() int {\nreturn 422\n}\n\n")
+	w.W("func (*IdempotencyKeyReusedError) Code() string {\nreturn \"idempotency_key_reused\"\n}\n\n")
+
+	w.W("// idempotencyKeys tracks the keys of the requests in progress, a repeated request is rejected\n")
+	w.W("// until the first one is stored.\n")
+	w.W("type idempotencyKeys struct {\nmu %s.Mutex\nkeys map[string]struct{}\n}\n\n", syncPkg)
+
+	w.W("func (k *idempotencyKeys) acquire(key string) bool {\n")
+	w.W("k.mu.Lock()\n")
+	w.W("defer k.mu.Unlock()\n")
+	w.W("if _, ok := k.keys[key]; ok {\nreturn false\n}\n")
+	w.W("k.keys[key] = struct{}{}\n")
+	w.W("return true\n")
+	w.W("}\n\n")
+
+	w.W("func (k *idempotencyKeys) release(key string) {\n")
+	w.W("k.mu.Lock()\n")
+	w.W("delete(k.keys, key)\n")
+	w.W("k.mu.Unlock()\n")
+	w.W("}\n\n")
+
+	w.W("// idempotencyCall is the request with the %s header, the handler passes it to the endpoint middleware\n", idempotencyKeyHeader)
+	w.W("// and stores the response when the middleware executes the method.\n")
+	w.W("type idempotencyCall struct {\n")
+	w.W("key string\n")
+	w.W("fingerprint string\n")
+	w.W("storeKey string\n")
+	w.W("release func()\n")
+	w.W("}\n\n")
+
+	w.W("type idempotencyCallContextKey struct{}\n\n")
+
+	w.W("// idempotencyCalls holds the calls by the request while the handler serves them.\n")
+	w.W("var idempotencyCalls %s.Map\n\n", syncPkg)
+
+	w.W("func idempotencyFingerprint(method, uri string, body []byte) string {\n")
+	w.W("h := %s.New()\n", sha256Pkg)
+	w.W("h.Write([]byte(method + \" \" + uri + \"\\n\"))\n")
+	w.W("h.Write(body)\n")
+	w.W("return %s.EncodeToString(h.Sum(nil))\n", hexPkg)
+	w.W("}\n\n")
+
+	if schemes.enabled() {
+		w.W("// idempotencyPrincipal returns the hash of the request credentials, the stored responses are replayed\n")
+		w.W("// to the callers with the same credentials only.\n")
+		w.W("func idempotencyPrincipal(ctx %s.Context) string {\n", contextPkg)
+		w.W("h := %s.New()\n", sha256Pkg)
+		if schemes.bearer {
+			w.W("if token, ok := BearerTokenFromContext(ctx); ok {\nh.Write([]byte(\"bearer \" + token + \"\\n\"))\n}\n")
+		}
+		if schemes.basic {
+			w.W("if credentials, ok := BasicCredentialsFromContext(ctx); ok {\nh.Write([]byte(\"basic \" + credentials.Username + \":\" + credentials.Password + \"\\n\"))\n}\n")
+		}
+		for _, a := range schemes.apiKeys {
+			w.W("if key, ok := APIKeyFromContext(ctx, %[1]s); ok {\nh.Write([]byte(\"apiKey \" + %[1]s + \" \" + key + \"\\n\"))\n}\n", strconv.Quote(a.Name))
+		}
+		w.W("return %s.EncodeToString(h.Sum(nil))\n", hexPkg)
+		w.W("}\n\n")
+	}
+
+	w.W("func idempotencyServerBefore(ctx %s.Context, r *%s.Request) %s.Context {\n", contextPkg, httpPkg, contextPkg)
+	w.W("if call, ok := idempotencyCalls.Load(r); ok {\nreturn %s.WithValue(ctx, idempotencyCallContextKey{}, call)\n}\n", contextPkg)
+	w.W("return ctx\n")
+	w.W("}\n\n")
+
+	storeKey := "method + \":\" + call.key"
+	if schemes.enabled() {
+		storeKey = "method + \":\" + idempotencyPrincipal(ctx) + \":\" + call.key"
+	}
+
+	w.W("// idempotencyMiddleware executes the method once for the %s and replays the stored response\n", idempotencyKeyHeader)
+	w.W("// for the repeats, it goes after the auth and rate limit middlewares so the repeats are checked as well.\n")
+	w.W("func idempotencyMiddleware(store IdempotencyStore, method string) %s.Middleware {\n", endpointPkg)
+	w.W("inProgress := &idempotencyKeys{keys: map[string]struct{}{}}\n")
+	w.W("return func(next %[1]s.Endpoint) %[1]s.Endpoint {\n", endpointPkg)
+	w.W("return func(ctx %s.Context, request interface{}) (interface{}, error) {\n", contextPkg)
+	w.W("call, ok := ctx.Value(idempotencyCallContextKey{}).(*idempotencyCall)\n")
+	w.W("if !ok {\nreturn next(ctx, request)\n}\n")
+	w.W("key := %s\n", storeKey)
+	w.W("if !inProgress.acquire(key) {\nreturn nil, &IdempotencyKeyInProgressError{}\n}\n")
+	w.W("call.release = func() {\ninProgress.release(key)\n}\n")
+	w.W("response, err := store.Get(ctx, key)\n")
+	w.W("if err != nil {\nreturn nil, err\n}\n")
+	w.W("if response != nil {\n")
+	w.W("if response.Fingerprint != call.fingerprint {\nreturn nil, &IdempotencyKeyReusedError{}\n}\n")
+	w.W("return response, nil\n")
+	w.W("}\n")
+	w.W("call.storeKey = key\n")
+	w.W("return next(ctx, request)\n")
+	w.W("}\n}\n}\n\n")
+
+	if useFast {
+		w.W("// writeIdempotencyResponse writes the stored response replayed by the idempotency middleware.\n")
+		w.W("func writeIdempotencyResponse(w *%s.Response, response *IdempotencyResponse) error {\n", httpPkg)
+		w.W("for name, values := range response.Header {\nw.Header.Del(name)\nfor _, v := range values {\nw.Header.Add(name, v)\n}\n}\n")
+		w.W("w.Header.Set(%s, \"true\")\n", strconv.Quote(idempotencyReplayedHeader))
+		w.W("w.SetStatusCode(response.StatusCode)\n")
+		w.W("w.SetBody(response.Body)\n")
+		w.W("return nil\n")
+		w.W("}\n\n")
+
+		w.W("// idempotencyHandler passes the request with the %s header to the idempotency middleware\n", idempotencyKeyHeader)
+		w.W("// and stores the successful response of the executed method.\n")
+		w.W("func idempotencyHandler(store IdempotencyStore, next %[1]s.Handler) %[1]s.Handler {\n", routerPkg)
+		w.W("return func(c *%s.Context) error {\n", routerPkg)
+		w.W("key := string(c.Request.Header.Peek(%s))\n", strconv.Quote(idempotencyKeyHeader))
+		w.W("if key == \"\" {\nreturn next(c)\n}\n")
+		w.W("call := &idempotencyCall{key: key, fingerprint: idempotencyFingerprint(string(c.Method()), string(c.RequestURI()), c.Request.Body())}\n")
+		w.W("idempotencyCalls.Store(&c.Request, call)\n")
+		w.W("defer idempotencyCalls.Delete(&c.Request)\n")
+		w.W("err := next(c)\n")
+		w.W("if call.release != nil {\ndefer call.release()\n}\n")
+		w.W("if err != nil || call.storeKey == \"\" {\nreturn err\n}\n")
+		w.W("if statusCode := c.Response.StatusCode(); statusCode < 200 || statusCode > 299 {\nreturn nil\n}\n")
+		w.W("header := map[string][]string{}\n")
+		w.W("c.Response.Header.VisitAll(func(k, v []byte) {\nheader[string(k)] = append(header[string(k)], string(v))\n})\n")
+		w.W("return store.Set(c, call.storeKey, &IdempotencyResponse{StatusCode: c.Response.StatusCode(), Header: header, Body: append([]byte(nil), c.Response.Body()...), Fingerprint: call.fingerprint})\n")
+		w.W("}\n}\n\n")
+		return
+	}
+
+	bytesPkg := importer.Import("bytes", "bytes")
+	ioPkg := importer.Import("io", "io")
+
+	w.W("// writeIdempotencyResponse writes the stored response replayed by the idempotency middleware.\n")
+	w.W("func writeIdempotencyResponse(w %s.ResponseWriter, response *IdempotencyResponse) error {\n", httpPkg)
+	w.W("for name, values := range response.Header {\nw.Header()[name] = values\n}\n")
+	w.W("w.Header().Set(%s, \"true\")\n", strconv.Quote(idempotencyReplayedHeader))
+	w.W("w.WriteHeader(response.StatusCode)\n")
+	w.W("_, err := w.Write(response.Body)\n")
+	w.W("return err\n")
+	w.W("}\n\n")
+
+	w.W("// idempotencyRecorder copies the response written by the handler.\n")
+	w.W("type idempotencyRecorder struct {\n%s.ResponseWriter\nstatusCode int\nbody %s.Buffer\n}\n\n", httpPkg, bytesPkg)
+
+	w.W("func (r *idempotencyRecorder) WriteHeader(statusCode int) {\n")
+	w.W("r.statusCode = statusCode\n")
+	w.W("r.ResponseWriter.WriteHeader(statusCode)\n")
+	w.W("}\n\n")
+
+	w.W("func (r *idempotencyRecorder) Write(b []byte) (int, error) {\n")
+	w.W("r.body.Write(b)\n")
+	w.W("return r.ResponseWriter.Write(b)\n")
+	w.W("}\n\n")
+
+	w.W("// idempotencyHandler passes the request with the %s header to the idempotency middleware\n", idempotencyKeyHeader)
+	w.W("// and stores the successful response of the executed method.\n")
+	w.W("func idempotencyHandler(store IdempotencyStore, next %[1]s.Handler) %[1]s.Handler {\n", httpPkg)
+	w.W("return %s.HandlerFunc(func(w %s.ResponseWriter, r *%s.Request) {\n", httpPkg, httpPkg, httpPkg)
+	w.W("key := r.Header.Get(%s)\n", strconv.Quote(idempotencyKeyHeader))
+	w.W("if key == \"\" {\nnext.ServeHTTP(w, r)\nreturn\n}\n")
+	w.W("body, err := %s.ReadAll(r.Body)\n", ioPkg)
+	w.W("if err != nil {\n%[1]s.Error(w, err.Error(), %[1]s.StatusBadRequest)\nreturn\n}\n", httpPkg)
+	w.W("r.Body = %s.NopCloser(%s.NewReader(body))\n", ioPkg, bytesPkg)
+	w.W("call := &idempotencyCall{key: key, fingerprint: idempotencyFingerprint(r.Method, r.URL.RequestURI(), body)}\n")
+	w.W("idempotencyCalls.Store(r, call)\n")
+	w.W("defer idempotencyCalls.Delete(r)\n")
+	w.W("rec := &idempotencyRecorder{ResponseWriter: w, statusCode: %s.StatusOK}\n", httpPkg)
+	w.W("next.ServeHTTP(rec, r)\n")
+	w.W("if call.release != nil {\ndefer call.release()\n}\n")
+	w.W("if call.storeKey == \"\" || rec.statusCode < 200 || rec.statusCode > 299 {\nreturn\n}\n")
+	w.W("_ = store.Set(r.Context(), call.storeKey, &IdempotencyResponse{StatusCode: rec.statusCode, Header: w.Header().Clone(), Body: rec.body.Bytes(), Fingerprint: call.fingerprint})\n")
+	w.W("})\n}\n\n")
+}
+
+// writeIdempotencyEndpointMiddleware writes the idempotency middleware of the method,
+// the auth and rate limit middlewares are written after it and run before it.
+func writeIdempotencyEndpointMiddleware(w *writer.GoWriter, iface *config.Interface, epSetName string, m *option.FuncType, mopt config.MethodOptions) {
+	if mopt.Idempotent == nil {
+		return
+	}
+	w.W("%[1]s.%[2]sEndpoint = idempotencyMiddleware(opts.idempotencyStore, %[3]s)(%[1]s.%[2]sEndpoint)\n", epSetName, m.Name, strconv.Quote(LcNameIfaceMethod(iface, m)))
+}
+
+func writeIdempotencyClient(w *writer.GoWriter, importer swipe.Importer, useFast bool) {
+	contextPkg := importer.Import("context", "context")
+	endpointPkg := importer.Import("endpoint", "github.com/go-kit/kit/endpoint")
+	randPkg := importer.Import("rand", "crypto/rand")
+	hexPkg := importer.Import("hex", "encoding/hex")
+
+	var requestType string
+	if useFast {
+		requestType = "*" + importer.Import("fasthttp", "github.com/valyala/fasthttp") + ".Request"
+	} else {
+		requestType = "*" + importer.Import("http", "net/http") + ".Request"
+	}
+
+	w.W("type idempotencyKeyContextKey struct{}\n\n")
+
+	w.W("// IdempotencyKeyContext returns the context with the %s of the idempotent method calls,\n", idempotencyKeyHeader)
+	w.W("// the server executes the calls with the same key once and replays the response for the repeats.\n")
+	w.W("func IdempotencyKeyContext(ctx %[1]s.Context, key string) %[1]s.Context {\n", contextPkg)
+	w.W("return %s.WithValue(ctx, idempotencyKeyContextKey{}, key)\n", contextPkg)
+	w.W("}\n\n")
+
+	w.W("// idempotencyKeyMiddleware assigns a random key to the call without one,\n")
+	w.W("// so the retries made by the endpoint middlewares send the same key.\n")
+	w.W("func idempotencyKeyMiddleware(next %[1]s.Endpoint) %[1]s.Endpoint {\n", endpointPkg)
+	w.W("return func(ctx %s.Context, request interface{}) (interface{}, error) {\n", contextPkg)
+	w.W("if _, ok := ctx.Value(idempotencyKeyContextKey{}).(string); !ok {\n")
+	w.W("b := make([]byte, 16)\n")
+	w.W("if _, err := %s.Read(b); err != nil {\nreturn nil, err\n}\n", randPkg)
+	w.W("ctx = IdempotencyKeyContext(ctx, %s.EncodeToString(b))\n", hexPkg)
+	w.W("}\n")
+	w.W("return next(ctx, request)\n")
+	w.W("}\n}\n\n")
+
+	w.W("func idempotencyClientBefore(ctx %s.Context, r %s) %s.Context {\n", contextPkg, requestType, contextPkg)
+	w.W("if key, _ := ctx.Value(idempotencyKeyContextKey{}).(string); key != \"\" {\n")
+	w.W("r.Header.Set(%s, key)\n", strconv.Quote(idempotencyKeyHeader))
+	w.W("}\n")
+	w.W("return ctx\n")
+	w.W("}\n\n")
+}
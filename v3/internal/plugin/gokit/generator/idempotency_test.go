@@ -0,0 +1,204 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"os/exec"
+	"reflect"
+	"testing"
+
+	"github.com/swipe-io/swipe/v3/swipe"
+	"github.com/swipe-io/swipe/v3/writer"
+)
+
+// idempotencyHarness serves the generated idempotency handler and middleware with the endpoint that
+// responds with the step status, it reads the steps from stdin and writes the responses to stdout.
+const idempotencyHarness = `package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+)
+
+type bearerTokenContextKey struct{}
+
+func BearerTokenFromContext(ctx context.Context) (string, bool) {
+	token, ok := ctx.Value(bearerTokenContextKey{}).(string)
+	return token, ok
+}
+
+type step struct {
+	Caller string
+	Key    string
+	Body   string
+	Status int
+}
+
+type result struct {
+	Status   int
+	Body     string
+	Replayed bool
+}
+
+func main() {
+	var steps []step
+	if err := json.NewDecoder(os.Stdin).Decode(&steps); err != nil {
+		panic(err)
+	}
+	var (
+		status int
+		calls  int
+	)
+	store := NewMemoryIdempotencyStore(DefaultIdempotencyTTL)
+	ep := idempotencyMiddleware(store, "create")(func(ctx context.Context, request interface{}) (interface{}, error) {
+		calls++
+		return calls, nil
+	})
+	handler := idempotencyHandler(store, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := idempotencyServerBefore(r.Context(), r)
+		ctx = context.WithValue(ctx, bearerTokenContextKey{}, r.Header.Get("Authorization"))
+		response, err := ep(ctx, nil)
+		if err != nil {
+			w.WriteHeader(err.(interface{ StatusCode() int }).StatusCode())
+			return
+		}
+		if replay, ok := response.(*IdempotencyResponse); ok {
+			_ = writeIdempotencyResponse(w, replay)
+			return
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(strconv.Itoa(response.(int))))
+	}))
+	results := make([]result, 0, len(steps))
+	for _, s := range steps {
+		status = s.Status
+		r := httptest.NewRequest(http.MethodPost, "/create", strings.NewReader(s.Body))
+		r.Header.Set("Authorization", s.Caller)
+		if s.Key != "" {
+			r.Header.Set("Idempotency-Key", s.Key)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		results = append(results, result{Status: w.Code, Body: w.Body.String(), Replayed: w.Header().Get("Idempotent-Replayed") == "true"})
+	}
+	if err := json.NewEncoder(os.Stdout).Encode(results); err != nil {
+		panic(err)
+	}
+}
+`
+
+func Test_idempotencyMemoryStore(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds the generated code")
+	}
+	bin := buildHarness(t, func(w *writer.GoWriter, importer swipe.Importer) {
+		writeIdempotencyServer(w, importer, false, authSchemes{bearer: true})
+	}, idempotencyHarness, goKitStub)
+
+	type step struct {
+		Caller string
+		Key    string
+		Body   string
+		Status int
+	}
+	type result struct {
+		Status   int
+		Body     string
+		Replayed bool
+	}
+	tests := []struct {
+		name  string
+		steps []step
+		want  []result
+	}{
+		{
+			"replays the stored response",
+			[]step{
+				{"Bearer a", "k1", `{"n":1}`, 201},
+				{"Bearer a", "k1", `{"n":1}`, 201},
+			},
+			[]result{
+				{201, "1", false},
+				{201, "1", true},
+			},
+		},
+		{
+			"stores 2xx responses only",
+			[]step{
+				{"Bearer a", "k1", `{"n":1}`, 500},
+				{"Bearer a", "k1", `{"n":1}`, 401},
+				{"Bearer a", "k1", `{"n":1}`, 201},
+				{"Bearer a", "k1", `{"n":1}`, 201},
+			},
+			[]result{
+				{500, "1", false},
+				{401, "2", false},
+				{201, "3", false},
+				{201, "3", true},
+			},
+		},
+		{
+			"rejects the key reused with another body",
+			[]step{
+				{"Bearer a", "k1", `{"n":1}`, 201},
+				{"Bearer a", "k1", `{"n":2}`, 201},
+			},
+			[]result{
+				{201, "1", false},
+				{422, "", false},
+			},
+		},
+		{
+			"scopes the keys per caller",
+			[]step{
+				{"Bearer a", "k1", `{"n":1}`, 201},
+				{"Bearer b", "k1", `{"n":1}`, 201},
+				{"Bearer a", "k1", `{"n":1}`, 201},
+				{"Bearer b", "k1", `{"n":1}`, 201},
+			},
+			[]result{
+				{201, "1", false},
+				{201, "2", false},
+				{201, "1", true},
+				{201, "2", true},
+			},
+		},
+		{
+			"executes the requests without key",
+			[]step{
+				{"Bearer a", "", `{"n":1}`, 201},
+				{"Bearer a", "", `{"n":1}`, 201},
+			},
+			[]result{
+				{201, "1", false},
+				{201, "2", false},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in, err := json.Marshal(tt.steps)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cmd := exec.Command(bin)
+			cmd.Stdin = bytes.NewReader(in)
+			out, err := cmd.Output()
+			if err != nil {
+				t.Fatalf("run the harness: %v", err)
+			}
+			var got []result
+			if err := json.Unmarshal(out, &got); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("responses = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
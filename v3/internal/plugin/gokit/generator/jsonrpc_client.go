@@ -13,11 +13,14 @@ import (
 )
 
 type JSONRPCClientGenerator struct {
-	w          writer.GoWriter
-	Interfaces []*config.Interface
-	UseFast    bool
-	Output     string
-	Pkg        string
+	w             writer.GoWriter
+	Interfaces    []*config.Interface
+	UseFast       bool
+	TracingEnable bool
+	Codecs        *config.CodecsEnable
+	MethodOptions map[string]config.MethodOptions
+	Output        string
+	Pkg           string
 }
 
 func (g *JSONRPCClientGenerator) Package() string {
@@ -86,6 +89,14 @@ func (g *JSONRPCClientGenerator) Generate(ctx context.Context) []byte {
 		g.w.W("u.Scheme = \"https\"")
 		g.w.W("}\n")
 
+		if g.TracingEnable {
+			g.w.W("opts.genericOpts.clientOption = append(opts.genericOpts.clientOption, %s.ClientBefore(tracingClientBefore))\n", jsonrpcPkg)
+		}
+		if g.Codecs != nil {
+			g.w.W("codecs := newCodecRegistry(opts.codecs)\n")
+			g.w.W("opts.genericOpts.clientOption = append(opts.genericOpts.clientOption, %s.ClientAfter(codecJSONRPCClientAfter(codecs)))\n", jsonrpcPkg)
+		}
+
 		for _, m := range ifaceType.Methods {
 			g.w.W("opts.%[1]sOpts.clientOption = append(\nopts.%[1]sOpts.clientOption,\n", LcNameIfaceMethod(iface, m))
 			g.w.W("%s.ClientRequestEncoder(", jsonrpcPkg)
@@ -137,8 +148,19 @@ func (g *JSONRPCClientGenerator) Generate(ctx context.Context) []byte {
 			g.w.W(")\n")
 
 			methodName := m.Name.Lower()
-			if iface.Namespace != "" {
-				methodName = iface.Namespace + "." + methodName
+			if prefix := rpcPrefix(iface); prefix != "" {
+				methodName = prefix + "." + methodName
+			}
+
+			writeAuthClientBefore(&g.w, jsonrpcPkg, LcNameIfaceMethod(iface, m)+"Opts", g.MethodOptions[iface.Named.Name.Value+m.Name.Value])
+
+			if g.Codecs != nil {
+				codecVar := codecVarName(LcNameIfaceMethod(iface, m))
+				g.w.W("%s, err := codecs.get(%s)\n", codecVar, strconv.Quote(codecMediaType(g.MethodOptions[iface.Named.Name.Value+m.Name.Value])))
+				g.w.WriteCheckErr("err", func() {
+					g.w.W("return nil, err\n")
+				})
+				g.w.W("opts.%[1]sOpts.clientOption = append(opts.%[1]sOpts.clientOption, %[2]s.ClientBefore(codecJSONRPCClientBefore(codecs, %[3]s)))\n", LcNameIfaceMethod(iface, m), jsonrpcPkg, codecVar)
 			}
 
 			g.w.W("c.%sEndpoint = %s.NewClient(\n", LcNameIfaceMethod(iface, m), jsonrpcPkg)
@@ -161,6 +161,16 @@ func (g *JSONRPCDocGenerator) Generate(ctx context.Context) []byte {
 	g.w.W("\n")
 
 	for _, named := range defTypes {
+		if len(named.Enums) > 0 {
+			g.w.W("### %s\n\n", named.Name)
+			g.w.W("Allowed values:\n\n")
+			g.w.W("| Value | Name |\n|------|------|\n")
+			for _, e := range named.Enums {
+				g.w.W("|<code>%s</code>|%s|\n", e.Literal, e.Name)
+			}
+			g.w.W("\n")
+			continue
+		}
 		st, ok := named.Type.(*option.StructType)
 		if !ok {
 			continue
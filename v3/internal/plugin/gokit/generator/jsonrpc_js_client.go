@@ -109,8 +109,8 @@ func (g *JSONRPCJSClientGenerator) Generate(ctx context.Context) []byte {
 			mw.W(strings.Join(params, ","))
 
 			var prefix string
-			if iface.Namespace != "" {
-				prefix = iface.Namespace + "."
+			if ns := rpcPrefix(iface); ns != "" {
+				prefix = ns + "."
 			}
 
 			mw.W(") {\n")
@@ -199,6 +199,10 @@ func (g *JSONRPCJSClientGenerator) Generate(ctx context.Context) []byte {
 		case "github.com/google/uuid", "github.com/pborman/uuid", "encoding/json", "time":
 			continue
 		}
+		if len(t.Enums) > 0 {
+			g.w.W(jsEnum(t))
+			continue
+		}
 		g.w.W(jsTypeDef(t))
 	}
 	return g.w.Bytes()
@@ -12,10 +12,15 @@ import (
 )
 
 type JSONRPCServerGenerator struct {
-	w           writer.GoWriter
-	UseFast     bool
-	Interfaces  []*config.Interface
-	JSONRPCPath string
+	w               writer.GoWriter
+	UseFast         bool
+	TracingEnable   bool
+	CORS            *config.HTTPCORS
+	Codecs          *config.CodecsEnable
+	HealthEndpoints *struct{}
+	Interfaces      []*config.Interface
+	MethodOptions   map[string]config.MethodOptions
+	JSONRPCPath     string
 }
 
 func (g *JSONRPCServerGenerator) Generate(ctx context.Context) []byte {
@@ -134,6 +139,18 @@ func (g *JSONRPCServerGenerator) Generate(ctx context.Context) []byte {
 	g.w.W("opts := &serverOpts{}\n")
 	g.w.W("for _, o := range options {\n o(opts)\n }\n")
 
+	if g.TracingEnable {
+		g.w.W("opts.genericOpts.serverOption = append(opts.genericOpts.serverOption, %s.ServerBefore(tracingServerBefore))\n", jsonrpcPkg)
+	}
+
+	if authSchemes := makeAuthSchemes(g.Interfaces, g.MethodOptions); authSchemes.enabled() {
+		writeAuthValidatorsCheck(&g.w, importer, authSchemes)
+		g.w.W("opts.genericOpts.serverOption = append(opts.genericOpts.serverOption, %s.ServerBefore(authServerBefore))\n", jsonrpcPkg)
+	}
+	if rateLimits := makeRateLimits(g.Interfaces, g.MethodOptions); len(rateLimits.keyHeaders) > 0 {
+		g.w.W("opts.genericOpts.serverOption = append(opts.genericOpts.serverOption, %s.ServerBefore(rateLimitServerBefore))\n", jsonrpcPkg)
+	}
+
 	for _, iface := range g.Interfaces {
 		optName := LcNameWithAppPrefix(iface, iface.Gateway != nil)
 		ifaceType := iface.Named.Type.(*option.IfaceType)
@@ -198,6 +215,8 @@ func (g *JSONRPCServerGenerator) Generate(ctx context.Context) []byte {
 					"%[3]s.%[2]sEndpoint = middlewareChain(append(opts.genericOpts.endpointMiddleware, opts.%[1]sOpts.endpointMiddleware...))(%[3]s.%[2]sEndpoint)\n",
 					LcNameIfaceMethod(iface, m), m.Name, epSetName,
 				)
+				writeAuthEndpointMiddleware(&g.w, epSetName, m, g.MethodOptions[iface.Named.Name.Value+m.Name.Value])
+				writeRateLimitEndpointMiddleware(&g.w, epSetName, m, g.MethodOptions[iface.Named.Name.Value+m.Name.Value])
 				g.w.W("}\n")
 			}
 		} else {
@@ -207,6 +226,8 @@ func (g *JSONRPCServerGenerator) Generate(ctx context.Context) []byte {
 					"%[3]s.%[2]sEndpoint = middlewareChain(append(opts.genericOpts.endpointMiddleware, opts.%[1]sOpts.endpointMiddleware...))(%[3]s.%[2]sEndpoint)\n",
 					LcNameIfaceMethod(iface, m), m.Name, epSetName,
 				)
+				writeAuthEndpointMiddleware(&g.w, epSetName, m, g.MethodOptions[iface.Named.Name.Value+m.Name.Value])
+				writeRateLimitEndpointMiddleware(&g.w, epSetName, m, g.MethodOptions[iface.Named.Name.Value+m.Name.Value])
 			}
 		}
 	}
@@ -216,6 +237,9 @@ func (g *JSONRPCServerGenerator) Generate(ctx context.Context) []byte {
 	} else {
 		g.w.W("r := %s.NewRouter()\n", routerPkg)
 	}
+	if g.CORS != nil {
+		g.w.W("r.Use(corsMiddleware)\n")
+	}
 
 	g.w.W("handler := %s.NewServer(", jsonrpcPkg)
 
@@ -232,8 +256,8 @@ func (g *JSONRPCServerGenerator) Generate(ctx context.Context) []byte {
 
 		g.w.W("Make%sEndpointCodecMap(%s", UcNameWithAppPrefix(iface), epSetName)
 
-		if iface.Namespace != "" {
-			g.w.W(",%s", strconv.Quote(iface.Namespace))
+		if prefix := rpcPrefix(iface); prefix != "" {
+			g.w.W(",%s", strconv.Quote(prefix))
 		}
 		g.w.W(")")
 	}
@@ -249,13 +273,35 @@ func (g *JSONRPCServerGenerator) Generate(ctx context.Context) []byte {
 		r := stdstrings.NewReplacer("{", "<", "}", ">")
 		jsonRPCPath = r.Replace(jsonRPCPath)
 
-		g.w.W("r.Post(\"%s\", func(c *routing.Context) error {\nhandler.ServeFastHTTP(c.RequestCtx)\nreturn nil\n})\n", jsonRPCPath)
+		serveFunc := "handler.ServeFastHTTP"
+		if g.Codecs != nil {
+			g.w.W("codecHandler := codecJSONRPCHandler(newCodecRegistry(opts.codecs), handler.ServeFastHTTP)\n")
+			serveFunc = "codecHandler"
+		}
+		g.w.W("r.Post(\"%s\", func(c *routing.Context) error {\n%s(c.RequestCtx)\nreturn nil\n})\n", jsonRPCPath, serveFunc)
 	} else {
 		g.w.W("r.Methods(\"POST\").")
 		if jsonRPCPath != "" {
 			g.w.W("Path(\"%s\").", jsonRPCPath)
 		}
-		g.w.W("Handler(handler)\n")
+		if g.Codecs != nil {
+			g.w.W("Handler(codecJSONRPCHandler(newCodecRegistry(opts.codecs), handler))\n")
+		} else {
+			g.w.W("Handler(handler)\n")
+		}
+	}
+	if g.CORS != nil {
+		var corsRoutes corsRoutes
+		for _, iface := range g.Interfaces {
+			ifaceType := iface.Named.Type.(*option.IfaceType)
+			for _, m := range ifaceType.Methods {
+				corsRoutes.add(jsonRPCPath, "POST", append([]string{"Content-Type"}, corsAuthHeaders(g.MethodOptions[iface.Named.Name.Value+m.Name.Value])...))
+			}
+		}
+		writeCORSPreflightRoutes(&g.w, g.UseFast, g.CORS, corsRoutes)
+	}
+	if g.HealthEndpoints != nil {
+		writeHealthRoutes(&g.w, g.UseFast)
 	}
 	if g.UseFast {
 		g.w.W("return r.HandleRequest, nil")
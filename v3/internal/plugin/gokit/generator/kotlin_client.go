@@ -0,0 +1,626 @@
+package generator
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/swipe-io/strcase"
+	"github.com/swipe-io/swipe/v3/internal/plugin"
+	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/config"
+	"github.com/swipe-io/swipe/v3/option"
+	"github.com/swipe-io/swipe/v3/writer"
+)
+
+const kotlinClientBase = `import java.io.ByteArrayOutputStream
+import java.net.HttpURLConnection
+import java.net.URL
+import java.net.URLEncoder
+import java.util.UUID
+import java.util.concurrent.Executor
+import java.util.concurrent.Executors
+import java.util.concurrent.atomic.AtomicLong
+import kotlin.coroutines.resume
+import kotlin.coroutines.resumeWithException
+import kotlin.coroutines.suspendCoroutine
+import org.json.JSONArray
+import org.json.JSONObject
+import org.json.JSONTokener
+
+open class APIException(message: String, val code: Long, val data: Any? = null, val errCode: String = "") : Exception(message)
+
+class UnknownException(message: String, code: Long, data: Any? = null, errCode: String = "") : APIException(message, code, data, errCode)
+
+interface JSONEnum {
+    val value: Any
+}
+
+class HTTPResponse(val status: Int, val body: ByteArray)
+
+interface Transport {
+    suspend fun request(method: String, path: String, query: List<Pair<String, String>>, headers: Map<String, String>, body: ByteArray?): HTTPResponse
+}
+
+class URLConnectionTransport(
+    baseURL: String,
+    private val headers: Map<String, String> = emptyMap(),
+    private val executor: Executor = Executors.newCachedThreadPool(),
+) : Transport {
+    private val baseURL = baseURL.trimEnd('/')
+
+    override suspend fun request(method: String, path: String, query: List<Pair<String, String>>, headers: Map<String, String>, body: ByteArray?): HTTPResponse =
+        suspendCoroutine { cont ->
+            executor.execute {
+                try {
+                    cont.resume(send(method, path, query, headers, body))
+                } catch (e: Throwable) {
+                    cont.resumeWithException(e)
+                }
+            }
+        }
+
+    private fun send(method: String, path: String, query: List<Pair<String, String>>, headers: Map<String, String>, body: ByteArray?): HTTPResponse {
+        var url = baseURL + path
+        if (query.isNotEmpty()) {
+            url += "?" + query.joinToString("&") { urlEncode(it.first) + "=" + urlEncode(it.second) }
+        }
+        val conn = URL(url).openConnection() as HttpURLConnection
+        try {
+            conn.requestMethod = method
+            (this.headers + headers).forEach { (k, v) -> conn.setRequestProperty(k, v) }
+            if (body != null) {
+                conn.doOutput = true
+                conn.outputStream.use { it.write(body) }
+            }
+            val status = conn.responseCode
+            val stream = if (status > 299) conn.errorStream else conn.inputStream
+            return HTTPResponse(status, stream?.use { it.readBytes() } ?: ByteArray(0))
+        } finally {
+            conn.disconnect()
+        }
+    }
+}
+
+internal fun urlEncode(value: String): String = URLEncoder.encode(value, "UTF-8").replace("+", "%s")
+
+internal fun isJSONNull(value: Any?): Boolean = value == null || value == JSONObject.NULL
+
+internal fun <T> jsonList(value: Any?, f: (Any?) -> T): List<T> {
+    if (isJSONNull(value)) {
+        return emptyList()
+    }
+    val a = value as JSONArray
+    return List(a.length()) { f(a.opt(it)) }
+}
+
+internal fun <T> jsonMap(value: Any?, f: (Any?) -> T): Map<String, T> {
+    if (isJSONNull(value)) {
+        return emptyMap()
+    }
+    val o = value as JSONObject
+    val result = LinkedHashMap<String, T>()
+    o.keys().forEach { result[it] = f(o.opt(it)) }
+    return result
+}
+
+internal fun readJSON(body: ByteArray): Any? {
+    if (body.isEmpty()) {
+        return null
+    }
+    return JSONTokener(String(body, Charsets.UTF_8)).nextValue()
+}
+
+internal fun toStr(value: Any?): String = when (value) {
+    is Boolean -> if (value) "true" else "false"
+    is List<*> -> value.joinToString(",") { toStr(it) }
+    is JSONEnum -> toStr(value.value)
+    else -> value.toString()
+}
+
+`
+
+const kotlinJSONRPCClientBase = `private val jsonRPCRequestID = AtomicLong()
+
+internal suspend fun jsonRPCCall(transport: Transport, path: String, method: String, params: JSONObject): JSONObject {
+    val request = JSONObject()
+        .put("jsonrpc", "2.0")
+        .put("id", jsonRPCRequestID.incrementAndGet())
+        .put("method", method)
+        .put("params", params)
+    val response = transport.request("POST", path, emptyList(), mapOf("Content-Type" to "application/json"), request.toString().toByteArray(Charsets.UTF_8))
+    val result = readJSON(response.body)
+    if (result !is JSONObject) {
+        throw UnknownException("invalid JSON-RPC response", response.status.toLong())
+    }
+    return result
+}
+
+`
+
+const kotlinRESTClientBase = `internal fun readErrorBody(body: ByteArray): JSONObject = try {
+    readJSON(body) as? JSONObject ?: JSONObject()
+} catch (e: Exception) {
+    JSONObject()
+}
+
+internal fun multipart(fields: List<Pair<String, Any?>>): Pair<String, ByteArray> {
+    val boundary = UUID.randomUUID().toString().replace("-", "")
+    val out = ByteArrayOutputStream()
+    for ((name, value) in fields) {
+        out.write("--$boundary\r\n".toByteArray(Charsets.UTF_8))
+        if (value is ByteArray) {
+            out.write("Content-Disposition: form-data; name=\"$name\"; filename=\"$name\"\r\n".toByteArray(Charsets.UTF_8))
+            out.write("Content-Type: application/octet-stream\r\n\r\n".toByteArray(Charsets.UTF_8))
+            out.write(value)
+        } else {
+            out.write("Content-Disposition: form-data; name=\"$name\"\r\n\r\n".toByteArray(Charsets.UTF_8))
+            out.write(toStr(value).toByteArray(Charsets.UTF_8))
+        }
+        out.write("\r\n".toByteArray(Charsets.UTF_8))
+    }
+    out.write("--$boundary--\r\n".toByteArray(Charsets.UTF_8))
+    return "multipart/form-data; boundary=$boundary" to out.toByteArray()
+}
+
+`
+
+type KotlinClientGenerator struct {
+	w             writer.GoWriter
+	JSONRPCEnable bool
+	ErrorFormat   string
+	Interfaces    []*config.Interface
+	MethodOptions map[string]config.MethodOptions
+	IfaceErrors   map[string]map[string][]config.Error
+	Output        string
+}
+
+func (g *KotlinClientGenerator) Generate(ctx context.Context) []byte {
+	g.w.W("package %s\n\n", strcase.ToSnake(filepath.Base(g.Output)))
+	g.w.W(kotlinClientBase, "%20")
+
+	if g.JSONRPCEnable {
+		g.w.W("%s", kotlinJSONRPCClientBase)
+	} else {
+		g.w.W("%s", kotlinRESTClientBase)
+	}
+
+	var defTypes []*option.NamedType
+	defTypesDub := map[string]struct{}{}
+	addDefTypes := func(i interface{}) {
+		for _, named := range extractNamed(i) {
+			if _, ok := defTypesDub[named.ID()]; ok {
+				continue
+			}
+			defTypesDub[named.ID()] = struct{}{}
+			if _, ok := ktExternalType(named); ok {
+				continue
+			}
+			if _, ok := named.Type.(*option.IfaceType); ok {
+				continue
+			}
+			if isFileUploadType(named) || plugin.IsFileDownloadType(named) {
+				continue
+			}
+			defTypes = append(defTypes, named)
+		}
+	}
+
+	for _, iface := range g.Interfaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+		for _, m := range ifaceType.Methods {
+			for _, p := range m.Sig.Params {
+				if !plugin.IsContext(p) {
+					addDefTypes(p.Type)
+				}
+			}
+			for _, r := range m.Sig.Results {
+				if !plugin.IsError(r) {
+					addDefTypes(r.Type)
+				}
+			}
+		}
+	}
+
+	for _, t := range defTypes {
+		g.w.W("%s", ktTypeDef(t))
+	}
+
+	for _, iface := range g.Interfaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+		for _, m := range ifaceType.Methods {
+			if plugin.LenWithoutErrors(m.Sig.Results) > 1 {
+				var fields []structField
+				for _, r := range m.Sig.Results {
+					if plugin.IsError(r) {
+						continue
+					}
+					fields = append(fields, structField{Name: r.Name.Value, Var: r})
+				}
+				g.w.W("%s", ktDataClass(NameResponse(m, iface), fields))
+			}
+		}
+	}
+
+	errorsDub := map[string]struct{}{}
+	for _, iface := range g.Interfaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+		for _, m := range ifaceType.Methods {
+			for _, e := range g.methodErrors(iface, m) {
+				errorName := jsErrorName(iface, e)
+				if _, ok := errorsDub[errorName]; ok {
+					continue
+				}
+				errorsDub[errorName] = struct{}{}
+				if g.JSONRPCEnable {
+					g.w.W("class %s(message: String, data: Any? = null) : APIException(message, %d, data)\n\n", errorName, e.Code)
+				} else {
+					g.w.W("class %s(message: String, data: Any? = null) : APIException(message, %d, data, %s)\n\n", errorName, e.Code, ktQuote(e.ErrCode))
+				}
+			}
+		}
+	}
+
+	for _, iface := range g.Interfaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+		for _, m := range ifaceType.Methods {
+			g.writeConvertError(iface, m)
+		}
+	}
+
+	clientName := "RESTClient"
+	if g.JSONRPCEnable {
+		clientName = "JSONRPCClient"
+	}
+
+	for _, iface := range g.Interfaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+
+		if g.JSONRPCEnable {
+			g.w.W("class %s%s(private val transport: Transport, private val path: String = \"\") {\n", clientName, UcNameJS(iface))
+		} else {
+			g.w.W("class %s%s(private val transport: Transport) {\n", clientName, UcNameJS(iface))
+		}
+
+		for i, m := range ifaceType.Methods {
+			if i > 0 {
+				g.w.W("\n")
+			}
+			doc := strings.TrimSpace(m.Comment)
+			for _, e := range g.methodErrors(iface, m) {
+				if doc != "" {
+					doc += "\n"
+				}
+				doc += "@throws " + jsErrorName(iface, e)
+			}
+			for _, line := range strings.Split(tsDoc(doc, false), "\n") {
+				if line != "" {
+					g.w.W("    %s\n", line)
+				}
+			}
+			if isDeprecated(iface, m) {
+				g.w.W("    @Deprecated(\"deprecated\")\n")
+			}
+			g.w.W("    suspend fun %s(", ktName(m.Name.Value))
+			var params []string
+			for _, p := range m.Sig.Params {
+				if plugin.IsContext(p) {
+					continue
+				}
+				if st, ok := p.Type.(*option.SliceType); ok && p.IsVariadic {
+					params = append(params, "vararg "+ktName(p.Name.Value)+": "+ktType(st.Value))
+				} else {
+					params = append(params, ktName(p.Name.Value)+": "+ktType(p.Type))
+				}
+			}
+			g.w.W("%s)", strings.Join(params, ", "))
+			if resultType := g.resultType(iface, m); resultType != "" {
+				g.w.W(": %s", resultType)
+			}
+			g.w.W(" {\n")
+
+			if g.JSONRPCEnable {
+				g.writeJSONRPCMethod(iface, m)
+			} else {
+				g.writeRESTMethod(iface, m)
+			}
+			g.w.W("    }\n")
+		}
+		g.w.W("}\n\n")
+	}
+
+	if len(g.Interfaces) > 1 {
+		g.w.W("class %s(transport: Transport) {\n", clientName)
+		for _, iface := range g.Interfaces {
+			g.w.W("    val %s = %s%s(transport)\n", ktName(LcNameJS(iface)), clientName, UcNameJS(iface))
+		}
+		g.w.W("}\n")
+	} else if len(g.Interfaces) == 1 {
+		g.w.W("typealias %[1]s = %[1]s%[2]s\n", clientName, UcNameJS(g.Interfaces[0]))
+	}
+	return g.w.Bytes()
+}
+
+func (g *KotlinClientGenerator) methodErrors(iface *config.Interface, m *option.FuncType) (result []config.Error) {
+	errorsDub := map[string]struct{}{}
+	for _, e := range g.IfaceErrors[iface.Named.Name.Value][m.Name.Value] {
+		errorName := jsErrorName(iface, e)
+		if _, ok := errorsDub[errorName]; ok {
+			continue
+		}
+		errorsDub[errorName] = struct{}{}
+		result = append(result, e)
+	}
+	return
+}
+
+func (g *KotlinClientGenerator) resultType(iface *config.Interface, m *option.FuncType) string {
+	resultsLen := plugin.LenWithoutErrors(m.Sig.Results)
+	if resultsLen == 0 {
+		return ""
+	}
+	if resultsLen > 1 {
+		return NameResponse(m, iface)
+	}
+	for _, r := range m.Sig.Results {
+		if !plugin.IsError(r) {
+			return ktType(r.Type)
+		}
+	}
+	return ""
+}
+
+func (g *KotlinClientGenerator) resultDecode(iface *config.Interface, m *option.FuncType, expr string) string {
+	if plugin.LenWithoutErrors(m.Sig.Results) > 1 {
+		return NameResponse(m, iface) + ".fromJSON(" + expr + " as JSONObject)"
+	}
+	for _, r := range m.Sig.Results {
+		if !plugin.IsError(r) {
+			return ktDecode(expr, r.Type, 0)
+		}
+	}
+	return expr
+}
+
+func (g *KotlinClientGenerator) writeConvertError(iface *config.Interface, m *option.FuncType) {
+	methodErrors := g.methodErrors(iface, m)
+	funcName := LcNameIfaceMethod(iface, m) + "ConvertError"
+
+	if g.JSONRPCEnable {
+		g.w.W("internal fun %s(e: JSONObject): APIException {\n", funcName)
+		g.w.W("    val code = e.optLong(\"code\")\n")
+		g.w.W("    val message = e.optString(\"message\")\n")
+		g.w.W("    val data = e.opt(\"data\")\n")
+		if len(methodErrors) > 0 {
+			g.w.W("    when (code) {\n")
+			for _, e := range methodErrors {
+				g.w.W("        %dL -> return %s(message, data)\n", e.Code, jsErrorName(iface, e))
+			}
+			g.w.W("    }\n")
+		}
+		g.w.W("    return UnknownException(%s + message, code, data)\n", ktQuote(LcNameIfaceMethod(iface, m)+": "))
+		g.w.W("}\n\n")
+		return
+	}
+
+	g.w.W("internal fun %s(status: Int, e: JSONObject): APIException {\n", funcName)
+	if g.ErrorFormat == "problem" {
+		g.w.W("    val message = e.optString(\"detail\").ifEmpty { e.optString(\"title\") }\n")
+	} else {
+		g.w.W("    val message = e.optString(\"error\")\n")
+	}
+	g.w.W("    val errCode = e.optString(\"code\")\n")
+	g.w.W("    val data = e.opt(\"data\")\n")
+	for _, e := range methodErrors {
+		g.w.W("    if (status == %d && errCode == %s) {\n", e.Code, ktQuote(e.ErrCode))
+		g.w.W("        return %s(message, data)\n", jsErrorName(iface, e))
+		g.w.W("    }\n")
+	}
+	g.w.W("    return UnknownException(%s + message, status.toLong(), data, errCode)\n", ktQuote(LcNameIfaceMethod(iface, m)+": "))
+	g.w.W("}\n\n")
+}
+
+func (g *KotlinClientGenerator) paramValue(p *option.VarType) string {
+	if p.IsVariadic {
+		return ktName(p.Name.Value) + ".toList()"
+	}
+	return ktName(p.Name.Value)
+}
+
+func (g *KotlinClientGenerator) paramsJSON(params []*option.VarType, indent string) string {
+	if len(params) == 0 {
+		return "JSONObject()"
+	}
+	result := "JSONObject().apply {\n"
+	for _, p := range params {
+		result += indent + "    put(" + ktQuote(p.Name.Value) + ", " + ktEncode(g.paramValue(p), p.Type, 0) + ")\n"
+	}
+	return result + indent + "}"
+}
+
+func (g *KotlinClientGenerator) writeJSONRPCMethod(iface *config.Interface, m *option.FuncType) {
+	var prefix string
+	if ns := rpcPrefix(iface); ns != "" {
+		prefix = ns + "."
+	}
+	var params []*option.VarType
+	for _, p := range m.Sig.Params {
+		if !plugin.IsContext(p) {
+			params = append(params, p)
+		}
+	}
+	g.w.W("        val response = jsonRPCCall(transport, path, %s, %s)\n", ktQuote(prefix+m.Name.Lower()), g.paramsJSON(params, "        "))
+	g.w.W("        val error = response.opt(\"error\")\n")
+	g.w.W("        if (error is JSONObject) {\n")
+	g.w.W("            throw %sConvertError(error)\n", LcNameIfaceMethod(iface, m))
+	g.w.W("        }\n")
+	if plugin.LenWithoutErrors(m.Sig.Results) > 0 {
+		g.w.W("        return %s\n", g.resultDecode(iface, m, "response.opt(\"result\")"))
+	}
+}
+
+func (g *KotlinClientGenerator) writeRESTMethod(iface *config.Interface, m *option.FuncType) {
+	mopt := g.MethodOptions[iface.Named.Name.Value+m.Name.Value]
+
+	httpMethod := strings.ToUpper(mopt.RESTMethod.Take())
+	if httpMethod == "" {
+		httpMethod = "GET"
+	}
+
+	bodyType := mopt.RESTBodyType.Take()
+	if bodyType == "" {
+		bodyType = "json"
+	}
+
+	var pathStr string
+	if mopt.RESTPath.IsValid() {
+		pathStr = mopt.RESTPath.Take()
+	} else {
+		pathStr = path.Join("/", strcase.ToKebab(m.Name.Value))
+	}
+	if prefix := restPrefix(iface, strcase.ToKebab(iface.Namespace)); prefix != "" {
+		pathStr = path.Join("/", prefix, "/", pathStr)
+	}
+
+	queryVars := make(map[string]string, len(mopt.RESTQueryVars.Value))
+	for i := 0; i < len(mopt.RESTQueryVars.Value); i += 2 {
+		queryVars[mopt.RESTQueryVars.Value[i+1]] = strings.TrimPrefix(mopt.RESTQueryVars.Value[i], "!")
+	}
+	headerVars := make(map[string]string, len(mopt.RESTHeaderVars.Value))
+	for i := 0; i < len(mopt.RESTHeaderVars.Value); i += 2 {
+		headerVars[mopt.RESTHeaderVars.Value[i+1]] = mopt.RESTHeaderVars.Value[i]
+	}
+
+	var (
+		queryParams  []*option.VarType
+		headerParams []*option.VarType
+		bodyParams   []*option.VarType
+		allParams    []*option.VarType
+	)
+	for _, p := range m.Sig.Params {
+		if plugin.IsContext(p) {
+			continue
+		}
+		allParams = append(allParams, p)
+		if _, ok := mopt.RESTPathVars[p.Name.Value]; ok {
+			continue
+		} else if _, ok := queryVars[p.Name.Value]; ok {
+			queryParams = append(queryParams, p)
+		} else if _, ok := headerVars[p.Name.Value]; ok {
+			headerParams = append(headerParams, p)
+		} else {
+			bodyParams = append(bodyParams, p)
+		}
+	}
+
+	var pathParts []string
+	var last int
+	for _, loc := range fetchPathVarRegexp.FindAllStringSubmatchIndex(pathStr, -1) {
+		name := pathStr[loc[2]:loc[3]]
+		if _, ok := mopt.RESTPathVars[name]; !ok {
+			continue
+		}
+		if loc[0] > last {
+			pathParts = append(pathParts, ktQuote(pathStr[last:loc[0]]))
+		}
+		pathParts = append(pathParts, "urlEncode(toStr("+ktName(name)+"))")
+		last = loc[1]
+	}
+	if last < len(pathStr) || len(pathParts) == 0 {
+		pathParts = append(pathParts, ktQuote(pathStr[last:]))
+	}
+
+	optionalValue := func(p *option.VarType, fn func(indent string)) {
+		if plugin.IsPointer(p.Type) {
+			g.w.W("        if (%s != null) {\n", ktName(p.Name.Value))
+			fn("            ")
+			g.w.W("        }\n")
+			return
+		}
+		fn("        ")
+	}
+
+	g.w.W("        val _query = mutableListOf<Pair<String, String>>()\n")
+	for _, p := range queryParams {
+		optionalValue(p, func(indent string) {
+			g.w.W("%s_query.add(%s to toStr(%s))\n", indent, ktQuote(queryVars[p.Name.Value]), g.paramValue(p))
+		})
+	}
+	for i := 0; i < len(mopt.RESTQueryValues.Value); i += 2 {
+		g.w.W("        _query.add(%s to %s)\n", ktQuote(mopt.RESTQueryValues.Value[i]), ktQuote(mopt.RESTQueryValues.Value[i+1]))
+	}
+
+	g.w.W("        val _headers = mutableMapOf<String, String>()\n")
+	for _, p := range headerParams {
+		optionalValue(p, func(indent string) {
+			g.w.W("%s_headers[%s] = toStr(%s)\n", indent, ktQuote(headerVars[p.Name.Value]), g.paramValue(p))
+		})
+	}
+
+	g.w.W("        var _body: ByteArray? = null\n")
+	switch httpMethod {
+	case "POST", "PUT", "PATCH":
+		if len(allParams) == 0 {
+			break
+		}
+		switch bodyType {
+		case "json":
+			reqData := g.paramsJSON(allParams, "        ")
+			if wrapRequest := mopt.RESTWrapRequest.Take(); wrapRequest != "" {
+				parts := strings.Split(wrapRequest, ".")
+				for i := len(parts) - 1; i >= 0; i-- {
+					reqData = "JSONObject().put(" + ktQuote(parts[i]) + ", " + reqData + ")"
+				}
+			}
+			g.w.W("        _headers[\"Content-Type\"] = \"application/json\"\n")
+			g.w.W("        _body = %s.toString().toByteArray(Charsets.UTF_8)\n", reqData)
+		case "urlencoded":
+			g.w.W("        val _form = mutableListOf<String>()\n")
+			for _, p := range bodyParams {
+				optionalValue(p, func(indent string) {
+					g.w.W("%s_form.add(urlEncode(%s) + \"=\" + urlEncode(toStr(%s)))\n", indent, ktQuote(p.Name.Value), g.paramValue(p))
+				})
+			}
+			g.w.W("        _headers[\"Content-Type\"] = \"application/x-www-form-urlencoded; charset=utf-8\"\n")
+			g.w.W("        _body = _form.joinToString(\"&\").toByteArray(Charsets.UTF_8)\n")
+		case "multipart":
+			g.w.W("        val _fields = mutableListOf<Pair<String, Any?>>()\n")
+			for _, p := range bodyParams {
+				optionalValue(p, func(indent string) {
+					g.w.W("%s_fields.add(%s to %s)\n", indent, ktQuote(p.Name.Value), g.paramValue(p))
+				})
+			}
+			g.w.W("        val (_contentType, _data) = multipart(_fields)\n")
+			g.w.W("        _headers[\"Content-Type\"] = _contentType\n")
+			g.w.W("        _body = _data\n")
+		}
+	}
+
+	g.w.W("        val _response = transport.request(%s, %s, _query, _headers, _body)\n", ktQuote(httpMethod), strings.Join(pathParts, " + "))
+	g.w.W("        if (_response.status > 299) {\n")
+	g.w.W("            throw %sConvertError(_response.status, readErrorBody(_response.body))\n", LcNameIfaceMethod(iface, m))
+	g.w.W("        }\n")
+
+	switch {
+	case plugin.LenWithoutErrors(m.Sig.Results) == 0:
+	case plugin.DownloadFile(m.Sig.Results) != nil:
+		g.w.W("        return _response.body\n")
+	default:
+		dataExpr := "readJSON(_response.body)"
+		if wrapResponse := mopt.RESTWrapResponse.Take(); wrapResponse != "" {
+			for _, part := range strings.Split(wrapResponse, ".") {
+				dataExpr = fmt.Sprintf("(%s as? JSONObject)?.opt(%s)", dataExpr, ktQuote(part))
+			}
+		}
+		g.w.W("        return %s\n", g.resultDecode(iface, m, dataExpr))
+	}
+}
+
+func (g *KotlinClientGenerator) OutputPath() string {
+	return g.Output
+}
+
+func (g *KotlinClientGenerator) Filename() string {
+	return "client.kt"
+}
@@ -0,0 +1,176 @@
+package generator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/swipe-io/strcase"
+	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/config"
+	"github.com/swipe-io/swipe/v3/option"
+	"github.com/swipe-io/swipe/v3/swipe"
+	"github.com/swipe-io/swipe/v3/writer"
+)
+
+type MockGenerator struct {
+	w          writer.GoWriter
+	Interfaces []*config.Interface
+	Output     string
+	Pkg        string
+}
+
+func (g *MockGenerator) Generate(ctx context.Context) []byte {
+	importer := ctx.Value(swipe.ImporterKey).(swipe.Importer)
+
+	if len(g.Interfaces) == 0 {
+		return g.w.Bytes()
+	}
+
+	syncPkg := importer.Import("sync", "sync")
+
+	g.w.W("// MockT is the part of testing.TB used by the mock assertions.\n")
+	g.w.W("type MockT interface {\n")
+	g.w.W("Helper()\n")
+	g.w.W("Errorf(format string, args ...interface{})\n")
+	g.w.W("}\n\n")
+
+	for _, iface := range g.Interfaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+		mockName := "Mock" + UcNameWithAppPrefix(iface)
+
+		g.w.W("var _ %s = (*%s)(nil)\n\n", swipe.TypeString(iface.Named, false, importer), mockName)
+
+		g.w.W("// %s is a mock implementation of %s, it is safe for concurrent use.\n", mockName, iface.Named.Name.Value)
+		g.w.W("// A method without a stub returns zero values.\n")
+		g.w.W("type %s struct {\n", mockName)
+		g.w.W("mu %s.Mutex\n", syncPkg)
+		for _, m := range ifaceType.Methods {
+			g.w.W("%sFunc func%s\n", m.Name.Lower(), swipe.TypeString(m.Sig, false, importer))
+			g.w.W("%sCalls []%s\n", m.Name.Lower(), g.callName(iface, m))
+		}
+		g.w.W("}\n\n")
+
+		for _, m := range ifaceType.Methods {
+			callName := g.callName(iface, m)
+			funcType := "func" + swipe.TypeString(m.Sig, false, importer)
+			params := mockParams(m.Sig.Params)
+
+			g.w.W("// %s holds the arguments of a %s call.\n", callName, m.Name.Value)
+			g.w.W("type %s struct {\n", callName)
+			for _, p := range params {
+				g.w.W("%s %s\n", strcase.ToCamel(p.Name.Value), swipe.TypeString(p.Type, false, importer))
+			}
+			g.w.W("}\n\n")
+
+			sig := *m.Sig
+			sig.Params = params
+			g.w.W("func (_m *%s) %s%s {\n", mockName, m.Name.Value, swipe.TypeString(&sig, false, importer))
+			g.w.W("_m.mu.Lock()\n")
+			g.w.W("_m.%sCalls = append(_m.%sCalls, %s{", m.Name.Lower(), m.Name.Lower(), callName)
+			for i, p := range params {
+				if i > 0 {
+					g.w.W(", ")
+				}
+				g.w.W("%s: %s", strcase.ToCamel(p.Name.Value), p.Name.Value)
+			}
+			g.w.W("})\n")
+			g.w.W("_fn := _m.%sFunc\n", m.Name.Lower())
+			g.w.W("_m.mu.Unlock()\n")
+			g.w.W("if _fn == nil {\n")
+			g.w.W("return\n")
+			g.w.W("}\n")
+			if len(m.Sig.Results) > 0 {
+				g.w.W("return ")
+			}
+			g.w.W("_fn(")
+			for i, p := range params {
+				if i > 0 {
+					g.w.W(", ")
+				}
+				g.w.W(p.Name.Value)
+				if p.IsVariadic {
+					g.w.W("...")
+				}
+			}
+			g.w.W(")\n")
+			if len(m.Sig.Results) == 0 {
+				g.w.W("return\n")
+			}
+			g.w.W("}\n\n")
+
+			g.w.W("// On%[2]s sets the stub called by %[2]s.\n", mockName, m.Name.Value)
+			g.w.W("func (_m *%s) On%s(fn %s) {\n", mockName, m.Name.Value, funcType)
+			g.w.W("_m.mu.Lock()\n")
+			g.w.W("_m.%sFunc = fn\n", m.Name.Lower())
+			g.w.W("_m.mu.Unlock()\n")
+			g.w.W("}\n\n")
+
+			g.w.W("// Calls%[2]s returns the arguments of the %[2]s calls in the order they were made.\n", mockName, m.Name.Value)
+			g.w.W("func (_m *%s) Calls%s() []%s {\n", mockName, m.Name.Value, callName)
+			g.w.W("_m.mu.Lock()\n")
+			g.w.W("defer _m.mu.Unlock()\n")
+			g.w.W("return append([]%s(nil), _m.%sCalls...)\n", callName, m.Name.Lower())
+			g.w.W("}\n\n")
+
+			g.w.W("// Assert%[2]sCalled reports an error to t if %[2]s was not called exactly n times.\n", mockName, m.Name.Value)
+			g.w.W("func (_m *%s) Assert%sCalled(t MockT, n int) bool {\n", mockName, m.Name.Value)
+			g.w.W("t.Helper()\n")
+			g.w.W("_m.mu.Lock()\n")
+			g.w.W("got := len(_m.%sCalls)\n", m.Name.Lower())
+			g.w.W("_m.mu.Unlock()\n")
+			g.w.W("if got != n {\n")
+			g.w.W("t.Errorf(\"%s.%s: expected %%d calls, got %%d\", n, got)\n", mockName, m.Name.Value)
+			g.w.W("return false\n")
+			g.w.W("}\n")
+			g.w.W("return true\n")
+			g.w.W("}\n\n")
+		}
+
+		g.w.W("// Reset clears the recorded calls, the stubs are kept.\n")
+		g.w.W("func (_m *%s) Reset() {\n", mockName)
+		g.w.W("_m.mu.Lock()\n")
+		for _, m := range ifaceType.Methods {
+			g.w.W("_m.%sCalls = nil\n", m.Name.Lower())
+		}
+		g.w.W("_m.mu.Unlock()\n")
+		g.w.W("}\n\n")
+	}
+	return g.w.Bytes()
+}
+
+// MockMethods returns the names of the mock methods generated for the interface method m,
+// the interface methods must not use them.
+func MockMethods(m *option.FuncType) []string {
+	return []string{"On" + m.Name.Value, "Calls" + m.Name.Value, "Assert" + m.Name.Value + "Called"}
+}
+
+// mockParams names the params the mock method can not refer to,
+// the names of the receiver and the stub local are taken as well.
+func mockParams(params option.VarsType) option.VarsType {
+	result := make(option.VarsType, 0, len(params))
+	for i, p := range params {
+		switch p.Name.Value {
+		case "", "_", "_m", "_fn":
+			v := *p
+			v.Name = option.String{Value: fmt.Sprintf("arg%d", i)}
+			p = &v
+		}
+		result = append(result, p)
+	}
+	return result
+}
+
+func (g *MockGenerator) callName(iface *config.Interface, m *option.FuncType) string {
+	return "Mock" + UcNameWithAppPrefix(iface) + m.Name.Upper() + "Call"
+}
+
+func (g *MockGenerator) Package() string {
+	return g.Pkg
+}
+
+func (g *MockGenerator) OutputPath() string {
+	return g.Output
+}
+
+func (g *MockGenerator) Filename() string {
+	return "mock.go"
+}
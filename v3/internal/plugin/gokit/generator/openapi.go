@@ -15,36 +15,33 @@ import (
 	"github.com/swipe-io/swipe/v3/internal/plugin"
 	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/config"
 	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/openapi"
+	"github.com/swipe-io/swipe/v3/internal/validation"
 	"github.com/swipe-io/swipe/v3/option"
 )
 
 type Openapi struct {
-	JSONRPCEnable bool
-	Contact       config.OpenapiContact
-	Info          config.OpenapiInfo
-	MethodTags    map[string][]string
-	Servers       []config.OpenapiServer
-	Licence       config.OpenapiLicence
-	Output        string
-	Interfaces    []*config.Interface
-	MethodOptions map[string]config.MethodOptions
-	IfaceErrors   map[string]map[string][]config.Error
-	defTypes      map[string]*option.NamedType
+	JSONRPCEnable    bool
+	ValidationEnable bool
+	Contact          config.OpenapiContact
+	Info             config.OpenapiInfo
+	MethodTags       map[string][]string
+	Servers          []config.OpenapiServer
+	Licence          config.OpenapiLicence
+	Output           string
+	Interfaces       []*config.Interface
+	MethodOptions    map[string]config.MethodOptions
+	IfaceErrors      map[string]map[string][]config.Error
+	RESTErrorFormat  string
+	Codecs           *config.CodecsEnable
+	Version          string
+	APIVersion       string
+	Webhooks         []config.OpenapiWebhook
+	defTypes         map[string]*option.NamedType
 }
 
 func (g *Openapi) Generate(ctx context.Context) []byte {
 	g.defTypes = make(map[string]*option.NamedType, 1024)
 
-	version := ""
-	switch t := g.Info.Version.(type) {
-	case string:
-		version = t
-	case *option.NamedType:
-		if c, ok := t.Obj.(*types.Const); ok {
-			version, _ = strconv.Unquote(c.Val().String())
-		}
-	}
-
 	o := openapi.OpenAPI{
 		OpenAPI: "3.0.0",
 		Info: openapi.Info{
@@ -60,13 +57,17 @@ func (g *Openapi) Generate(ctx context.Context) []byte {
 				Name: g.Licence.Name,
 				URL:  g.Licence.Url,
 			},
-			Version: version,
+			Version: openapiInfoVersion(g.Info),
 		},
 		Paths: map[string]*openapi.Path{},
 		Components: openapi.Components{
 			Schemas: openapi.Schemas{},
 		},
 	}
+	// the interface version is the document version when the info version is not set
+	if o.Info.Version == "" {
+		o.Info.Version = g.APIVersion
+	}
 	if g.JSONRPCEnable {
 		o.Components.Schemas = getOpenapiJSONRPCErrorSchemas()
 	}
@@ -93,10 +94,10 @@ func (g *Openapi) Generate(ctx context.Context) []byte {
 			tags := g.MethodTags[iface.Named.Name.Value+m.Name.Value]
 
 			if g.JSONRPCEnable {
-				op = g.makeJSONRPCPath(m, iface.Namespace, mopt)
+				op = g.makeJSONRPCPath(m, rpcPrefix(iface), mopt)
 				pathStr = "/" + m.Name.Lower()
-				if iface.Namespace != "" {
-					pathStr = "/" + iface.Namespace + "." + m.Name.Lower()
+				if prefix := rpcPrefix(iface); prefix != "" {
+					pathStr = "/" + prefix + "." + m.Name.Lower()
 				}
 				httpMethodName = "POST"
 			} else {
@@ -115,9 +116,7 @@ func (g *Openapi) Generate(ctx context.Context) []byte {
 						pathStr = stdstrings.Replace(pathStr, ":"+regexp, "", -1)
 					}
 				}
-				if iface.Namespace != "" {
-					pathStr = path.Join(iface.Namespace, pathStr)
-				}
+				pathStr = path.Join("/", restPrefix(iface, iface.Namespace), pathStr)
 			}
 
 			if methodErrors, ok := g.IfaceErrors[iface.Named.Name.Value]; ok {
@@ -139,7 +138,7 @@ func (g *Openapi) Generate(ctx context.Context) []byte {
 							errResponse.Description = e.Name
 						} else {
 							errResponse.Description = http.StatusText(int(e.Code))
-							o.Components.Schemas[e.Name] = makeOpenapiSchemaRESTError(e.ErrCode)
+							g.fillRESTErrorResponse(&o, errResponse, e.Name, e.ErrCode)
 						}
 
 						op.Responses[codeStr] = errResponse
@@ -147,6 +146,28 @@ func (g *Openapi) Generate(ctx context.Context) []byte {
 				}
 			}
 
+			if mopt.RateLimit != nil {
+				codeStr := strconv.FormatInt(http.StatusTooManyRequests, 10)
+				errResponse := &openapi.Response{
+					Content: openapi.Content{
+						"application/json": {
+							Schema: &openapi.Schema{
+								Ref: "#/components/schemas/RateLimitError",
+							},
+						},
+					},
+				}
+				if g.JSONRPCEnable {
+					codeStr = "x" + strconv.FormatInt(rateLimitErrorCode, 10)
+					o.Components.Schemas["RateLimitError"] = makeOpenapiSchemaJRPCError(rateLimitErrorCode)
+					errResponse.Description = "RateLimitError"
+				} else {
+					errResponse.Description = http.StatusText(http.StatusTooManyRequests)
+					g.fillRESTErrorResponse(&o, errResponse, "RateLimitError", "rate_limit_exceeded")
+				}
+				op.Responses[codeStr] = errResponse
+			}
+
 			ifaceTag := iface.Named.Name.Upper()
 			if iface.Namespace != "" {
 				ifaceTag = iface.Namespace
@@ -154,7 +175,31 @@ func (g *Openapi) Generate(ctx context.Context) []byte {
 			tags = append(tags, ifaceTag)
 
 			op.Description = m.Comment
+			op.Deprecated = isDeprecated(iface, m)
 			op.Tags = tags
+			if d, ok := iface.DeprecatedMethods[m.Name.Value]; ok && !g.JSONRPCEnable {
+				for code, response := range op.Responses {
+					if !stdstrings.HasPrefix(code, "2") {
+						continue
+					}
+					if response.Headers == nil {
+						response.Headers = map[string]*openapi.Header{}
+					}
+					for name, h := range deprecationOpenapiHeaders(d) {
+						response.Headers[name] = h
+					}
+				}
+			}
+
+			if mopt.BearerAuth != nil {
+				op.Security = append(op.Security, openapi.Security{"bearerAuth": []string{}})
+			}
+			if mopt.APIKeyAuth != nil {
+				op.Security = append(op.Security, openapi.Security{apiKeySchemeName(*mopt.APIKeyAuth): []string{}})
+			}
+			if mopt.BasicAuth != nil {
+				op.Security = append(op.Security, openapi.Security{"basicAuth": []string{}})
+			}
 
 			if _, ok := o.Paths[pathStr]; !ok {
 				o.Paths[pathStr] = &openapi.Path{}
@@ -175,8 +220,47 @@ func (g *Openapi) Generate(ctx context.Context) []byte {
 		}
 	}
 
+	if authSchemes := makeAuthSchemes(g.Interfaces, g.MethodOptions); authSchemes.enabled() {
+		o.Components.SecuritySchemes = map[string]openapi.SecurityScheme{}
+		if authSchemes.bearer {
+			o.Components.SecuritySchemes["bearerAuth"] = openapi.SecurityScheme{
+				Type:         "http",
+				Scheme:       "bearer",
+				BearerFormat: "JWT",
+			}
+		}
+		if authSchemes.basic {
+			o.Components.SecuritySchemes["basicAuth"] = openapi.SecurityScheme{
+				Type:   "http",
+				Scheme: "basic",
+			}
+		}
+		for _, a := range authSchemes.apiKeys {
+			o.Components.SecuritySchemes[apiKeySchemeName(a)] = openapi.SecurityScheme{
+				Type: "apiKey",
+				In:   a.In,
+				Name: a.Name,
+			}
+		}
+	}
+
+	if len(g.Webhooks) > 0 {
+		o.Webhooks = map[string]*openapi.Path{}
+		for _, w := range g.Webhooks {
+			m := webhookMethod(w.Method)
+			op := g.makeRestPath(m, config.MethodOptions{RESTMethod: option.ExprStringValue{Value: "POST"}})
+			op.Description = m.Comment
+			op.Deprecated = m.Deprecated
+			o.Webhooks[w.Name] = &openapi.Path{Post: op}
+		}
+	}
+
 	for _, namedType := range g.defTypes {
-		o.Components.Schemas[namedType.Name.Value] = g.schemaByType(namedType.Type)
+		o.Components.Schemas[namedType.Name.Value] = g.namedTypeSchema(namedType)
+	}
+
+	if g.Version == "3.1" {
+		o.UpgradeTo31()
 	}
 
 	data, _ := ffjson.Marshal(o)
@@ -188,6 +272,9 @@ func (g *Openapi) OutputPath() string {
 }
 
 func (g *Openapi) Filename() string {
+	if g.APIVersion != "" {
+		return "openapi_" + g.APIVersion + ".json"
+	}
 	return "openapi.json"
 }
 
@@ -233,6 +320,9 @@ func (g *Openapi) fillTypeDef(t interface{}) {
 }
 
 func (g *Openapi) schemaByTypeRecursive(schema *openapi.Schema, t interface{}) {
+	if g.Version == "3.1" && plugin.IsPointer(t) {
+		schema.Nullable = true
+	}
 	switch t := t.(type) {
 	case *option.NamedType:
 		switch t.Pkg.Path {
@@ -281,8 +371,19 @@ func (g *Openapi) schemaByTypeRecursive(schema *openapi.Schema, t interface{}) {
 				Properties: openapi.Properties{},
 			}
 			filedSchema.Description = field.Var.Comment
+			filedSchema.Deprecated = field.Var.Deprecated
 			schema.Properties[name] = filedSchema
 			g.schemaByTypeRecursive(filedSchema, field.Var.Type)
+			if field.Var.Example != "" {
+				filedSchema.Example = exampleValue(field.Var.Example)
+			}
+			if g.ValidationEnable {
+				c := validation.MakeConstraints(field.Var.Type, validation.FieldRules(field))
+				if c.Required {
+					schema.Required = append(schema.Required, name)
+				}
+				applyConstraints(filedSchema, c)
+			}
 		}
 	case *option.MapType:
 		mapSchema := &openapi.Schema{
@@ -312,6 +413,18 @@ func (g *Openapi) schemaByTypeRecursive(schema *openapi.Schema, t interface{}) {
 		}
 		return
 	case *option.IfaceType:
+		if g.Version == "3.1" {
+			schema.Description = "Can be any value - string, number, boolean, array, object or null."
+			schema.OneOf = []openapi.Schema{
+				{Type: "string", Example: "abc"},
+				{Type: "number", Example: 1.11},
+				{Type: "boolean", Example: true},
+				{Type: "array"},
+				{Type: "object"},
+				{Type: "null"},
+			}
+			return
+		}
 		schema.Type = "object"
 		schema.Description = "Can be any value - string, number, boolean, array or object."
 		schema.Properties = openapi.Properties{}
@@ -361,6 +474,18 @@ func (g *Openapi) schemaByTypeRecursive(schema *openapi.Schema, t interface{}) {
 	}
 }
 
+func (g *Openapi) namedTypeSchema(namedType *option.NamedType) *openapi.Schema {
+	schema := g.schemaByType(namedType.Type)
+	if len(namedType.Enums) > 0 {
+		schema.Enum = make([]interface{}, 0, len(namedType.Enums))
+		for _, e := range namedType.Enums {
+			schema.Enum = append(schema.Enum, e.Value)
+		}
+		schema.Example = schema.Enum[0]
+	}
+	return schema
+}
+
 func (g *Openapi) schemaByType(t interface{}) (schema *openapi.Schema) {
 	schema = &openapi.Schema{
 		Properties: openapi.Properties{},
@@ -388,13 +513,26 @@ func (g *Openapi) makeJSONRPCPath(m *option.FuncType, prefix string, mopt config
 
 			schema := g.schemaByType(p.Type)
 			schema.Description = p.Comment
+			schema.Deprecated = p.Deprecated
 			requestSchema.Properties[p.Name.Lower()] = schema
+
+			if mopt.Validation.Take() {
+				c := validation.MakeConstraints(p.Type, validation.ParamRules(p))
+				if c.Required {
+					requestSchema.Required = append(requestSchema.Required, p.Name.Lower())
+				}
+				schema.Description = validation.TrimParamRules(p.Comment)
+				applyConstraints(schema, c)
+			}
 		}
 	} else {
 		requestSchema.Type = "object"
 		requestSchema.Nullable = true
 		requestSchema.Example = json.RawMessage("null")
 	}
+	if m.Example != "" {
+		requestSchema.Example = exampleValue(m.Example)
+	}
 
 	lenResults := plugin.LenWithoutErrors(m.Sig.Results)
 
@@ -460,13 +598,14 @@ func (g *Openapi) makeJSONRPCPath(m *option.FuncType, prefix string, mopt config
 			},
 			"method": &openapi.Schema{
 				Type: "string",
-				Enum: []string{restMethod},
+				Enum: []interface{}{restMethod},
 			},
 			"params": requestSchema,
 		},
 	}
 
 	return &openapi.Operation{
+		Summary: operationSummary(m),
 		RequestBody: &openapi.RequestBody{
 			Required: true,
 			Content: map[string]openapi.Media{
@@ -585,7 +724,17 @@ func (g *Openapi) makeRestPath(m *option.FuncType, mopt config.MethodOptions) *o
 		g.fillTypeDef(p.Type)
 		schema := g.schemaByType(p.Type)
 		schema.Description = p.Comment
+		schema.Deprecated = p.Deprecated
 		requestSchema.Properties[p.Name.Lower()] = schema
+
+		if mopt.Validation.Take() {
+			c := validation.MakeConstraints(p.Type, validation.ParamRules(p))
+			if c.Required {
+				requestSchema.Required = append(requestSchema.Required, p.Name.Lower())
+			}
+			schema.Description = validation.TrimParamRules(p.Comment)
+			applyConstraints(schema, c)
+		}
 	}
 
 	lenResults := plugin.LenWithoutErrors(m.Sig.Results)
@@ -640,64 +789,175 @@ func (g *Openapi) makeRestPath(m *option.FuncType, mopt config.MethodOptions) *o
 		} else {
 			responses["200"] = &openapi.Response{
 				Description: "OK",
-				Content: openapi.Content{
-					"application/json": {
-						Schema: responseSchema,
-					},
-				},
+				Content:     g.codecContent(responseSchema, mopt),
 			}
 		}
 
 	}
 
 	o := &openapi.Operation{
-		Summary:   m.Name.Value,
+		Summary:   operationSummary(m),
 		Responses: responses,
 	}
 
 	for _, pathVar := range pathVars {
-		o.Parameters = append(o.Parameters, openapi.Parameter{
-			In:          "path",
-			Name:        pathVar.Param.Name.Lower(),
-			Description: pathVar.Param.Comment,
-			Required:    pathVar.IsRequired,
-			Schema:      g.schemaByType(pathVar.Param.Type),
-		})
+		o.Parameters = append(o.Parameters, g.makeParameter("path", pathVar.Param.Name.Lower(), pathVar, g.schemaByType(pathVar.Param.Type), mopt))
 	}
 
 	for _, headerVar := range headerVars {
-		o.Parameters = append(o.Parameters, openapi.Parameter{
-			In:          "header",
-			Name:        headerVar.Value,
-			Description: headerVar.Param.Comment,
-			Required:    headerVar.IsRequired,
-			Schema:      g.schemaByType(headerVar.Param.Type),
-		})
+		o.Parameters = append(o.Parameters, g.makeParameter("header", headerVar.Value, headerVar, g.schemaByType(headerVar.Param.Type), mopt))
 	}
+	if mopt.Idempotent != nil {
+		o.Parameters = append(o.Parameters, idempotencyOpenapiParameter())
+	}
+
+	pagination, paginated := makePagination(m, mopt)
 
 	for _, queryVar := range queryVars {
-		o.Parameters = append(o.Parameters, openapi.Parameter{
-			In:          "query",
-			Name:        queryVar.Param.Name.Lower(),
-			Description: queryVar.Param.Comment,
-			Required:    queryVar.IsRequired,
-			Schema: &openapi.Schema{
-				Type:       "string",
-				Properties: openapi.Properties{},
-			},
-		})
+		schema := &openapi.Schema{
+			Type:       "string",
+			Properties: openapi.Properties{},
+		}
+		parameter := g.makeParameter("query", queryVar.Param.Name.Lower(), queryVar, schema, mopt)
+		if paginated {
+			if schemaType, description, ok := paginationOpenapiParameter(queryVar.Param, pagination); ok {
+				schema.Type = schemaType
+				if parameter.Description == "" {
+					parameter.Description = description
+				}
+			}
+		}
+		o.Parameters = append(o.Parameters, parameter)
+	}
+
+	if response, ok := responses["200"]; ok && paginated {
+		response.Headers = paginationOpenapiHeaders(pagination)
+	}
+
+	if m.Example != "" {
+		requestSchema.Example = exampleValue(m.Example)
 	}
 
 	switch mopt.RESTMethod.Take() {
 	case "POST", "PUT", "PATCH":
 		o.RequestBody = &openapi.RequestBody{
 			Required: true,
-			Content: map[string]openapi.Media{
-				"application/json": {
-					Schema: requestSchema,
-				},
-			},
+			Content:  g.codecContent(requestSchema, mopt),
 		}
 	}
 	return o
 }
+
+// codecContent returns the content with the schema for the media types of the enabled codecs,
+// the custom codec of the method is added by its media type.
+func (g *Openapi) codecContent(schema *openapi.Schema, mopt config.MethodOptions) openapi.Content {
+	content := openapi.Content{
+		"application/json": {
+			Schema: schema,
+		},
+	}
+	if g.Codecs == nil {
+		return content
+	}
+	for _, name := range g.Codecs.Codecs {
+		content[CodecMediaTypes[name]] = openapi.Media{Schema: schema}
+	}
+	content[codecMediaType(mopt)] = openapi.Media{Schema: schema}
+	return content
+}
+
+func (g *Openapi) makeParameter(in, name string, v plugin.VarType, schema *openapi.Schema, mopt config.MethodOptions) openapi.Parameter {
+	parameter := openapi.Parameter{
+		In:          in,
+		Name:        name,
+		Description: v.Param.Comment,
+		Required:    v.IsRequired,
+		Deprecated:  v.Param.Deprecated,
+		Schema:      schema,
+	}
+	if mopt.Validation.Take() {
+		c := validation.MakeConstraints(v.Param.Type, validation.ParamRules(v.Param))
+		parameter.Description = validation.TrimParamRules(v.Param.Comment)
+		parameter.Required = parameter.Required || c.Required
+		applyConstraints(schema, c)
+	}
+	return parameter
+}
+
+// webhookMethod returns the interface method with its comment, the signature is used when the receiver is not an interface.
+func webhookMethod(named *option.NamedType) *option.FuncType {
+	sig := named.Type.(*option.SignType)
+	if recv, ok := sig.Recv.(*option.NamedType); ok {
+		if ifaceType, ok := recv.Type.(*option.IfaceType); ok {
+			for _, m := range ifaceType.Methods {
+				if m.Name.Value == named.Name.Value {
+					return m
+				}
+			}
+		}
+	}
+	return &option.FuncType{Name: named.Name, Sig: sig}
+}
+
+// fillRESTErrorResponse adds the error schema to the components, problem details are sent as application/problem+json.
+func (g *Openapi) fillRESTErrorResponse(o *openapi.OpenAPI, response *openapi.Response, name, errCode string) {
+	if g.RESTErrorFormat == "problem" {
+		response.Content = openapi.Content{"application/problem+json": response.Content["application/json"]}
+		o.Components.Schemas[name] = makeOpenapiSchemaRESTProblem(errCode)
+		return
+	}
+	o.Components.Schemas[name] = makeOpenapiSchemaRESTError(errCode)
+}
+
+func openapiInfoVersion(info config.OpenapiInfo) (version string) {
+	switch t := info.Version.(type) {
+	case string:
+		version = t
+	case *option.NamedType:
+		if c, ok := t.Obj.(*types.Const); ok {
+			version, _ = strconv.Unquote(c.Val().String())
+		}
+	}
+	return
+}
+
+// operationSummary returns the first sentence of the method comment.
+func operationSummary(m *option.FuncType) string {
+	if m.Comment == "" || stdstrings.HasPrefix(m.Comment, "Deprecated:") {
+		return m.Name.Value
+	}
+	if i := stdstrings.Index(m.Comment, ". "); i >= 0 {
+		return m.Comment[:i+1]
+	}
+	return m.Comment
+}
+
+// exampleValue decodes the JSON literal of an @example block, other text is used as a string example.
+func exampleValue(s string) interface{} {
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return s
+	}
+	return v
+}
+
+func applyConstraints(schema *openapi.Schema, c validation.Constraints) {
+	if schema.Ref != "" {
+		return
+	}
+	if c.Format != "" {
+		schema.Format = c.Format
+	}
+	if len(c.Enum) > 0 {
+		schema.Enum = make([]interface{}, 0, len(c.Enum))
+		for _, v := range c.Enum {
+			schema.Enum = append(schema.Enum, v)
+		}
+	}
+	schema.MinLength = c.MinLength
+	schema.MaxLength = c.MaxLength
+	schema.Minimum = c.Minimum
+	schema.Maximum = c.Maximum
+	schema.MinItems = c.MinItems
+	schema.MaxItems = c.MaxItems
+}
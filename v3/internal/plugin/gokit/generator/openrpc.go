@@ -0,0 +1,202 @@
+package generator
+
+import (
+	"context"
+
+	"github.com/pquerna/ffjson/ffjson"
+
+	"github.com/swipe-io/swipe/v3/internal/plugin"
+	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/config"
+	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/openapi"
+	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/openrpc"
+	"github.com/swipe-io/swipe/v3/internal/validation"
+	"github.com/swipe-io/swipe/v3/option"
+)
+
+const openRPCVersion = "1.2.6"
+
+type OpenRPC struct {
+	AppName          string
+	ValidationEnable bool
+	Info             config.OpenapiInfo
+	Contact          config.OpenapiContact
+	Licence          config.OpenapiLicence
+	Servers          []config.OpenapiServer
+	Interfaces       []*config.Interface
+	MethodOptions    map[string]config.MethodOptions
+	IfaceErrors      map[string]map[string][]config.Error
+	Output           string
+	schemas          *Openapi
+}
+
+func (g *OpenRPC) Generate(ctx context.Context) []byte {
+	g.schemas = &Openapi{
+		ValidationEnable: g.ValidationEnable,
+		Version:          "3.1",
+		defTypes:         make(map[string]*option.NamedType, 1024),
+	}
+
+	title := g.Info.Title
+	if title == "" {
+		title = g.AppName
+	}
+
+	o := openrpc.OpenRPC{
+		OpenRPC: openRPCVersion,
+		Info: openapi.Info{
+			Title:       title,
+			Description: g.Info.Description,
+			Version:     openapiInfoVersion(g.Info),
+		},
+		Methods: []openrpc.Method{},
+		Components: openrpc.Components{
+			Schemas: openapi.Schemas{},
+		},
+	}
+	if g.Contact != (config.OpenapiContact{}) {
+		o.Info.Contact = &openapi.Contact{
+			Name:  g.Contact.Name,
+			URL:   g.Contact.Url,
+			Email: g.Contact.Email,
+		}
+	}
+	if g.Licence != (config.OpenapiLicence{}) {
+		o.Info.License = &openapi.License{
+			Name: g.Licence.Name,
+			URL:  g.Licence.Url,
+		}
+	}
+	for _, s := range g.Servers {
+		o.Servers = append(o.Servers, openapi.Server{
+			URL:         s.Url,
+			Description: s.Description,
+		})
+	}
+
+	for _, iface := range g.Interfaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+
+		tag := iface.Named.Name.Upper()
+		if prefix := rpcPrefix(iface); prefix != "" {
+			tag = prefix
+		}
+
+		methodErrors := g.IfaceErrors[iface.Named.Name.Value]
+
+		for _, m := range ifaceType.Methods {
+			mopt := g.MethodOptions[iface.Named.Name.Value+m.Name.Value]
+
+			method := g.makeMethod(m, rpcPrefix(iface), mopt)
+			method.Tags = []openrpc.Tag{{Name: tag}}
+			method.Deprecated = isDeprecated(iface, m)
+
+			errorsDub := map[int64]struct{}{}
+			for _, e := range methodErrors[m.Name.Value] {
+				if _, ok := errorsDub[e.Code]; ok {
+					continue
+				}
+				errorsDub[e.Code] = struct{}{}
+				method.Errors = append(method.Errors, openrpc.Error{Code: e.Code, Message: e.Name})
+			}
+			if mopt.Validation.Take() && plugin.LenWithoutContexts(m.Sig.Params) > 0 {
+				method.Errors = append(method.Errors, openrpc.Error{Code: -32602, Message: "Invalid params"})
+			}
+			if mopt.RateLimit != nil {
+				method.Errors = append(method.Errors, openrpc.Error{Code: rateLimitErrorCode, Message: "RateLimitError"})
+			}
+			o.Methods = append(o.Methods, method)
+		}
+	}
+
+	for _, namedType := range g.schemas.defTypes {
+		schema := g.schemas.namedTypeSchema(namedType)
+		schema.UpgradeTo31()
+		o.Components.Schemas[namedType.Name.Value] = schema
+	}
+
+	data, _ := ffjson.Marshal(o)
+	return data
+}
+
+func (g *OpenRPC) makeMethod(m *option.FuncType, prefix string, mopt config.MethodOptions) openrpc.Method {
+	name := m.Name.Lower()
+	if prefix != "" {
+		name = prefix + "." + name
+	}
+
+	method := openrpc.Method{
+		Name:           name,
+		Summary:        operationSummary(m),
+		Description:    m.Comment,
+		ParamStructure: "by-name",
+		Params:         []openrpc.ContentDescriptor{},
+		Deprecated:     m.Deprecated,
+	}
+
+	for _, p := range m.Sig.Params {
+		if plugin.IsContext(p) {
+			continue
+		}
+		g.schemas.fillTypeDef(p.Type)
+
+		param := openrpc.ContentDescriptor{
+			Name:        p.Name.Lower(),
+			Description: p.Comment,
+			Schema:      g.schemas.schemaByType(p.Type),
+			Deprecated:  p.Deprecated,
+		}
+		if mopt.Validation.Take() {
+			c := validation.MakeConstraints(p.Type, validation.ParamRules(p))
+			param.Required = c.Required
+			param.Description = validation.TrimParamRules(p.Comment)
+			applyConstraints(param.Schema, c)
+		}
+		param.Schema.UpgradeTo31()
+		method.Params = append(method.Params, param)
+	}
+
+	resultSchema := &openapi.Schema{
+		Type:       "object",
+		Properties: openapi.Properties{},
+	}
+
+	lenResults := plugin.LenWithoutErrors(m.Sig.Results)
+	if lenResults > 1 {
+		for _, r := range m.Sig.Results {
+			if plugin.IsError(r) {
+				continue
+			}
+			g.schemas.fillTypeDef(r.Type)
+			resultSchema.Properties[r.Name.Lower()] = g.schemas.schemaByType(r.Type)
+		}
+	} else if lenResults == 1 {
+		g.schemas.fillTypeDef(m.Sig.Results[0].Type)
+		resultSchema = g.schemas.schemaByType(m.Sig.Results[0].Type)
+	} else {
+		resultSchema = &openapi.Schema{Type: "null"}
+	}
+
+	if mopt.RESTWrapResponse.Take() != "" {
+		resultSchema = &openapi.Schema{
+			Type: "object",
+			Properties: openapi.Properties{
+				mopt.RESTWrapResponse.Take(): resultSchema,
+			},
+		}
+	}
+	resultSchema.UpgradeTo31()
+
+	method.Result = &openrpc.ContentDescriptor{
+		Name:   m.Name.Lower() + "Result",
+		Schema: resultSchema,
+	}
+	return method
+}
+
+func (g *OpenRPC) OutputPath() string {
+	return g.Output
+}
+
+func (g *OpenRPC) Filename() string {
+	return "openrpc.json"
+}
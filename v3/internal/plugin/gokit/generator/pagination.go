@@ -0,0 +1,346 @@
+package generator
+
+import (
+	"fmt"
+	"strconv"
+	stdstrings "strings"
+
+	"github.com/swipe-io/swipe/v3/internal/plugin"
+	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/config"
+	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/openapi"
+	"github.com/swipe-io/swipe/v3/option"
+	"github.com/swipe-io/swipe/v3/swipe"
+	"github.com/swipe-io/swipe/v3/writer"
+)
+
+// paginationHeaders are the response headers of the paginated methods.
+var paginationHeaders = []string{"Link", "X-Total-Count"}
+
+// makePagination returns the pagination of the method, the method options are validated by the plugin.
+func makePagination(m *option.FuncType, mopt config.MethodOptions) (plugin.Pagination, bool) {
+	kind := mopt.Paginated.Take()
+	if kind == "" {
+		return plugin.Pagination{}, false
+	}
+	p, err := plugin.FindPagination(m, kind)
+	if err != nil {
+		return plugin.Pagination{}, false
+	}
+	return p, true
+}
+
+func hasPagination(ifaces []*config.Interface, methodOptions map[string]config.MethodOptions) bool {
+	for _, iface := range ifaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+		for _, m := range ifaceType.Methods {
+			if _, ok := makePagination(m, methodOptions[iface.Named.Name.Value+m.Name.Value]); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// paginationQueryName returns the query var name of the limit, offset or cursor param.
+func paginationQueryName(v *option.VarType, mopt config.MethodOptions) string {
+	if qv, ok := plugin.FindParam(v, mopt.RESTQueryVars.Value); ok {
+		return qv.Value
+	}
+	return v.Name.Value
+}
+
+// paginationOpenapiParameter returns the schema type and the description of the limit, offset or cursor query param.
+func paginationOpenapiParameter(v *option.VarType, p plugin.Pagination) (schemaType, description string, ok bool) {
+	switch {
+	case v == p.Limit:
+		return "integer", "The maximum number of items in the page.", true
+	case v == p.Page && p.Kind == "offset":
+		return "integer", "The number of items to skip.", true
+	case v == p.Page && p.Kind == "cursor":
+		return "string", "The cursor of the page from the Link header, the first page has no cursor.", true
+	}
+	return "", "", false
+}
+
+func paginationOpenapiHeaders(p plugin.Pagination) map[string]*openapi.Header {
+	headers := map[string]*openapi.Header{}
+	switch p.Kind {
+	case "offset":
+		headers["Link"] = &openapi.Header{
+			Description: "The links to the first, prev, next and last pages.",
+			Schema:      &openapi.Schema{Type: "string"},
+		}
+	case "cursor":
+		headers["Link"] = &openapi.Header{
+			Description: "The link to the next page, the last page has no link.",
+			Schema:      &openapi.Schema{Type: "string"},
+		}
+	}
+	if p.Total != nil {
+		headers["X-Total-Count"] = &openapi.Header{
+			Description: "The total number of items.",
+			Schema:      &openapi.Schema{Type: "integer"},
+		}
+	}
+	return headers
+}
+
+func writePaginationServer(w *writer.GoWriter, importer swipe.Importer, useFast bool) {
+	contextPkg := importer.Import("context", "context")
+	urlPkg := importer.Import("url", "net/url")
+	strconvPkg := importer.Import("strconv", "strconv")
+	stringsPkg := importer.Import("strings", "strings")
+
+	var httpPkg string
+	if useFast {
+		httpPkg = importer.Import("fasthttp", "github.com/valyala/fasthttp")
+	} else {
+		httpPkg = importer.Import("http", "net/http")
+	}
+
+	w.W("type paginationURLContextKey struct{}\n\n")
+
+	w.W("// paginationServerBefore keeps the request URL for the Link header, the encoder has no access to the request.\n")
+	w.W("func paginationServerBefore(ctx %s.Context, r *%s.Request) %s.Context {\n", contextPkg, httpPkg, contextPkg)
+	if useFast {
+		w.W("u, err := %s.ParseRequestURI(string(r.RequestURI()))\n", urlPkg)
+		w.W("if err != nil {\nreturn ctx\n}\n")
+		w.W("return %s.WithValue(ctx, paginationURLContextKey{}, u)\n", contextPkg)
+	} else {
+		w.W("return %s.WithValue(ctx, paginationURLContextKey{}, r.URL)\n", contextPkg)
+	}
+	w.W("}\n\n")
+
+	w.W("type paginationHeaderSetter interface {\nSet(key, value string)\n}\n\n")
+
+	w.W("// paginationLink returns the Link header value of the page, the page is the request URL with the query value replaced.\n")
+	w.W("func paginationLink(u *%s.URL, rel, name, value string) string {\n", urlPkg)
+	w.W("q := u.Query()\n")
+	w.W("q.Set(name, value)\n")
+	w.W("link := *u\n")
+	w.W("link.RawQuery = q.Encode()\n")
+	w.W("return \"<\" + link.RequestURI() + \">; rel=\\\"\" + rel + \"\\\"\"\n")
+	w.W("}\n\n")
+
+	w.W("// setOffsetPaginationHeaders sets the X-Total-Count header and the Link header with the first, prev, next and last pages.\n")
+	w.W("func setOffsetPaginationHeaders(ctx %s.Context, h paginationHeaderSetter, limitName, offsetName string, total int) {\n", contextPkg)
+	w.W("h.Set(\"X-Total-Count\", %s.Itoa(total))\n", strconvPkg)
+	w.W("u, ok := ctx.Value(paginationURLContextKey{}).(*%s.URL)\n", urlPkg)
+	w.W("if !ok {\nreturn\n}\n")
+	w.W("q := u.Query()\n")
+	w.W("limit, _ := %s.Atoi(q.Get(limitName))\n", strconvPkg)
+	w.W("if limit <= 0 {\nreturn\n}\n")
+	w.W("offset, _ := %s.Atoi(q.Get(offsetName))\n", strconvPkg)
+	w.W("if offset < 0 {\noffset = 0\n}\n")
+	w.W("links := []string{paginationLink(u, \"first\", offsetName, \"0\")}\n")
+	w.W("if offset > 0 {\n")
+	w.W("prev := offset - limit\n")
+	w.W("if prev < 0 {\nprev = 0\n}\n")
+	w.W("links = append(links, paginationLink(u, \"prev\", offsetName, %s.Itoa(prev)))\n", strconvPkg)
+	w.W("}\n")
+	w.W("if offset+limit < total {\n")
+	w.W("links = append(links, paginationLink(u, \"next\", offsetName, %s.Itoa(offset+limit)))\n", strconvPkg)
+	w.W("}\n")
+	w.W("last := 0\n")
+	w.W("if total > 0 {\nlast = (total - 1) / limit * limit\n}\n")
+	w.W("links = append(links, paginationLink(u, \"last\", offsetName, %s.Itoa(last)))\n", strconvPkg)
+	w.W("h.Set(\"Link\", %s.Join(links, \", \"))\n", stringsPkg)
+	w.W("}\n\n")
+
+	w.W("// setCursorPaginationHeaders sets the Link header with the next page, the last page has no next cursor.\n")
+	w.W("func setCursorPaginationHeaders(ctx %s.Context, h paginationHeaderSetter, cursorName, nextCursor string) {\n", contextPkg)
+	w.W("if nextCursor == \"\" {\nreturn\n}\n")
+	w.W("u, ok := ctx.Value(paginationURLContextKey{}).(*%s.URL)\n", urlPkg)
+	w.W("if !ok {\nreturn\n}\n")
+	w.W("h.Set(\"Link\", paginationLink(u, \"next\", cursorName, nextCursor))\n")
+	w.W("}\n\n")
+}
+
+// writePaginationResponseHeaders writes the statements of the response encoder that set the pagination headers,
+// the response is the response struct of the method before it is wrapped.
+func writePaginationResponseHeaders(w *writer.GoWriter, importer swipe.Importer, useFast bool, iface *config.Interface, m *option.FuncType, mopt config.MethodOptions, p plugin.Pagination) {
+	header := "w.Header()"
+	if useFast {
+		header = "&w.Header"
+	}
+	w.W("if resp, ok := response.(%s); ok {\n", NameResponse(m, iface))
+	switch p.Kind {
+	case "offset":
+		w.W(
+			"setOffsetPaginationHeaders(ctx, %s, %s, %s, int(resp.%s))\n",
+			header, strconv.Quote(paginationQueryName(p.Limit, mopt)), strconv.Quote(paginationQueryName(p.Page, mopt)), p.Total.Name.Upper(),
+		)
+	case "cursor":
+		if p.Total != nil {
+			w.W("%s.Set(\"X-Total-Count\", %s.Itoa(int(resp.%s)))\n", stdstrings.TrimPrefix(header, "&"), importer.Import("strconv", "strconv"), p.Total.Name.Upper())
+		}
+		w.W(
+			"setCursorPaginationHeaders(ctx, %s, %s, resp.%s)\n",
+			header, strconv.Quote(paginationQueryName(p.Page, mopt)), p.NextCursor.Name.Upper(),
+		)
+	}
+	w.W("}\n")
+}
+
+// writePaginationIterator writes the client iterator that fetches the pages of the method lazily.
+func writePaginationIterator(w *writer.GoWriter, importer swipe.Importer, clientType string, iface *config.Interface, m *option.FuncType, p plugin.Pagination) {
+	iterType := UcNameWithAppPrefix(iface) + m.Name.Upper() + "Iterator"
+	itemType := swipe.TypeString(p.Items.Type.(*option.SliceType).Value, false, importer)
+	pageType := swipe.TypeString(p.Page.Type, false, importer)
+
+	nextType := pageType
+	nextName := "next"
+	if p.Kind == "offset" {
+		nextType = swipe.TypeString(p.Total.Type, false, importer)
+		nextName = "total"
+	}
+	fetchType := "func(" + pageType + ") ([]" + itemType + ", " + nextType + ", error)"
+
+	w.W("// %s iterates over the items of %s, the pages are fetched lazily.\n", iterType, m.Name.Value)
+	w.W("type %s struct {\n", iterType)
+	w.W("fetch %s\n", fetchType)
+	w.W("items []%s\n", itemType)
+	w.W("item %s\n", itemType)
+	w.W("page %s\n", pageType)
+	w.W("done bool\n")
+	w.W("err error\n")
+	w.W("}\n\n")
+
+	w.W("// Next fetches the next page when the items of the current page are over and reports whether the item is available.\n")
+	w.W("func (it *%s) Next() bool {\n", iterType)
+	w.W("for len(it.items) == 0 {\n")
+	w.W("if it.done || it.err != nil {\nreturn false\n}\n")
+	w.W("items, %s, err := it.fetch(it.page)\n", nextName)
+	w.W("if err != nil {\nit.err = err\nreturn false\n}\n")
+	w.W("it.items = items\n")
+	if p.Kind == "offset" {
+		w.W("it.page += %s(len(items))\n", pageType)
+		w.W("it.done = len(items) == 0 || int(it.page) >= int(total)\n")
+	} else {
+		w.W("it.page = next\n")
+		w.W("it.done = next == \"\"\n")
+	}
+	w.W("}\n")
+	w.W("it.item, it.items = it.items[0], it.items[1:]\n")
+	w.W("return true\n")
+	w.W("}\n\n")
+
+	w.W("// Item returns the current item.\n")
+	w.W("func (it *%s) Item() %s {\nreturn it.item\n}\n\n", iterType, itemType)
+
+	w.W("// Err returns the error of the page request that stopped the iteration.\n")
+	w.W("func (it *%s) Err() error {\nreturn it.err\n}\n\n", iterType)
+
+	var (
+		params   []string
+		callArgs []string
+	)
+	for _, v := range m.Sig.Params {
+		if v == p.Page {
+			callArgs = append(callArgs, v.Name.Value)
+			continue
+		}
+		if v.IsVariadic {
+			params = append(params, v.Name.Value+" ..."+swipe.TypeString(v.Type.(*option.SliceType).Value, false, importer))
+			callArgs = append(callArgs, v.Name.Value+"...")
+			continue
+		}
+		params = append(params, v.Name.Value+" "+swipe.TypeString(v.Type, false, importer))
+		callArgs = append(callArgs, v.Name.Value)
+	}
+
+	var (
+		results []string
+		errName = "nil"
+	)
+	for _, v := range m.Sig.Results {
+		switch {
+		case plugin.IsError(v):
+			errName = v.Name.Value
+			results = append(results, errName)
+		case v == p.Items, p.Kind == "offset" && v == p.Total, p.Kind == "cursor" && v == p.NextCursor:
+			results = append(results, v.Name.Value)
+		default:
+			results = append(results, "_")
+		}
+	}
+	nextResult := p.Total
+	if p.Kind == "cursor" {
+		nextResult = p.NextCursor
+	}
+
+	w.W("// %sIterator returns the iterator over the items of all pages of %s.\n", m.Name.Value, m.Name.Value)
+	w.W("func (c *%s) %sIterator(%s) *%s {\n", clientType, m.Name.Value, stdstrings.Join(params, ", "), iterType)
+	w.W("return &%s{\n", iterType)
+	w.W("fetch: %s {\n", stdstrings.Replace(fetchType, "func(", "func("+p.Page.Name.Value+" ", 1))
+	w.W("%s := c.%s(%s)\n", stdstrings.Join(results, ", "), m.Name.Value, stdstrings.Join(callArgs, ", "))
+	w.W("return %s, %s, %s\n", p.Items.Name.Value, nextResult.Name.Value, errName)
+	w.W("},\n")
+	w.W("}\n")
+	w.W("}\n\n")
+}
+
+// writePaginationJSIterator writes the async generator that yields the items of all pages of the method,
+// the TypeScript client uses the typed params and the return type instead of the JSDoc.
+func writePaginationJSIterator(w *writer.TextWriter, m *option.FuncType, p plugin.Pagination, ts bool) {
+	var params, callArgs, docParams []string
+	for _, v := range m.Sig.Params {
+		if plugin.IsContext(v) {
+			continue
+		}
+		if v == p.Page {
+			callArgs = append(callArgs, v.Name.Value)
+			continue
+		}
+		name := v.Name.Value
+		if v.IsVariadic {
+			name = "..." + name
+		}
+		callArgs = append(callArgs, name)
+		if ts {
+			params = append(params, name+": "+tsType(v.Type))
+			continue
+		}
+		params = append(params, name)
+		docParams = append(docParams, fmt.Sprintf("* @param {%s} %s\n", restJSDocType(v.Type, v.IsVariadic), v.Name))
+	}
+	itemType := p.Items.Type.(*option.SliceType).Value
+
+	if ts {
+		w.W(tsDoc("Iterates over the items of all pages of "+m.Name.Lower()+", the pages are fetched lazily.", false))
+		w.W("async *%sIterator(%s): AsyncGenerator<%s> {\n", m.Name.Lower(), stdstrings.Join(params, ", "), tsType(itemType))
+	} else {
+		w.W("/**\n")
+		w.W("* Iterates over the items of all pages of %s, the pages are fetched lazily.\n", m.Name.Lower())
+		w.W("*\n")
+		for _, docParam := range docParams {
+			w.W(docParam)
+		}
+		w.W("* @return {AsyncGenerator<%s>}\n", jsDocType(itemType))
+		w.W("**/\n")
+		w.W("async *%sIterator(%s) {\n", m.Name.Lower(), stdstrings.Join(params, ","))
+	}
+	switch p.Kind {
+	case "offset":
+		w.W("let %s = 0;\n", p.Page.Name.Value)
+		w.W("for (;;) {\n")
+		w.W("const { %s, %s } = await this.%s(%s);\n", p.Items.Name.Value, p.Total.Name.Value, m.Name.Lower(), stdstrings.Join(callArgs, ", "))
+		w.W("const page = %s || [];\n", p.Items.Name.Value)
+		w.W("yield* page;\n")
+		w.W("%s += page.length;\n", p.Page.Name.Value)
+		w.W("if (page.length === 0 || %s >= %s) {\nreturn;\n}\n", p.Page.Name.Value, p.Total.Name.Value)
+		w.W("}\n")
+	case "cursor":
+		w.W("let %s = \"\";\n", p.Page.Name.Value)
+		w.W("for (;;) {\n")
+		w.W("const { %s, %s } = await this.%s(%s);\n", p.Items.Name.Value, p.NextCursor.Name.Value, m.Name.Lower(), stdstrings.Join(callArgs, ", "))
+		w.W("yield* (%s || []);\n", p.Items.Name.Value)
+		w.W("if (!%s) {\nreturn;\n}\n", p.NextCursor.Name.Value)
+		w.W("%s = %s;\n", p.Page.Name.Value, p.NextCursor.Name.Value)
+		w.W("}\n")
+	}
+	w.W("}\n")
+	if ts {
+		w.W("\n")
+	}
+}
@@ -0,0 +1,327 @@
+package generator
+
+import (
+	"context"
+	"net/url"
+	"path"
+	stdstrings "strings"
+
+	"github.com/pquerna/ffjson/ffjson"
+	"github.com/swipe-io/strcase"
+
+	"github.com/swipe-io/swipe/v3/internal/plugin"
+	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/config"
+	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/postman"
+	"github.com/swipe-io/swipe/v3/option"
+)
+
+type Postman struct {
+	AppName       string
+	Info          config.OpenapiInfo
+	Servers       []config.OpenapiServer
+	Interfaces    []*config.Interface
+	MethodOptions map[string]config.MethodOptions
+	JSONRPCEnable bool
+	JSONRPCPath   string
+	Output        string
+}
+
+func (g *Postman) Generate(ctx context.Context) []byte {
+	name := g.Info.Title
+	if name == "" {
+		name = g.AppName
+	}
+
+	baseURL := sampleBaseURL
+	if len(g.Servers) > 0 {
+		baseURL = stdstrings.TrimRight(g.Servers[0].Url, "/")
+	}
+
+	c := postman.Collection{
+		Info: postman.Info{
+			Name:        name,
+			Description: g.Info.Description,
+			Schema:      postman.SchemaV21,
+		},
+		Item: []postman.Item{},
+		Variable: []postman.Variable{
+			{Key: "baseUrl", Value: baseURL, Type: "string"},
+		},
+	}
+
+	schemes := makeAuthSchemes(g.Interfaces, g.MethodOptions)
+	if schemes.bearer {
+		c.Variable = append(c.Variable, postman.Variable{Key: "bearerToken", Type: "string"})
+	}
+	if schemes.basic {
+		c.Variable = append(c.Variable,
+			postman.Variable{Key: "basicUsername", Type: "string"},
+			postman.Variable{Key: "basicPassword", Type: "string"},
+		)
+	}
+	apiKeyVars := map[string]struct{}{}
+	for _, a := range schemes.apiKeys {
+		key := sampleAPIKeyVar(a)
+		if _, ok := apiKeyVars[key]; ok {
+			continue
+		}
+		apiKeyVars[key] = struct{}{}
+		c.Variable = append(c.Variable, postman.Variable{Key: key, Type: "string", Description: "API key sent in the " + a.In + " " + a.Name})
+	}
+
+	for _, iface := range g.Interfaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+
+		folderName := iface.Named.Name.Value
+		if iface.ClientName.IsValid() {
+			folderName = iface.ClientName.Take()
+		}
+		folder := postman.Item{Name: folderName, Item: []postman.Item{}}
+		for _, m := range ifaceType.Methods {
+			mopt := g.MethodOptions[iface.Named.Name.Value+m.Name.Value]
+
+			var item postman.Item
+			if g.JSONRPCEnable {
+				item = g.jsonRPCItem(iface, m)
+			} else {
+				item = g.restItem(iface, m, mopt)
+			}
+			item.Request.Description = m.Comment
+			g.setAuth(item.Request, mopt)
+			folder.Item = append(folder.Item, item)
+		}
+		c.Item = append(c.Item, folder)
+	}
+
+	data, _ := ffjson.Marshal(c)
+	return data
+}
+
+func (g *Postman) jsonRPCItem(iface *config.Interface, m *option.FuncType) postman.Item {
+	methodName := m.Name.Lower()
+	if prefix := rpcPrefix(iface); prefix != "" {
+		methodName = prefix + "." + methodName
+	}
+	params := map[string]interface{}{}
+	for _, p := range m.Sig.Params {
+		if plugin.IsContext(p) {
+			continue
+		}
+		params[p.Name.Value] = sampleValue(p.Type, "", map[string]int{})
+	}
+	raw := sampleIndentJSON(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  methodName,
+		"params":  params,
+	})
+
+	return postman.Item{
+		Name: methodName,
+		Request: &postman.Request{
+			Method: "POST",
+			Header: []postman.Header{
+				{Key: "Content-Type", Value: "application/json", Type: "text"},
+			},
+			Body: &postman.Body{
+				Mode:    "raw",
+				Raw:     raw,
+				Options: &postman.BodyOptions{Raw: postman.BodyRawOptions{Language: "json"}},
+			},
+			URL: g.makeURL(g.JSONRPCPath, nil, nil),
+		},
+	}
+}
+
+func (g *Postman) restItem(iface *config.Interface, m *option.FuncType, mopt config.MethodOptions) postman.Item {
+	bodyType := mopt.RESTBodyType.Take()
+	if bodyType == "" {
+		bodyType = "json"
+	}
+
+	var (
+		queryParams []postman.QueryParam
+		headers     = []postman.Header{}
+		pathValues  = map[string]string{}
+		paramVars   []*option.VarType
+	)
+	for _, p := range m.Sig.Params {
+		if plugin.IsContext(p) {
+			continue
+		}
+		if v, ok := plugin.FindParam(p, mopt.RESTQueryVars.Value); ok {
+			queryParams = append(queryParams, postman.QueryParam{Key: v.Value, Value: url.QueryEscape(sampleString(p.Type, ""))})
+			continue
+		}
+		if v, ok := plugin.FindParam(p, mopt.RESTHeaderVars.Value); ok {
+			headers = append(headers, postman.Header{Key: v.Value, Value: sampleString(p.Type, ""), Type: "text"})
+			continue
+		}
+		if re, ok := mopt.RESTPathVars[p.Name.Value]; ok {
+			if re == "" {
+				re = "[^/]+"
+			}
+			pathValues[p.Name.Value] = url.PathEscape(sampleString(p.Type, re))
+			continue
+		}
+		paramVars = append(paramVars, p)
+	}
+	for i := 0; i+1 < len(mopt.RESTQueryValues.Value); i += 2 {
+		queryParams = append(queryParams, postman.QueryParam{Key: mopt.RESTQueryValues.Value[i], Value: url.QueryEscape(mopt.RESTQueryValues.Value[i+1])})
+	}
+
+	var urlPath string
+	if mopt.RESTPath.IsValid() {
+		urlPath = mopt.RESTPath.Take()
+	} else {
+		urlPath = strcase.ToKebab(m.Name.Value)
+	}
+	if prefix := restPrefix(iface, iface.Namespace); prefix != "" {
+		urlPath = path.Join(prefix, urlPath)
+	}
+
+	httpMethod := "GET"
+	if mopt.RESTMethod.Take() != "" {
+		httpMethod = stdstrings.ToUpper(mopt.RESTMethod.Take())
+	}
+
+	r := &postman.Request{
+		Method: httpMethod,
+		Header: headers,
+		URL:    g.makeURL(urlPath, pathValues, queryParams),
+	}
+
+	switch httpMethod {
+	case "POST", "PUT", "PATCH":
+		if len(paramVars) == 0 {
+			break
+		}
+		switch bodyType {
+		case "json":
+			if len(paramVars) == 1 {
+				if s, ok := paramVars[0].Type.(*option.SliceType); ok {
+					if b, ok := s.Value.(*option.BasicType); ok && b.IsByte() {
+						r.Header = append(r.Header, postman.Header{Key: "Content-Type", Value: "application/octet-stream", Type: "text"})
+						r.Body = &postman.Body{Mode: "file"}
+						break
+					}
+				}
+			}
+			raw := sampleIndentJSON(sampleRequestBody(paramVars, mopt.RESTWrapRequest.Take()))
+			r.Header = append(r.Header, postman.Header{Key: "Content-Type", Value: "application/json", Type: "text"})
+			r.Body = &postman.Body{
+				Mode:    "raw",
+				Raw:     raw,
+				Options: &postman.BodyOptions{Raw: postman.BodyRawOptions{Language: "json"}},
+			}
+		case "urlencoded":
+			r.Body = &postman.Body{Mode: "urlencoded"}
+			for _, p := range paramVars {
+				r.Body.URLEncoded = append(r.Body.URLEncoded, postman.FormParam{Key: p.Name.Value, Value: sampleString(p.Type, ""), Type: "text"})
+			}
+		case "multipart":
+			r.Body = &postman.Body{Mode: "formdata"}
+			for _, p := range paramVars {
+				if isFileUploadType(p.Type) {
+					r.Body.FormData = append(r.Body.FormData, postman.FormParam{Key: p.Name.Value, Type: "file"})
+					continue
+				}
+				r.Body.FormData = append(r.Body.FormData, postman.FormParam{Key: p.Name.Value, Value: sampleString(p.Type, ""), Type: "text"})
+			}
+		}
+	}
+
+	methodName := m.Name.Lower()
+	if prefix := rpcPrefix(iface); prefix != "" {
+		methodName = prefix + "." + methodName
+	}
+	return postman.Item{Name: methodName, Request: r}
+}
+
+// makeURL returns the URL with the path variables in the Postman :name form,
+// a variable that is only a part of the path segment is replaced with the sample value.
+func (g *Postman) makeURL(urlPath string, pathValues map[string]string, query []postman.QueryParam) postman.URL {
+	u := postman.URL{
+		Host:  []string{"{{baseUrl}}"},
+		Query: query,
+	}
+	for _, segment := range stdstrings.Split(stdstrings.Trim(urlPath, "/"), "/") {
+		if segment == "" {
+			continue
+		}
+		parts := splitPathTemplate(segment)
+		if len(parts) == 1 && parts[0].name != "" {
+			u.Path = append(u.Path, ":"+parts[0].name)
+			u.Variable = append(u.Variable, postman.Variable{Key: parts[0].name, Value: pathValues[parts[0].name]})
+			continue
+		}
+		var buf stdstrings.Builder
+		for _, part := range parts {
+			if part.name == "" {
+				buf.WriteString(part.text)
+				continue
+			}
+			buf.WriteString(pathValues[part.name])
+		}
+		u.Path = append(u.Path, buf.String())
+	}
+	u.Raw = "{{baseUrl}}"
+	if len(u.Path) > 0 {
+		u.Raw += "/" + stdstrings.Join(u.Path, "/")
+	}
+	for i, q := range query {
+		if i == 0 {
+			u.Raw += "?"
+		} else {
+			u.Raw += "&"
+		}
+		u.Raw += q.Key + "=" + q.Value
+	}
+	return u
+}
+
+// setAuth sets the bearer and basic auth with the collection variables,
+// the API keys are sent as the headers and the query params.
+func (g *Postman) setAuth(r *postman.Request, mopt config.MethodOptions) {
+	switch {
+	case mopt.BearerAuth != nil:
+		r.Auth = &postman.Auth{
+			Type:   "bearer",
+			Bearer: []postman.AuthAttribute{{Key: "token", Value: "{{bearerToken}}", Type: "string"}},
+		}
+	case mopt.BasicAuth != nil:
+		r.Auth = &postman.Auth{
+			Type: "basic",
+			Basic: []postman.AuthAttribute{
+				{Key: "username", Value: "{{basicUsername}}", Type: "string"},
+				{Key: "password", Value: "{{basicPassword}}", Type: "string"},
+			},
+		}
+	default:
+		r.Auth = &postman.Auth{Type: "noauth"}
+	}
+	if mopt.APIKeyAuth == nil {
+		return
+	}
+	value := "{{" + sampleAPIKeyVar(*mopt.APIKeyAuth) + "}}"
+	switch mopt.APIKeyAuth.In {
+	case "header":
+		r.Header = append(r.Header, postman.Header{Key: mopt.APIKeyAuth.Name, Value: value, Type: "text"})
+	case "query":
+		r.URL.Query = append(r.URL.Query, postman.QueryParam{Key: mopt.APIKeyAuth.Name, Value: value})
+		if len(r.URL.Query) == 1 {
+			r.URL.Raw += "?"
+		} else {
+			r.URL.Raw += "&"
+		}
+		r.URL.Raw += mopt.APIKeyAuth.Name + "=" + value
+	}
+}
+
+func (g *Postman) OutputPath() string {
+	return g.Output
+}
+
+func (g *Postman) Filename() string {
+	return "postman_collection.json"
+}
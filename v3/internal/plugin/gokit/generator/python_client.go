@@ -0,0 +1,575 @@
+package generator
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/swipe-io/strcase"
+
+	"github.com/swipe-io/swipe/v3/internal/plugin"
+	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/config"
+	"github.com/swipe-io/swipe/v3/option"
+	"github.com/swipe-io/swipe/v3/writer"
+)
+
+const pythonClientBase = `from __future__ import annotations
+
+import enum
+import json
+import typing
+import urllib.error
+import urllib.parse
+import urllib.request
+import uuid
+
+
+class APIError(Exception):
+    kind: typing.ClassVar[str] = "UnknownError"
+
+    def __init__(self, message: str, code: int, data: typing.Any = None, err_code: str = "") -> None:
+        super().__init__(message)
+        self.message = message
+        self.code = code
+        self.data = data
+        self.err_code = err_code
+
+
+class UnknownError(APIError):
+    pass
+
+
+def _read_json(data: bytes) -> typing.Any:
+    if not data:
+        return None
+    return json.loads(data)
+
+
+`
+
+const pythonJSONRPCClientBase = `class JSONRPCTransport:
+    def __init__(self, url: str, headers: typing.Optional[typing.Dict[str, str]] = None, timeout: float = 30.0) -> None:
+        self.url = url
+        self.headers = dict(headers or {})
+        self.timeout = timeout
+        self._request_id = 0
+
+    def call(self, method: str, params: typing.Dict[str, typing.Any]) -> typing.Dict[str, typing.Any]:
+        self._request_id += 1
+        payload = json.dumps({"jsonrpc": "2.0", "id": self._request_id, "method": method, "params": params})
+        headers = {"Content-Type": "application/json"}
+        headers.update(self.headers)
+        request = urllib.request.Request(self.url, data=payload.encode("utf-8"), headers=headers, method="POST")
+        try:
+            with urllib.request.urlopen(request, timeout=self.timeout) as resp:
+                data = resp.read()
+        except urllib.error.HTTPError as e:
+            data = e.read()
+            if not data:
+                raise
+        response = _read_json(data)
+        if isinstance(response, list):
+            response = response[0]
+        return response
+
+
+`
+
+const pythonRESTClientBase = `class RESTTransport:
+    def __init__(self, base_url: str, headers: typing.Optional[typing.Dict[str, str]] = None, timeout: float = 30.0) -> None:
+        self.base_url = base_url.rstrip("/")
+        self.headers = dict(headers or {})
+        self.timeout = timeout
+
+    def request(
+        self,
+        method: str,
+        path: str,
+        query: typing.List[typing.Tuple[str, str]],
+        headers: typing.Dict[str, str],
+        body: typing.Optional[bytes],
+    ) -> typing.Tuple[int, bytes]:
+        url = self.base_url + path
+        if query:
+            url += "?" + urllib.parse.urlencode(query)
+        request_headers = dict(self.headers)
+        request_headers.update(headers)
+        request = urllib.request.Request(url, data=body, headers=request_headers, method=method)
+        try:
+            with urllib.request.urlopen(request, timeout=self.timeout) as resp:
+                return resp.status, resp.read()
+        except urllib.error.HTTPError as e:
+            return e.code, e.read()
+
+
+def _to_str(value: typing.Any) -> str:
+    if isinstance(value, bool):
+        return "true" if value else "false"
+    if isinstance(value, enum.Enum):
+        return _to_str(value.value)
+    if isinstance(value, (list, tuple)):
+        return ",".join(_to_str(v) for v in value)
+    return str(value)
+
+
+def _read_error_body(data: bytes) -> typing.Dict[str, typing.Any]:
+    try:
+        body = _read_json(data)
+    except ValueError:
+        return {}
+    return body if isinstance(body, dict) else {}
+
+
+def _multipart(fields: typing.List[typing.Tuple[str, typing.Any]]) -> typing.Tuple[str, bytes]:
+    boundary = uuid.uuid4().hex
+    body = b""
+    for name, value in fields:
+        body += ("--" + boundary + "\r\n").encode("utf-8")
+        if hasattr(value, "read"):
+            filename = getattr(value, "name", name)
+            body += ('Content-Disposition: form-data; name="' + name + '"; filename="' + filename + '"\r\n').encode("utf-8")
+            body += b"Content-Type: application/octet-stream\r\n\r\n"
+            data = value.read()
+            body += data if isinstance(data, bytes) else data.encode("utf-8")
+        else:
+            body += ('Content-Disposition: form-data; name="' + name + '"\r\n\r\n').encode("utf-8")
+            body += _to_str(value).encode("utf-8")
+        body += b"\r\n"
+    body += ("--" + boundary + "--\r\n").encode("utf-8")
+    return "multipart/form-data; boundary=" + boundary, body
+
+
+`
+
+type PythonClientGenerator struct {
+	w             writer.GoWriter
+	JSONRPCEnable bool
+	ErrorFormat   string
+	Interfaces    []*config.Interface
+	MethodOptions map[string]config.MethodOptions
+	IfaceErrors   map[string]map[string][]config.Error
+	Output        string
+}
+
+func (g *PythonClientGenerator) Generate(ctx context.Context) []byte {
+	g.w.W(pythonClientBase)
+
+	if g.JSONRPCEnable {
+		g.w.W(pythonJSONRPCClientBase)
+	} else {
+		g.w.W(pythonRESTClientBase)
+	}
+
+	var defTypes []*option.NamedType
+	defTypesDub := map[string]struct{}{}
+	addDefTypes := func(i interface{}) {
+		for _, named := range extractNamed(i) {
+			if _, ok := defTypesDub[named.ID()]; ok {
+				continue
+			}
+			defTypesDub[named.ID()] = struct{}{}
+			if _, ok := pyExternalType(named); ok {
+				continue
+			}
+			if _, ok := named.Type.(*option.IfaceType); ok {
+				continue
+			}
+			if plugin.IsFileDownloadType(named) {
+				continue
+			}
+			defTypes = append(defTypes, named)
+		}
+	}
+
+	for _, iface := range g.Interfaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+		for _, m := range ifaceType.Methods {
+			for _, p := range m.Sig.Params {
+				if !plugin.IsContext(p) {
+					addDefTypes(p.Type)
+				}
+			}
+			for _, r := range m.Sig.Results {
+				if !plugin.IsError(r) {
+					addDefTypes(r.Type)
+				}
+			}
+		}
+	}
+
+	for _, t := range defTypes {
+		g.w.W(pyTypeDef(t))
+	}
+
+	for _, iface := range g.Interfaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+		for _, m := range ifaceType.Methods {
+			if plugin.LenWithoutErrors(m.Sig.Results) > 1 {
+				var names, types []string
+				for _, r := range m.Sig.Results {
+					if plugin.IsError(r) {
+						continue
+					}
+					names = append(names, r.Name.Value)
+					types = append(types, pyType(r.Type))
+				}
+				g.w.W(pyTypedDict(NameResponse(m, iface), names, types, nil, false))
+			}
+		}
+	}
+
+	errorsDub := map[string]struct{}{}
+	for _, iface := range g.Interfaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+		for _, m := range ifaceType.Methods {
+			for _, e := range g.methodErrors(iface, m) {
+				errorName := jsErrorName(iface, e)
+				if _, ok := errorsDub[errorName]; ok {
+					continue
+				}
+				errorsDub[errorName] = struct{}{}
+				g.w.W("class %s(APIError):\n", errorName)
+				g.w.W("    kind = %s\n\n", strconv.Quote(errorName))
+				g.w.W("    def __init__(self, message: str, data: typing.Any = None) -> None:\n")
+				if g.JSONRPCEnable {
+					g.w.W("        super().__init__(message, %d, data)\n\n\n", e.Code)
+				} else {
+					g.w.W("        super().__init__(message, %d, data, %s)\n\n\n", e.Code, strconv.Quote(e.ErrCode))
+				}
+			}
+		}
+	}
+
+	for _, iface := range g.Interfaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+		for _, m := range ifaceType.Methods {
+			g.writeConvertError(iface, m)
+		}
+	}
+
+	clientName := "RESTClient"
+	transportName := "RESTTransport"
+	if g.JSONRPCEnable {
+		clientName = "JSONRPCClient"
+		transportName = "JSONRPCTransport"
+	}
+
+	for _, iface := range g.Interfaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+
+		g.w.W("class %s%s:\n", clientName, UcNameJS(iface))
+		g.w.W("    def __init__(self, transport: %s) -> None:\n", transportName)
+		g.w.W("        self._transport = transport\n")
+
+		for _, m := range ifaceType.Methods {
+			g.w.W("\n")
+			g.w.W("    def %s(self", pyName(m.Name.Value))
+			for _, p := range m.Sig.Params {
+				if plugin.IsContext(p) {
+					continue
+				}
+				if st, ok := p.Type.(*option.SliceType); ok && p.IsVariadic {
+					g.w.W(", *%s: %s", pyName(p.Name.Value), pyType(st.Value))
+				} else {
+					g.w.W(", %s: %s", pyName(p.Name.Value), pyType(p.Type))
+				}
+			}
+			g.w.W(") -> %s:\n", g.resultType(iface, m))
+
+			doc := strings.TrimSpace(m.Comment)
+			methodErrors := g.methodErrors(iface, m)
+			if len(methodErrors) > 0 {
+				if doc != "" {
+					doc += "\n\n"
+				}
+				doc += "Raises:"
+				for _, e := range methodErrors {
+					doc += "\n    " + jsErrorName(iface, e)
+				}
+			}
+			if isDeprecated(iface, m) {
+				doc += "\n\n.. deprecated::"
+			}
+			if doc != "" {
+				g.w.W("        \"\"\"")
+				for i, line := range strings.Split(strings.ReplaceAll(doc, "\"\"\"", "'''"), "\n") {
+					if i > 0 && line != "" {
+						g.w.W("        ")
+					}
+					g.w.W("%s\n", line)
+				}
+				g.w.W("        \"\"\"\n")
+			}
+
+			if g.JSONRPCEnable {
+				g.writeJSONRPCMethod(iface, m)
+			} else {
+				g.writeRESTMethod(iface, m)
+			}
+		}
+		g.w.W("\n\n")
+	}
+
+	if len(g.Interfaces) > 1 {
+		g.w.W("class %s:\n", clientName)
+		g.w.W("    def __init__(self, transport: %s) -> None:\n", transportName)
+		for _, iface := range g.Interfaces {
+			g.w.W("        self.%s = %s%s(transport)\n", pyName(LcNameJS(iface)), clientName, UcNameJS(iface))
+		}
+	} else if len(g.Interfaces) == 1 {
+		g.w.W("%[1]s = %[1]s%[2]s\n", clientName, UcNameJS(g.Interfaces[0]))
+	}
+	return g.w.Bytes()
+}
+
+func (g *PythonClientGenerator) methodErrors(iface *config.Interface, m *option.FuncType) (result []config.Error) {
+	errorsDub := map[string]struct{}{}
+	for _, e := range g.IfaceErrors[iface.Named.Name.Value][m.Name.Value] {
+		errorName := jsErrorName(iface, e)
+		if _, ok := errorsDub[errorName]; ok {
+			continue
+		}
+		errorsDub[errorName] = struct{}{}
+		result = append(result, e)
+	}
+	return
+}
+
+func (g *PythonClientGenerator) resultType(iface *config.Interface, m *option.FuncType) string {
+	resultsLen := plugin.LenWithoutErrors(m.Sig.Results)
+	if resultsLen == 0 {
+		return "None"
+	}
+	if resultsLen > 1 {
+		return NameResponse(m, iface)
+	}
+	for _, r := range m.Sig.Results {
+		if !plugin.IsError(r) {
+			return pyType(r.Type)
+		}
+	}
+	return "None"
+}
+
+func (g *PythonClientGenerator) writeConvertError(iface *config.Interface, m *option.FuncType) {
+	methodErrors := g.methodErrors(iface, m)
+	funcName := "_" + strcase.ToSnake(LcNameIfaceMethod(iface, m)) + "_convert_error"
+
+	if g.JSONRPCEnable {
+		g.w.W("def %s(e: typing.Dict[str, typing.Any]) -> APIError:\n", funcName)
+		g.w.W("    code = e.get(\"code\", 0)\n")
+		g.w.W("    message = e.get(\"message\", \"\")\n")
+		for _, e := range methodErrors {
+			g.w.W("    if code == %d:\n", e.Code)
+			g.w.W("        return %s(message, e.get(\"data\"))\n", jsErrorName(iface, e))
+		}
+		g.w.W("    return UnknownError(%s + message, code, e.get(\"data\"))\n\n\n", strconv.Quote(LcNameIfaceMethod(iface, m)+": "))
+		return
+	}
+
+	g.w.W("def %s(status: int, e: typing.Dict[str, typing.Any]) -> APIError:\n", funcName)
+	if g.ErrorFormat == "problem" {
+		g.w.W("    message = e.get(\"detail\") or e.get(\"title\") or \"\"\n")
+	} else {
+		g.w.W("    message = e.get(\"error\") or \"\"\n")
+	}
+	g.w.W("    err_code = e.get(\"code\") or \"\"\n")
+	for _, e := range methodErrors {
+		g.w.W("    if status == %d and err_code == %s:\n", e.Code, strconv.Quote(e.ErrCode))
+		g.w.W("        return %s(message, e.get(\"data\"))\n", jsErrorName(iface, e))
+	}
+	g.w.W("    return UnknownError(%s + message, status, e.get(\"data\"), err_code)\n\n\n", strconv.Quote(LcNameIfaceMethod(iface, m)+": "))
+}
+
+func (g *PythonClientGenerator) writeJSONRPCMethod(iface *config.Interface, m *option.FuncType) {
+	var prefix string
+	if ns := rpcPrefix(iface); ns != "" {
+		prefix = ns + "."
+	}
+	params := make([]string, 0, len(m.Sig.Params))
+	for _, p := range m.Sig.Params {
+		if plugin.IsContext(p) {
+			continue
+		}
+		value := pyName(p.Name.Value)
+		if p.IsVariadic {
+			value = "list(" + value + ")"
+		}
+		params = append(params, fmt.Sprintf("%s: %s", strconv.Quote(p.Name.Value), value))
+	}
+	g.w.W("        response = self._transport.call(%s, {%s})\n", strconv.Quote(prefix+m.Name.Lower()), strings.Join(params, ", "))
+	g.w.W("        if response.get(\"error\") is not None:\n")
+	g.w.W("            raise _%s_convert_error(response[\"error\"])\n", strcase.ToSnake(LcNameIfaceMethod(iface, m)))
+	if plugin.LenWithoutErrors(m.Sig.Results) > 0 {
+		g.w.W("        return typing.cast(%s, response.get(\"result\"))\n", strconv.Quote(g.resultType(iface, m)))
+	}
+}
+
+func (g *PythonClientGenerator) writeRESTMethod(iface *config.Interface, m *option.FuncType) {
+	mopt := g.MethodOptions[iface.Named.Name.Value+m.Name.Value]
+
+	httpMethod := strings.ToUpper(mopt.RESTMethod.Take())
+	if httpMethod == "" {
+		httpMethod = "GET"
+	}
+
+	bodyType := mopt.RESTBodyType.Take()
+	if bodyType == "" {
+		bodyType = "json"
+	}
+
+	var pathStr string
+	if mopt.RESTPath.IsValid() {
+		pathStr = mopt.RESTPath.Take()
+	} else {
+		pathStr = path.Join("/", strcase.ToKebab(m.Name.Value))
+	}
+	if prefix := restPrefix(iface, strcase.ToKebab(iface.Namespace)); prefix != "" {
+		pathStr = path.Join("/", prefix, "/", pathStr)
+	}
+
+	queryVars := make(map[string]string, len(mopt.RESTQueryVars.Value))
+	for i := 0; i < len(mopt.RESTQueryVars.Value); i += 2 {
+		queryVars[mopt.RESTQueryVars.Value[i+1]] = strings.TrimPrefix(mopt.RESTQueryVars.Value[i], "!")
+	}
+	headerVars := make(map[string]string, len(mopt.RESTHeaderVars.Value))
+	for i := 0; i < len(mopt.RESTHeaderVars.Value); i += 2 {
+		headerVars[mopt.RESTHeaderVars.Value[i+1]] = mopt.RESTHeaderVars.Value[i]
+	}
+
+	var (
+		queryParams  []*option.VarType
+		headerParams []*option.VarType
+		bodyParams   []*option.VarType
+		allParams    []*option.VarType
+	)
+	for _, p := range m.Sig.Params {
+		if plugin.IsContext(p) {
+			continue
+		}
+		allParams = append(allParams, p)
+		if _, ok := mopt.RESTPathVars[p.Name.Value]; ok {
+			continue
+		} else if _, ok := queryVars[p.Name.Value]; ok {
+			queryParams = append(queryParams, p)
+		} else if _, ok := headerVars[p.Name.Value]; ok {
+			headerParams = append(headerParams, p)
+		} else {
+			bodyParams = append(bodyParams, p)
+		}
+	}
+
+	var pathParts []string
+	var last int
+	for _, loc := range fetchPathVarRegexp.FindAllStringSubmatchIndex(pathStr, -1) {
+		name := pathStr[loc[2]:loc[3]]
+		if _, ok := mopt.RESTPathVars[name]; !ok {
+			continue
+		}
+		if loc[0] > last {
+			pathParts = append(pathParts, strconv.Quote(pathStr[last:loc[0]]))
+		}
+		pathParts = append(pathParts, "urllib.parse.quote(_to_str("+pyName(name)+"), safe=\"\")")
+		last = loc[1]
+	}
+	if last < len(pathStr) || len(pathParts) == 0 {
+		pathParts = append(pathParts, strconv.Quote(pathStr[last:]))
+	}
+
+	optionalValue := func(p *option.VarType, indent string, fn func(indent string)) {
+		if plugin.IsPointer(p.Type) {
+			g.w.W("%sif %s is not None:\n", indent, pyName(p.Name.Value))
+			fn(indent + "    ")
+			return
+		}
+		fn(indent)
+	}
+
+	g.w.W("        _query: typing.List[typing.Tuple[str, str]] = []\n")
+	for _, p := range queryParams {
+		optionalValue(p, "        ", func(indent string) {
+			g.w.W("%s_query.append((%s, _to_str(%s)))\n", indent, strconv.Quote(queryVars[p.Name.Value]), pyName(p.Name.Value))
+		})
+	}
+	for i := 0; i < len(mopt.RESTQueryValues.Value); i += 2 {
+		g.w.W("        _query.append((%s, %s))\n", strconv.Quote(mopt.RESTQueryValues.Value[i]), strconv.Quote(mopt.RESTQueryValues.Value[i+1]))
+	}
+
+	g.w.W("        _headers: typing.Dict[str, str] = {}\n")
+	for _, p := range headerParams {
+		optionalValue(p, "        ", func(indent string) {
+			g.w.W("%s_headers[%s] = _to_str(%s)\n", indent, strconv.Quote(headerVars[p.Name.Value]), pyName(p.Name.Value))
+		})
+	}
+
+	g.w.W("        _body: typing.Optional[bytes] = None\n")
+	switch httpMethod {
+	case "POST", "PUT", "PATCH":
+		if len(allParams) == 0 {
+			break
+		}
+		switch bodyType {
+		case "json":
+			fields := make([]string, 0, len(allParams))
+			for _, p := range allParams {
+				fields = append(fields, fmt.Sprintf("%s: %s", strconv.Quote(p.Name.Value), pyName(p.Name.Value)))
+			}
+			reqData := "{" + strings.Join(fields, ", ") + "}"
+			if wrapRequest := mopt.RESTWrapRequest.Take(); wrapRequest != "" {
+				parts := strings.Split(wrapRequest, ".")
+				for i := len(parts) - 1; i >= 0; i-- {
+					reqData = "{" + strconv.Quote(parts[i]) + ": " + reqData + "}"
+				}
+			}
+			g.w.W("        _headers[\"Content-Type\"] = \"application/json\"\n")
+			g.w.W("        _body = json.dumps(%s).encode(\"utf-8\")\n", reqData)
+		case "urlencoded":
+			g.w.W("        _form: typing.List[typing.Tuple[str, str]] = []\n")
+			for _, p := range bodyParams {
+				optionalValue(p, "        ", func(indent string) {
+					g.w.W("%s_form.append((%s, _to_str(%s)))\n", indent, strconv.Quote(p.Name.Value), pyName(p.Name.Value))
+				})
+			}
+			g.w.W("        _headers[\"Content-Type\"] = \"application/x-www-form-urlencoded; charset=utf-8\"\n")
+			g.w.W("        _body = urllib.parse.urlencode(_form).encode(\"utf-8\")\n")
+		case "multipart":
+			g.w.W("        _fields: typing.List[typing.Tuple[str, typing.Any]] = []\n")
+			for _, p := range bodyParams {
+				optionalValue(p, "        ", func(indent string) {
+					g.w.W("%s_fields.append((%s, %s))\n", indent, strconv.Quote(p.Name.Value), pyName(p.Name.Value))
+				})
+			}
+			g.w.W("        _headers[\"Content-Type\"], _body = _multipart(_fields)\n")
+		}
+	}
+
+	g.w.W("        _status, _data = self._transport.request(%s, %s, _query, _headers, _body)\n", strconv.Quote(httpMethod), strings.Join(pathParts, " + "))
+	g.w.W("        if _status > 299:\n")
+	g.w.W("            raise _%s_convert_error(_status, _read_error_body(_data))\n", strcase.ToSnake(LcNameIfaceMethod(iface, m)))
+
+	switch {
+	case plugin.LenWithoutErrors(m.Sig.Results) == 0:
+	case plugin.DownloadFile(m.Sig.Results) != nil:
+		g.w.W("        return _data\n")
+	default:
+		dataExpr := "_read_json(_data)"
+		if wrapResponse := mopt.RESTWrapResponse.Take(); wrapResponse != "" {
+			g.w.W("        _result = _read_json(_data)\n")
+			for _, part := range strings.Split(wrapResponse, ".") {
+				g.w.W("        _result = (_result or {}).get(%s)\n", strconv.Quote(part))
+			}
+			dataExpr = "_result"
+		}
+		g.w.W("        return typing.cast(%s, %s)\n", strconv.Quote(g.resultType(iface, m)), dataExpr)
+	}
+}
+
+func (g *PythonClientGenerator) OutputPath() string {
+	return g.Output
+}
+
+func (g *PythonClientGenerator) Filename() string {
+	return "client.py"
+}
@@ -0,0 +1,164 @@
+package generator
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/config"
+	"github.com/swipe-io/swipe/v3/option"
+	"github.com/swipe-io/swipe/v3/swipe"
+	"github.com/swipe-io/swipe/v3/writer"
+)
+
+const rateLimitErrorCode = -32029
+
+type rateLimits struct {
+	enabled    bool
+	keyHeaders []string
+}
+
+func makeRateLimits(ifaces []*config.Interface, methodOptions map[string]config.MethodOptions) (r rateLimits) {
+	keyHeaders := map[string]struct{}{}
+	for _, iface := range ifaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+		for _, m := range ifaceType.Methods {
+			mopt := methodOptions[iface.Named.Name.Value+m.Name.Value]
+			if mopt.RateLimit == nil {
+				continue
+			}
+			r.enabled = true
+			if name := mopt.RateLimitKeyHeader.Take(); name != "" {
+				if _, ok := keyHeaders[name]; !ok {
+					keyHeaders[name] = struct{}{}
+					r.keyHeaders = append(r.keyHeaders, name)
+				}
+			}
+		}
+	}
+	sort.Strings(r.keyHeaders)
+	return
+}
+
+func writeRateLimitServerOptions(w *writer.GoWriter, r rateLimits) {
+	if !r.enabled {
+		return
+	}
+	w.W("// RateLimitKeyOption sets the function that selects the rate limit bucket for a request,\n")
+	w.W("// methods with a key header use the header value instead.\n")
+	w.W("func RateLimitKeyOption(f RateLimitKeyFunc) ServerOption {\nreturn func(c *serverOpts) {\nc.rateLimitKeyFunc = f\n}\n}\n\n")
+}
+
+func writeRateLimitServerOptsFields(w *writer.GoWriter, r rateLimits) {
+	if !r.enabled {
+		return
+	}
+	w.W("rateLimitKeyFunc RateLimitKeyFunc\n")
+}
+
+func writeRateLimitServer(w *writer.GoWriter, importer swipe.Importer, useFast bool, r rateLimits) {
+	contextPkg := importer.Import("context", "context")
+	endpointPkg := importer.Import("endpoint", "github.com/go-kit/kit/endpoint")
+	syncPkg := importer.Import("sync", "sync")
+	timePkg := importer.Import("time", "time")
+
+	w.W("type RateLimitError struct{}\n\n")
+	w.W("func (*RateLimitError) Error() string {\nreturn \"rate limit exceeded\"\n}\n\n")
+	w.W("func (*RateLimitError) StatusCode() int {\nreturn 429\n}\n\n")
+	w.W("func (*RateLimitError) ErrorCode() int {\nreturn %d\n}\n\n", rateLimitErrorCode)
+	w.W("func (*RateLimitError) Code() string {\nreturn \"rate_limit_exceeded\"\n}\n\n")
+
+	w.W("// RateLimitKeyFunc returns the client key of the request, requests with the same key share a token bucket.\n")
+	w.W("type RateLimitKeyFunc func(ctx %s.Context) string\n\n", contextPkg)
+
+	w.W("const rateLimitMaxBuckets = 10000\n\n")
+
+	w.W("type rateLimitBucket struct {\ntokens float64\nlast %s.Time\n}\n\n", timePkg)
+
+	w.W("type rateLimiter struct {\n")
+	w.W("mu %s.Mutex\n", syncPkg)
+	w.W("rps float64\n")
+	w.W("burst float64\n")
+	w.W("buckets map[string]*rateLimitBucket\n")
+	w.W("}\n\n")
+
+	w.W("func newRateLimiter(rps float64, burst int) *rateLimiter {\n")
+	w.W("return &rateLimiter{rps: rps, burst: float64(burst), buckets: map[string]*rateLimitBucket{}}\n")
+	w.W("}\n\n")
+
+	w.W("func (l *rateLimiter) allow(key string) bool {\n")
+	w.W("now := %s.Now()\n", timePkg)
+	w.W("l.mu.Lock()\n")
+	w.W("defer l.mu.Unlock()\n")
+	w.W("b, ok := l.buckets[key]\n")
+	w.W("if !ok {\n")
+	w.W("if len(l.buckets) >= rateLimitMaxBuckets {\nl.evict(now)\n}\n")
+	w.W("b = &rateLimitBucket{tokens: l.burst, last: now}\n")
+	w.W("l.buckets[key] = b\n")
+	w.W("}\n")
+	w.W("b.tokens += now.Sub(b.last).Seconds() * l.rps\n")
+	w.W("if b.tokens > l.burst {\nb.tokens = l.burst\n}\n")
+	w.W("b.last = now\n")
+	w.W("if b.tokens < 1 {\nreturn false\n}\n")
+	w.W("b.tokens--\n")
+	w.W("return true\n")
+	w.W("}\n\n")
+
+	w.W("// evict removes the buckets that are full again, they do not differ from new ones.\n")
+	w.W("func (l *rateLimiter) evict(now %s.Time) {\n", timePkg)
+	w.W("for key, b := range l.buckets {\n")
+	w.W("if b.tokens+now.Sub(b.last).Seconds()*l.rps >= l.burst {\ndelete(l.buckets, key)\n}\n")
+	w.W("}\n")
+	w.W("}\n\n")
+
+	w.W("func rateLimitMiddleware(l *rateLimiter, keyFunc RateLimitKeyFunc) %s.Middleware {\n", endpointPkg)
+	w.W("return func(next %[1]s.Endpoint) %[1]s.Endpoint {\n", endpointPkg)
+	w.W("return func(ctx %s.Context, request interface{}) (interface{}, error) {\n", contextPkg)
+	w.W("var key string\n")
+	w.W("if keyFunc != nil {\nkey = keyFunc(ctx)\n}\n")
+	w.W("if !l.allow(key) {\nreturn nil, &RateLimitError{}\n}\n")
+	w.W("return next(ctx, request)\n")
+	w.W("}\n}\n}\n\n")
+
+	if len(r.keyHeaders) == 0 {
+		return
+	}
+
+	w.W("type rateLimitContextKey string\n\n")
+
+	w.W("func rateLimitHeaderKey(name string) RateLimitKeyFunc {\n")
+	w.W("return func(ctx %s.Context) string {\n", contextPkg)
+	w.W("v, _ := ctx.Value(rateLimitContextKey(name)).(string)\n")
+	w.W("return v\n")
+	w.W("}\n}\n\n")
+
+	var httpPkg string
+	if useFast {
+		httpPkg = importer.Import("fasthttp", "github.com/valyala/fasthttp")
+	} else {
+		httpPkg = importer.Import("http", "net/http")
+	}
+	w.W("func rateLimitServerBefore(ctx %s.Context, r *%s.Request) %s.Context {\n", contextPkg, httpPkg, contextPkg)
+	for _, name := range r.keyHeaders {
+		if useFast {
+			w.W("ctx = %s.WithValue(ctx, rateLimitContextKey(%[2]s), string(r.Header.Peek(%[2]s)))\n", contextPkg, strconv.Quote(name))
+		} else {
+			w.W("ctx = %s.WithValue(ctx, rateLimitContextKey(%[2]s), r.Header.Get(%[2]s))\n", contextPkg, strconv.Quote(name))
+		}
+	}
+	w.W("return ctx\n")
+	w.W("}\n\n")
+}
+
+func writeRateLimitEndpointMiddleware(w *writer.GoWriter, epSetName string, m *option.FuncType, mopt config.MethodOptions) {
+	if mopt.RateLimit == nil {
+		return
+	}
+	keyFunc := "opts.rateLimitKeyFunc"
+	if name := mopt.RateLimitKeyHeader.Take(); name != "" {
+		keyFunc = "rateLimitHeaderKey(" + strconv.Quote(name) + ")"
+	}
+	w.W(
+		"%[1]s.%[2]sEndpoint = rateLimitMiddleware(newRateLimiter(%[3]s, %[4]d), %[5]s)(%[1]s.%[2]sEndpoint)\n",
+		epSetName, m.Name, strconv.FormatFloat(mopt.RateLimit.Rps, 'f', -1, 64), mopt.RateLimit.Burst, keyFunc,
+	)
+}
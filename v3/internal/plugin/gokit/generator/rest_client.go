@@ -26,6 +26,9 @@ type RESTClientGenerator struct {
 	w             writer.GoWriter
 	Interfaces    []*config.Interface
 	UseFast       bool
+	TracingEnable bool
+	Codecs        *config.CodecsEnable
+	ErrorFormat   string
 	MethodOptions map[string]config.MethodOptions
 	Output        string
 	Pkg           string
@@ -58,7 +61,15 @@ func (g *RESTClientGenerator) Generate(ctx context.Context) []byte {
 	}
 
 	g.w.W("type clientErrorWrapper struct {\n")
-	g.w.W("Error string `json:\"error\"`\n")
+	if g.ErrorFormat == "problem" {
+		g.w.W("Type string `json:\"type\"`\n")
+		g.w.W("Title string `json:\"title\"`\n")
+		g.w.W("Status int `json:\"status\"`\n")
+		g.w.W("Detail string `json:\"detail,omitempty\"`\n")
+		g.w.W("Instance string `json:\"instance,omitempty\"`\n")
+	} else {
+		g.w.W("Error string `json:\"error\"`\n")
+	}
 	g.w.W("Code string `json:\"code,omitempty\"`\n")
 	g.w.W("Data interface{} `json:\"data,omitempty\"`\n")
 	g.w.W("}\n")
@@ -101,6 +112,13 @@ func (g *RESTClientGenerator) Generate(ctx context.Context) []byte {
 		g.w.W("u.Scheme = \"https\"\n")
 		g.w.W("}\n")
 
+		if g.TracingEnable {
+			g.w.W("opts.genericOpts.clientOption = append(opts.genericOpts.clientOption, %s.ClientBefore(tracingClientBefore))\n", kitHTTPPkg)
+		}
+		if g.Codecs != nil {
+			g.w.W("codecs := newCodecRegistry(opts.codecs)\n")
+		}
+
 		for _, m := range ifaceType.Methods {
 			mopt := g.MethodOptions[iface.Named.Name.Value+m.Name.Value]
 
@@ -111,17 +129,37 @@ func (g *RESTClientGenerator) Generate(ctx context.Context) []byte {
 				httpMethod = "GET"
 			}
 
+			writeAuthClientBefore(&g.w, kitHTTPPkg, LcNameIfaceMethod(iface, m)+"Opts", mopt)
+			if mopt.Idempotent != nil {
+				g.w.W("opts.%[1]sOpts.clientOption = append(opts.%[1]sOpts.clientOption, %[2]s.ClientBefore(idempotencyClientBefore))\n", LcNameIfaceMethod(iface, m), kitHTTPPkg)
+			}
+
+			if g.Codecs != nil {
+				g.w.W("%s, err := codecs.get(%s)\n", codecVarName(LcNameIfaceMethod(iface, m)), strconv.Quote(codecMediaType(mopt)))
+				g.w.WriteCheckErr("err", func() {
+					g.w.W("return nil, err\n")
+				})
+			}
+
 			g.w.W("c.%s = %s.NewClient(\n", epName, kitHTTPPkg)
 			g.w.W(strconv.Quote(httpMethod))
 			g.w.W(",\n")
 			g.w.W("u,\n")
-			g.w.W("%sReqFn,\n", LcNameIfaceMethod(iface, m))
-			g.w.W("%sRespFn,\n", LcNameIfaceMethod(iface, m))
+			if g.Codecs != nil {
+				g.w.W("%sReqFn(%s),\n", LcNameIfaceMethod(iface, m), codecVarName(LcNameIfaceMethod(iface, m)))
+				g.w.W("%sRespFn(codecs, %s),\n", LcNameIfaceMethod(iface, m), codecVarName(LcNameIfaceMethod(iface, m)))
+			} else {
+				g.w.W("%sReqFn,\n", LcNameIfaceMethod(iface, m))
+				g.w.W("%sRespFn,\n", LcNameIfaceMethod(iface, m))
+			}
 			g.w.W("append(opts.genericOpts.clientOption, opts.%sOpts.clientOption...)...,\n).Endpoint()\n", LcNameIfaceMethod(iface, m))
 			g.w.W(
 				"c.%[1]s = middlewareChain(append(opts.genericOpts.endpointMiddleware, opts.%[2]sOpts.endpointMiddleware...))(c.%[1]s)\n",
 				epName, LcNameIfaceMethod(iface, m),
 			)
+			if mopt.Idempotent != nil {
+				g.w.W("c.%[1]s = idempotencyKeyMiddleware(c.%[1]s)\n", epName)
+			}
 		}
 		g.w.W("return c, nil\n}\n\n")
 	}
@@ -137,7 +175,12 @@ func (g *RESTClientGenerator) writeCreateReqFuncs(importer swipe.Importer, httpP
 		for _, m := range ifaceType.Methods {
 			mopt := g.MethodOptions[iface.Named.Name.Value+m.Name.Value]
 
-			g.w.W("func %sRespFn(_ %s.Context, r *%s.Response) (response interface{}, err error) {\n", LcNameIfaceMethod(iface, m), contextPkg, httpPkg)
+			if g.Codecs != nil {
+				g.w.W("func %sRespFn(codecs codecRegistry, defaultCodec Codec) func(%s.Context, *%s.Response) (interface{}, error) {\n", LcNameIfaceMethod(iface, m), contextPkg, httpPkg)
+				g.w.W("return func(_ %s.Context, r *%s.Response) (response interface{}, err error) {\n", contextPkg, httpPkg)
+			} else {
+				g.w.W("func %sRespFn(_ %s.Context, r *%s.Response) (response interface{}, err error) {\n", LcNameIfaceMethod(iface, m), contextPkg, httpPkg)
+			}
 			statusCode := "r.StatusCode"
 			if g.UseFast {
 				statusCode = "r.StatusCode(r)"
@@ -191,10 +234,19 @@ func (g *RESTClientGenerator) writeCreateReqFuncs(importer swipe.Importer, httpP
 					})
 				}
 
-				jsonPkg := importer.Import("ffjson", "github.com/pquerna/ffjson/ffjson")
-
 				g.w.W("if len(b) == 0 {\nreturn nil, nil\n}\n")
-				g.w.W("err = %s.Unmarshal(b, &resp)\n", jsonPkg)
+				if g.Codecs != nil {
+					contentType := "r.Header.Get(\"Content-Type\")"
+					if g.UseFast {
+						contentType = "string(r.Header.ContentType())"
+					}
+					g.w.W("codec, ok := codecs.lookup(%s, defaultCodec)\n", contentType)
+					g.w.W("if !ok {\ncodec = defaultCodec\n}\n")
+					g.w.W("err = codec.Unmarshal(b, &resp)\n")
+				} else {
+					jsonPkg := importer.Import("ffjson", "github.com/pquerna/ffjson/ffjson")
+					g.w.W("err = %s.Unmarshal(b, &resp)\n", jsonPkg)
+				}
 				g.w.W("if err != nil {\n")
 				g.w.W("return nil, %s.Errorf(\"couldn't unmarshal body to %s: %%s\", err)\n", fmtPkg, responseType)
 				g.w.W("}\n")
@@ -208,8 +260,13 @@ func (g *RESTClientGenerator) writeCreateReqFuncs(importer swipe.Importer, httpP
 				g.w.W("return nil, nil\n")
 			}
 			g.w.W("}\n")
-
-			g.w.W("func %sReqFn(_ %s.Context, r *%s.Request, request interface{}) error {\n", LcNameIfaceMethod(iface, m), contextPkg, httpPkg)
+			if g.Codecs != nil {
+				g.w.W("}\n")
+				g.w.W("func %sReqFn(codec Codec) func(%s.Context, *%s.Request, interface{}) error {\n", LcNameIfaceMethod(iface, m), contextPkg, httpPkg)
+				g.w.W("return func(_ %s.Context, r *%s.Request, request interface{}) error {\n", contextPkg, httpPkg)
+			} else {
+				g.w.W("func %sReqFn(_ %s.Context, r *%s.Request, request interface{}) error {\n", LcNameIfaceMethod(iface, m), contextPkg, httpPkg)
+			}
 
 			nameRequest := NameRequest(m, iface)
 			httpMethod := mopt.RESTMethod.Take()
@@ -229,8 +286,8 @@ func (g *RESTClientGenerator) writeCreateReqFuncs(importer swipe.Importer, httpP
 				pathStr = path.Join("/", strcase.ToKebab(m.Name.Value))
 			}
 
-			if iface.Namespace != "" {
-				pathStr = path.Join("/", strcase.ToKebab(iface.Namespace), "/", pathStr)
+			if prefix := restPrefix(iface, strcase.ToKebab(iface.Namespace)); prefix != "" {
+				pathStr = path.Join("/", prefix, "/", pathStr)
 			}
 
 			var (
@@ -307,6 +364,9 @@ func (g *RESTClientGenerator) writeCreateReqFuncs(importer swipe.Importer, httpP
 				g.w.W(")")
 			}
 			g.w.W("\n")
+			if g.Codecs != nil {
+				g.w.W("r.Header.Set(\"Accept\", codec.ContentType())\n")
+			}
 
 			pathVarNames := make([]string, 0, len(pathVars))
 			for _, p := range pathVars {
@@ -384,8 +444,11 @@ func (g *RESTClientGenerator) writeCreateReqFuncs(importer swipe.Importer, httpP
 				case "POST", "PUT", "PATCH":
 					switch bodyType {
 					case "json":
-						jsonPkg := importer.Import("ffjson", "github.com/pquerna/ffjson/ffjson")
-						g.w.W("r.Header.Set(\"Content-Type\", \"application/json\")\n")
+						if g.Codecs != nil {
+							g.w.W("r.Header.Set(\"Content-Type\", codec.ContentType())\n")
+						} else {
+							g.w.W("r.Header.Set(\"Content-Type\", \"application/json\")\n")
+						}
 
 						g.w.W("var reqData interface{}\n")
 
@@ -399,7 +462,12 @@ func (g *RESTClientGenerator) writeCreateReqFuncs(importer swipe.Importer, httpP
 							g.w.W("reqData = request\n")
 						}
 
-						g.w.W("data, err := %s.Marshal(reqData)\n", jsonPkg)
+						if g.Codecs != nil {
+							g.w.W("data, err := codec.Marshal(reqData)\n")
+						} else {
+							jsonPkg := importer.Import("ffjson", "github.com/pquerna/ffjson/ffjson")
+							g.w.W("data, err := %s.Marshal(reqData)\n", jsonPkg)
+						}
 						g.w.W("if err != nil  {\n")
 						g.w.W("return %s.Errorf(\"couldn't marshal request %%T: %%s\", req, err)\n", fmtPkg)
 						g.w.W("}\n")
@@ -482,6 +550,9 @@ func (g *RESTClientGenerator) writeCreateReqFuncs(importer swipe.Importer, httpP
 
 			g.w.W("return nil\n")
 			g.w.W("}\n")
+			if g.Codecs != nil {
+				g.w.W("}\n")
+			}
 		}
 	}
 }
@@ -0,0 +1,470 @@
+package generator
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/swipe-io/strcase"
+
+	"github.com/swipe-io/swipe/v3/internal/plugin"
+	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/config"
+	"github.com/swipe-io/swipe/v3/option"
+	"github.com/swipe-io/swipe/v3/writer"
+)
+
+const restJSClientBase = `
+export class RESTError extends Error {
+	constructor(message, name, code, errCode, data) {
+		super(message);
+		this.name = name;
+		this.code = code;
+		this.errCode = errCode;
+		this.data = data;
+	}
+}
+
+class RESTRequester {
+	/**
+	 * @param {string} baseURL
+	 * @param {function(string, Object): Promise<Response>} fetchFn
+	 */
+	constructor(baseURL, fetchFn) {
+		this._baseURL = baseURL.replace(/\/+$/, "");
+		this._fetchFn = fetchFn;
+	}
+	/**
+	 * @param {string} method
+	 * @param {string} path
+	 * @param {URLSearchParams} query
+	 * @param {Object<string, string>} headers
+	 * @param {*} body
+	 * @returns {Promise<Response>}
+	 */
+	request(method, path, query, headers, body) {
+		const qs = query.toString();
+		return this._fetchFn(this._baseURL + path + (qs ? "?" + qs : ""), { method, headers, body });
+	}
+}
+
+async function readErrorBody(resp) {
+	try {
+		return await resp.json();
+	} catch (e) {
+		return {};
+	}
+}
+
+async function readJSON(resp) {
+	const text = await resp.text();
+	return text === "" ? null : JSON.parse(text);
+}
+`
+
+type RESTJSClientGenerator struct {
+	w             writer.GoWriter
+	ErrorFormat   string
+	Interfaces    []*config.Interface
+	MethodOptions map[string]config.MethodOptions
+	IfaceErrors   map[string]map[string][]config.Error
+}
+
+func (g *RESTJSClientGenerator) Generate(ctx context.Context) []byte {
+	g.w.W(restJSClientBase)
+	if hasIdempotency(g.Interfaces, g.MethodOptions) {
+		g.w.W(restJSIdempotencyBase)
+	}
+
+	mw := writer.TextWriter{}
+
+	defTypes := map[string]*option.NamedType{}
+
+	for _, iface := range g.Interfaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+
+		mw.W("class RESTClient%s {\n", UcNameJS(iface))
+		mw.W("/**\n")
+		mw.W("* @param {string} baseURL\n")
+		mw.W("* @param {function(string, Object): Promise<Response>} [fetchFn]\n")
+		mw.W("**/\n")
+		mw.W("constructor(baseURL, fetchFn = (input, init) => fetch(input, init)) {\n")
+		mw.W("this.requester = new RESTRequester(baseURL, fetchFn);\n")
+		mw.W("}\n\n")
+
+		for _, m := range ifaceType.Methods {
+			mw.W("/**\n")
+			if m.Comment != "" {
+				mw.W("* %s\n", m.Comment)
+				mw.W("*\n")
+			}
+			for _, p := range m.Sig.Params {
+				if plugin.IsContext(p) {
+					continue
+				}
+				for _, named := range extractNamed(p.Type) {
+					if _, ok := defTypes[named.ID()]; !ok {
+						defTypes[named.ID()] = named
+					}
+				}
+				mw.W("* @param {%s} %s\n", restJSDocType(p.Type, p.IsVariadic), p.Name)
+			}
+			if plugin.LenWithoutErrors(m.Sig.Results) > 0 {
+				mw.W("* @return {Promise<")
+				if plugin.DownloadFile(m.Sig.Results) != nil {
+					mw.W("Blob")
+				} else {
+					results := make([]string, 0, len(m.Sig.Results))
+					for _, p := range m.Sig.Results {
+						if plugin.IsError(p) {
+							continue
+						}
+						for _, named := range extractNamed(p.Type) {
+							if _, ok := defTypes[named.ID()]; !ok {
+								defTypes[named.ID()] = named
+							}
+						}
+						if plugin.LenWithoutErrors(m.Sig.Results) > 1 {
+							results = append(results, fmt.Sprintf("%s: %s", p.Name, jsDocType(p.Type)))
+						} else {
+							mw.W(jsDocType(p.Type))
+						}
+					}
+					if len(results) > 0 {
+						mw.W("{%s}", strings.Join(results, ","))
+					}
+				}
+				mw.W(">}\n")
+			}
+			for _, e := range g.methodErrors(iface, m) {
+				mw.W("* @throws {%s}\n", jsErrorName(iface, e))
+			}
+			mw.W("**/\n")
+
+			params := make([]string, 0, len(m.Sig.Params))
+			for _, p := range m.Sig.Params {
+				if plugin.IsContext(p) {
+					continue
+				}
+				name := p.Name.Value
+				if p.IsVariadic {
+					name = "..." + name
+				}
+				params = append(params, name)
+			}
+			mw.W("async %s(%s) {\n", m.Name.Lower(), strings.Join(params, ","))
+			writeFetchRequest(&mw, iface, m, g.MethodOptions[iface.Named.Name.Value+m.Name.Value], "")
+			mw.W("}\n")
+
+			if pagination, ok := makePagination(m, g.MethodOptions[iface.Named.Name.Value+m.Name.Value]); ok {
+				writePaginationJSIterator(&mw, m, pagination, false)
+			}
+		}
+		mw.W("}\n\n")
+	}
+
+	g.w.W(mw.String())
+
+	if len(g.Interfaces) > 1 {
+		g.w.W("class RESTClient {\n")
+		g.w.W("constructor(baseURL, fetchFn) {\n")
+		for _, iface := range g.Interfaces {
+			g.w.W("this.%s = new RESTClient%s(baseURL, fetchFn);\n", LcNameJS(iface), UcNameJS(iface))
+		}
+		g.w.W("}\n")
+		g.w.W("}\n")
+
+		g.w.W("export default RESTClient\n\n")
+	} else if len(g.Interfaces) == 1 {
+		g.w.W("export default RESTClient%s\n\n", UcNameJS(g.Interfaces[0]))
+	}
+
+	errorsDub := map[string]struct{}{}
+	for _, iface := range g.Interfaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+		for _, m := range ifaceType.Methods {
+			for _, e := range g.methodErrors(iface, m) {
+				errorName := jsErrorName(iface, e)
+				if _, ok := errorsDub[errorName]; ok {
+					continue
+				}
+				errorsDub[errorName] = struct{}{}
+				g.w.W(
+					"export class %[1]s extends RESTError {\nconstructor(message, data) {\nsuper(message, \"%[1]s\", %[2]d, %[3]s, data);\n}\n}\n",
+					errorName, e.Code, strconv.Quote(e.ErrCode),
+				)
+			}
+		}
+	}
+
+	for _, iface := range g.Interfaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+		for _, m := range ifaceType.Methods {
+			g.w.W("function %sConvertError(status, e) {\n", LcNameIfaceMethod(iface, m))
+			if g.ErrorFormat == "problem" {
+				g.w.W("const message = e.detail || e.title || \"\";\n")
+			} else {
+				g.w.W("const message = e.error || \"\";\n")
+			}
+			g.w.W("const errCode = e.code || \"\";\n")
+			var statusCodes []int64
+			errorsByStatus := map[int64][]config.Error{}
+			for _, e := range g.methodErrors(iface, m) {
+				if _, ok := errorsByStatus[e.Code]; !ok {
+					statusCodes = append(statusCodes, e.Code)
+				}
+				errorsByStatus[e.Code] = append(errorsByStatus[e.Code], e)
+			}
+			if len(statusCodes) > 0 {
+				g.w.W("switch (status) {\n")
+			}
+			for _, statusCode := range statusCodes {
+				g.w.W("case %d:\n", statusCode)
+				g.w.W("switch (errCode) {\n")
+				for _, e := range errorsByStatus[statusCode] {
+					g.w.W("case %s:\n", strconv.Quote(e.ErrCode))
+					g.w.W("return new %s(message, e.data);\n", jsErrorName(iface, e))
+				}
+				g.w.W("}\n")
+				g.w.W("break;\n")
+			}
+			if len(statusCodes) > 0 {
+				g.w.W("}\n")
+			}
+			g.w.W("return new RESTError(\"%s: \" + message, \"UnknownError\", status, errCode, e.data);\n", LcNameIfaceMethod(iface, m))
+			g.w.W("}\n")
+		}
+	}
+
+	for _, t := range defTypes {
+		switch t.Pkg.Path {
+		case "github.com/google/uuid", "github.com/pborman/uuid", "encoding/json", "time":
+			continue
+		}
+		if isFileUploadType(t) || plugin.IsFileDownloadType(t) {
+			continue
+		}
+		if len(t.Enums) > 0 {
+			g.w.W(jsEnum(t))
+			continue
+		}
+		g.w.W(jsTypeDef(t))
+	}
+	return g.w.Bytes()
+}
+
+func (g *RESTJSClientGenerator) methodErrors(iface *config.Interface, m *option.FuncType) (result []config.Error) {
+	errorsDub := map[string]struct{}{}
+	for _, e := range g.IfaceErrors[iface.Named.Name.Value][m.Name.Value] {
+		errorName := jsErrorName(iface, e)
+		if _, ok := errorsDub[errorName]; ok {
+			continue
+		}
+		errorsDub[errorName] = struct{}{}
+		result = append(result, e)
+	}
+	return
+}
+
+func (g *RESTJSClientGenerator) OutputPath() string {
+	return ""
+}
+
+func (g *RESTJSClientGenerator) Filename() string {
+	return "rest_client.js"
+}
+
+func restJSDocType(i interface{}, variadic bool) string {
+	t := jsDocType(i)
+	if isFileUploadType(i) {
+		t = "Blob"
+	}
+	if variadic {
+		if s, ok := i.(*option.SliceType); ok {
+			t = jsDocType(s.Value)
+		}
+		return "..." + t
+	}
+	return t
+}
+
+var fetchPathVarRegexp = regexp.MustCompile(`{([^}:]+)(:[^}]*)?}`)
+
+// writeFetchRequest writes the body of a fetch based REST client method, the result is cast
+// to resultType when it is not empty.
+func writeFetchRequest(w *writer.TextWriter, iface *config.Interface, m *option.FuncType, mopt config.MethodOptions, resultType string) {
+	httpMethod := strings.ToUpper(mopt.RESTMethod.Take())
+	if httpMethod == "" {
+		httpMethod = "GET"
+	}
+
+	bodyType := mopt.RESTBodyType.Take()
+	if bodyType == "" {
+		bodyType = "json"
+	}
+
+	var pathStr string
+	if mopt.RESTPath.IsValid() {
+		pathStr = mopt.RESTPath.Take()
+	} else {
+		pathStr = path.Join("/", strcase.ToKebab(m.Name.Value))
+	}
+	if prefix := restPrefix(iface, strcase.ToKebab(iface.Namespace)); prefix != "" {
+		pathStr = path.Join("/", prefix, "/", pathStr)
+	}
+
+	queryVars := make(map[string]string, len(mopt.RESTQueryVars.Value))
+	for i := 0; i < len(mopt.RESTQueryVars.Value); i += 2 {
+		queryVars[mopt.RESTQueryVars.Value[i+1]] = strings.TrimPrefix(mopt.RESTQueryVars.Value[i], "!")
+	}
+	headerVars := make(map[string]string, len(mopt.RESTHeaderVars.Value))
+	for i := 0; i < len(mopt.RESTHeaderVars.Value); i += 2 {
+		headerVars[mopt.RESTHeaderVars.Value[i+1]] = mopt.RESTHeaderVars.Value[i]
+	}
+
+	var (
+		queryParams  []*option.VarType
+		headerParams []*option.VarType
+		bodyParams   []*option.VarType
+		allParams    []*option.VarType
+	)
+	for _, p := range m.Sig.Params {
+		if plugin.IsContext(p) {
+			continue
+		}
+		allParams = append(allParams, p)
+		if _, ok := mopt.RESTPathVars[p.Name.Value]; ok {
+			continue
+		} else if _, ok := queryVars[p.Name.Value]; ok {
+			queryParams = append(queryParams, p)
+		} else if _, ok := headerVars[p.Name.Value]; ok {
+			headerParams = append(headerParams, p)
+		} else {
+			bodyParams = append(bodyParams, p)
+		}
+	}
+
+	pathExpr := fetchPathVarRegexp.ReplaceAllStringFunc(strings.ReplaceAll(pathStr, "`", "\\`"), func(s string) string {
+		name := fetchPathVarRegexp.FindStringSubmatch(s)[1]
+		if _, ok := mopt.RESTPathVars[name]; !ok {
+			return s
+		}
+		return "${encodeURIComponent(String(" + name + "))}"
+	})
+
+	w.W("const _query = new URLSearchParams();\n")
+	for _, p := range queryParams {
+		writeFetchOptionalValue(w, p, func() {
+			w.W("_query.append(%s, %s);\n", strconv.Quote(queryVars[p.Name.Value]), fetchStringValue(p.Name.Value, p.Type))
+		})
+	}
+	for i := 0; i < len(mopt.RESTQueryValues.Value); i += 2 {
+		w.W("_query.append(%s, %s);\n", strconv.Quote(mopt.RESTQueryValues.Value[i]), strconv.Quote(mopt.RESTQueryValues.Value[i+1]))
+	}
+
+	if resultType != "" {
+		w.W("const _headers: Record<string, string> = {};\n")
+	} else {
+		w.W("const _headers = {};\n")
+	}
+	for _, p := range headerParams {
+		writeFetchOptionalValue(w, p, func() {
+			w.W("_headers[%s] = %s;\n", strconv.Quote(headerVars[p.Name.Value]), fetchStringValue(p.Name.Value, p.Type))
+		})
+	}
+	if mopt.Idempotent != nil {
+		// the key is generated once per call, the retries made by the fetch function send the same key
+		w.W("_headers[%s] = newIdempotencyKey();\n", strconv.Quote(idempotencyKeyHeader))
+	}
+
+	bodyVar := "undefined"
+	switch httpMethod {
+	case "POST", "PUT", "PATCH":
+		if len(allParams) == 0 {
+			break
+		}
+		bodyVar = "_body"
+		switch bodyType {
+		case "json":
+			fields := make([]string, 0, len(allParams))
+			for _, p := range allParams {
+				fields = append(fields, fmt.Sprintf("%s: %s", strconv.Quote(p.Name.Value), p.Name.Value))
+			}
+			reqData := "{" + strings.Join(fields, ", ") + "}"
+			if wrapRequest := mopt.RESTWrapRequest.Take(); wrapRequest != "" {
+				parts := strings.Split(wrapRequest, ".")
+				for i := len(parts) - 1; i >= 0; i-- {
+					reqData = "{" + strconv.Quote(parts[i]) + ": " + reqData + "}"
+				}
+			}
+			w.W("_headers[\"Content-Type\"] = \"application/json\";\n")
+			w.W("const _body = JSON.stringify(%s);\n", reqData)
+		case "urlencoded":
+			w.W("const _body = new URLSearchParams();\n")
+			for _, p := range bodyParams {
+				writeFetchOptionalValue(w, p, func() {
+					w.W("_body.append(%s, %s);\n", strconv.Quote(p.Name.Value), fetchStringValue(p.Name.Value, p.Type))
+				})
+			}
+		case "multipart":
+			w.W("const _body = new FormData();\n")
+			for _, p := range bodyParams {
+				writeFetchOptionalValue(w, p, func() {
+					if isFileUploadType(p.Type) {
+						w.W("_body.append(%s, %s);\n", strconv.Quote(p.Name.Value), p.Name.Value)
+						return
+					}
+					w.W("_body.append(%s, %s);\n", strconv.Quote(p.Name.Value), fetchStringValue(p.Name.Value, p.Type))
+				})
+			}
+		}
+	}
+
+	w.W("const _resp = await this.requester.request(%s, `%s`, _query, _headers, %s);\n", strconv.Quote(httpMethod), pathExpr, bodyVar)
+	w.W("if (_resp.status > 299) {\n")
+	w.W("throw %sConvertError(_resp.status, await readErrorBody(_resp));\n", LcNameIfaceMethod(iface, m))
+	w.W("}\n")
+
+	switch {
+	case plugin.LenWithoutErrors(m.Sig.Results) == 0:
+	case plugin.DownloadFile(m.Sig.Results) != nil:
+		w.W("return _resp.blob();\n")
+	default:
+		w.W("const _data = await readJSON(_resp);\n")
+		dataExpr := "_data"
+		if wrapResponse := mopt.RESTWrapResponse.Take(); wrapResponse != "" {
+			for _, part := range strings.Split(wrapResponse, ".") {
+				dataExpr += "?.[" + strconv.Quote(part) + "]"
+			}
+		}
+		if resultType != "" {
+			w.W("return %s as %s;\n", dataExpr, resultType)
+		} else {
+			w.W("return %s;\n", dataExpr)
+		}
+	}
+}
+
+func writeFetchOptionalValue(w *writer.TextWriter, p *option.VarType, fn func()) {
+	if plugin.IsPointer(p.Type) {
+		w.W("if (%s != null) {\n", p.Name.Value)
+		fn()
+		w.W("}\n")
+		return
+	}
+	fn()
+}
+
+func fetchStringValue(name string, i interface{}) string {
+	switch t := i.(type) {
+	case *option.SliceType:
+		if b, ok := t.Value.(*option.BasicType); ok && !b.IsByte() {
+			return name + ".join(\",\")"
+		}
+	case *option.ArrayType:
+		return name + ".join(\",\")"
+	}
+	return "String(" + name + ")"
+}
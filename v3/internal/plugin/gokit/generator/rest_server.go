@@ -19,11 +19,16 @@ import (
 )
 
 type RESTServerGenerator struct {
-	w             writer.GoWriter
-	UseFast       bool
-	JSONRPCEnable bool
-	Interfaces    []*config.Interface
-	MethodOptions map[string]config.MethodOptions
+	w               writer.GoWriter
+	UseFast         bool
+	JSONRPCEnable   bool
+	TracingEnable   bool
+	CORS            *config.HTTPCORS
+	HealthEndpoints *struct{}
+	Codecs          *config.CodecsEnable
+	ErrorFormat     string
+	Interfaces      []*config.Interface
+	MethodOptions   map[string]config.MethodOptions
 }
 
 func (g *RESTServerGenerator) Generate(ctx context.Context) []byte {
@@ -65,7 +70,7 @@ func (g *RESTServerGenerator) Generate(ctx context.Context) []byte {
 			external = true
 			g.w.W("%s %sOption", LcNameWithAppPrefix(iface, true), UcNameWithAppPrefix(iface, true))
 		} else {
-			g.w.W("svc%s %s", iface.Named.Name.Upper(), typeStr)
+			g.w.W("%s %s", ServicePropName(iface), typeStr)
 		}
 	}
 
@@ -91,6 +96,33 @@ func (g *RESTServerGenerator) Generate(ctx context.Context) []byte {
 	g.w.W("opts.genericOpts.serverOption = append(opts.genericOpts.serverOption, %s.ServerErrorEncoder(opts.errorEncoder))\n", kitHTTPPkg)
 	g.w.W("}\n\n")
 
+	if g.TracingEnable {
+		g.w.W("opts.genericOpts.serverOption = append(opts.genericOpts.serverOption, %s.ServerBefore(tracingServerBefore))\n\n", kitHTTPPkg)
+	}
+	if g.ErrorFormat == "problem" && !g.UseFast {
+		// the request path is used as the problem instance
+		g.w.W("opts.genericOpts.serverOption = append(opts.genericOpts.serverOption, %[1]s.ServerBefore(%[1]s.PopulateRequestContext))\n\n", kitHTTPPkg)
+	}
+
+	if authSchemes := makeAuthSchemes(g.Interfaces, g.MethodOptions); authSchemes.enabled() {
+		writeAuthValidatorsCheck(&g.w, importer, authSchemes)
+		g.w.W("opts.genericOpts.serverOption = append(opts.genericOpts.serverOption, %s.ServerBefore(authServerBefore))\n\n", kitHTTPPkg)
+	}
+	if rateLimits := makeRateLimits(g.Interfaces, g.MethodOptions); len(rateLimits.keyHeaders) > 0 {
+		g.w.W("opts.genericOpts.serverOption = append(opts.genericOpts.serverOption, %s.ServerBefore(rateLimitServerBefore))\n\n", kitHTTPPkg)
+	}
+	if g.Codecs != nil {
+		g.w.W("codecs := newCodecRegistry(opts.codecs)\n")
+		g.w.W("opts.genericOpts.serverOption = append(opts.genericOpts.serverOption, %s.ServerBefore(codecServerBefore))\n\n", kitHTTPPkg)
+	}
+	if hasPagination(g.Interfaces, g.MethodOptions) {
+		g.w.W("opts.genericOpts.serverOption = append(opts.genericOpts.serverOption, %s.ServerBefore(paginationServerBefore))\n\n", kitHTTPPkg)
+	}
+	if hasIdempotency(g.Interfaces, g.MethodOptions) {
+		g.w.W("if opts.idempotencyStore == nil {\nopts.idempotencyStore = NewMemoryIdempotencyStore(DefaultIdempotencyTTL)\n}\n")
+		g.w.W("opts.genericOpts.serverOption = append(opts.genericOpts.serverOption, %s.ServerBefore(idempotencyServerBefore))\n\n", kitHTTPPkg)
+	}
+
 	for _, iface := range g.Interfaces {
 		optName := LcNameWithAppPrefix(iface, iface.Gateway != nil)
 		ifaceType := iface.Named.Type.(*option.IfaceType)
@@ -155,15 +187,21 @@ func (g *RESTServerGenerator) Generate(ctx context.Context) []byte {
 					"%[3]s.%[2]sEndpoint = middlewareChain(append(opts.genericOpts.endpointMiddleware, opts.%[1]sOpts.endpointMiddleware...))(%[3]s.%[2]sEndpoint)\n",
 					LcNameWithAppPrefix(iface)+m.Name.Upper(), m.Name, epSetName,
 				)
+				writeIdempotencyEndpointMiddleware(&g.w, iface, epSetName, m, g.MethodOptions[iface.Named.Name.Value+m.Name.Value])
+				writeAuthEndpointMiddleware(&g.w, epSetName, m, g.MethodOptions[iface.Named.Name.Value+m.Name.Value])
+				writeRateLimitEndpointMiddleware(&g.w, epSetName, m, g.MethodOptions[iface.Named.Name.Value+m.Name.Value])
 				g.w.W("}\n")
 			}
 		} else {
-			g.w.W("%s := Make%s(svc%s)\n", NameEndpointSetNameVar(iface), NameEndpointSetName(iface), iface.Named.Name.Upper())
+			g.w.W("%s := Make%s(%s)\n", NameEndpointSetNameVar(iface), NameEndpointSetName(iface), ServicePropName(iface))
 			for _, m := range ifaceType.Methods {
 				g.w.W(
 					"%[3]s.%[2]sEndpoint = middlewareChain(append(opts.genericOpts.endpointMiddleware, opts.%[1]sOpts.endpointMiddleware...))(%[3]s.%[2]sEndpoint)\n",
 					LcNameWithAppPrefix(iface)+m.Name.Upper(), m.Name, epSetName,
 				)
+				writeIdempotencyEndpointMiddleware(&g.w, iface, epSetName, m, g.MethodOptions[iface.Named.Name.Value+m.Name.Value])
+				writeAuthEndpointMiddleware(&g.w, epSetName, m, g.MethodOptions[iface.Named.Name.Value+m.Name.Value])
+				writeRateLimitEndpointMiddleware(&g.w, epSetName, m, g.MethodOptions[iface.Named.Name.Value+m.Name.Value])
 			}
 		}
 	}
@@ -172,6 +210,11 @@ func (g *RESTServerGenerator) Generate(ctx context.Context) []byte {
 	} else {
 		g.w.W("r := %s.NewRouter()\n", routerPkg)
 	}
+	if g.CORS != nil {
+		g.w.W("r.Use(corsMiddleware)\n")
+	}
+
+	var corsRoutes corsRoutes
 
 	for _, iface := range g.Interfaces {
 		ifaceType := iface.Named.Type.(*option.IfaceType)
@@ -181,7 +224,12 @@ func (g *RESTServerGenerator) Generate(ctx context.Context) []byte {
 
 			encRespFuncName := LcNameWithAppPrefix(iface) + m.Name.Upper()
 
-			g.w.W("%s := encodeResponseHTTP\n", encRespFuncName)
+			if g.Codecs != nil {
+				g.w.W("if _, err := codecs.get(%s); err != nil {\nreturn nil, err\n}\n", strconv.Quote(codecMediaType(mopt)))
+				g.w.W("%s := makeEncodeResponseHTTP(codecs)\n", encRespFuncName)
+			} else {
+				g.w.W("%s := encodeResponseHTTP\n", encRespFuncName)
+			}
 
 			bodyType := mopt.RESTBodyType.Take()
 			if bodyType == "" {
@@ -221,21 +269,21 @@ func (g *RESTServerGenerator) Generate(ctx context.Context) []byte {
 			} else {
 				urlPath = strcase.ToKebab(m.Name.Value)
 			}
-			if iface.Namespace != "" {
-				urlPath = path.Join(iface.Namespace, urlPath)
+			if prefix := restPrefix(iface, iface.Namespace); prefix != "" {
+				urlPath = path.Join(prefix, urlPath)
 			}
 			if !stdstrings.HasPrefix(urlPath, "/") {
 				urlPath = "/" + urlPath
 			}
 
+			httpMethod := "GET"
+			if mopt.RESTMethod.Take() != "" {
+				httpMethod = mopt.RESTMethod.Take()
+			}
+
 			if g.UseFast {
 				g.w.W("r.To(")
-				if mopt.RESTMethod.Take() != "" {
-					g.w.W(strconv.Quote(mopt.RESTMethod.Take()))
-				} else {
-					g.w.W(strconv.Quote("GET"))
-				}
-
+				g.w.W(strconv.Quote(httpMethod))
 				g.w.W(", ")
 
 				// replace brace indices for fasthttp router
@@ -246,12 +294,13 @@ func (g *RESTServerGenerator) Generate(ctx context.Context) []byte {
 
 				g.w.W(", ")
 			} else {
-				g.w.W("r.Methods(%s,", strconv.Quote("OPTIONS"))
-				if mopt.RESTMethod.Take() != "" {
-					g.w.W(strconv.Quote(mopt.RESTMethod.Take()))
+				// preflight requests are served by the generated CORS handlers
+				if g.CORS != nil {
+					g.w.W("r.Methods(")
 				} else {
-					g.w.W(strconv.Quote("GET"))
+					g.w.W("r.Methods(%s,", strconv.Quote("OPTIONS"))
 				}
+				g.w.W(strconv.Quote(httpMethod))
 				g.w.W(").")
 				g.w.W("Path(")
 
@@ -260,6 +309,14 @@ func (g *RESTServerGenerator) Generate(ctx context.Context) []byte {
 				g.w.W(").")
 				g.w.W("Handler(")
 			}
+			deprecation, deprecated := iface.DeprecatedMethods[m.Name.Value]
+			if deprecated {
+				deprecationValue, sunsetValue := deprecationHeaderValues(deprecation)
+				g.w.W("deprecationHandler(%s, %s, ", strconv.Quote(deprecationValue), strconv.Quote(sunsetValue))
+			}
+			if mopt.Idempotent != nil {
+				g.w.W("idempotencyHandler(opts.idempotencyStore, ")
+			}
 			g.w.W(
 				"%s.NewServer(\n%s.%sEndpoint,\n",
 				kitHTTPPkg,
@@ -383,18 +440,31 @@ func (g *RESTServerGenerator) Generate(ctx context.Context) []byte {
 									g.w.W("return nil, %s.Errorf(\"couldn't read body for %s: %%w\", err)\n", fmtPkg, nameRequest)
 								})
 							}
+							unmarshal := func() {
+								if g.Codecs == nil {
+									g.w.W("err = %s.Unmarshal(data, &req)\n", jsonPkg)
+									return
+								}
+								contentType := "r.Header.Get(\"Content-Type\")"
+								if g.UseFast {
+									contentType = "string(r.Header.ContentType())"
+								}
+								g.w.W("codec, ok := codecs.lookup(%s, codecs[%s])\n", contentType, strconv.Quote(codecMediaType(mopt)))
+								g.w.W("if !ok {\nreturn nil, &UnsupportedMediaTypeError{ContentType: %s}\n}\n", contentType)
+								g.w.W("err = codec.Unmarshal(data, &req)\n")
+							}
 							if len(paramVars) == 1 {
 								if s, ok := paramVars[0].Type.(*option.SliceType); ok {
 									if b, ok := s.Value.(*option.BasicType); ok && b.IsByte() {
 										g.w.W("req%s = data\n", "."+paramVars[0].Name.Upper())
 									} else {
-										g.w.W("err = %s.Unmarshal(data, &req)\n", jsonPkg)
+										unmarshal()
 									}
 								} else {
-									g.w.W("err = %s.Unmarshal(data, &req)\n", jsonPkg)
+									unmarshal()
 								}
 							} else {
-								g.w.W("err = %s.Unmarshal(data, &req)\n", jsonPkg)
+								unmarshal()
 							}
 							g.w.W("if err != nil && err != %s.EOF {\n", pkgIO)
 							g.w.W("return nil, %s.Errorf(\"couldn't unmarshal body to %s: %%w\", err)\n", fmtPkg, nameRequest)
@@ -486,7 +556,8 @@ func (g *RESTServerGenerator) Generate(ctx context.Context) []byte {
 			}
 			g.w.W("},\n")
 
-			if mopt.RESTWrapResponse.Take() != "" {
+			pagination, paginated := makePagination(m, mopt)
+			if mopt.RESTWrapResponse.Take() != "" || paginated || mopt.Idempotent != nil {
 				var responseWriterType string
 				if g.UseFast {
 					responseWriterType = fmt.Sprintf("*%s.Response", httpPkg)
@@ -494,7 +565,17 @@ func (g *RESTServerGenerator) Generate(ctx context.Context) []byte {
 					responseWriterType = fmt.Sprintf("%s.ResponseWriter", httpPkg)
 				}
 				g.w.W("func (ctx context.Context, w %s, response interface{}) error {\n", responseWriterType)
-				g.w.W("return %s(ctx, w, %s)\n", encRespFuncName, wrapDataServer(stdstrings.Split(mopt.RESTWrapResponse.Take(), ".")))
+				if mopt.Idempotent != nil {
+					g.w.W("if replay, ok := response.(*IdempotencyResponse); ok {\nreturn writeIdempotencyResponse(w, replay)\n}\n")
+				}
+				if paginated {
+					writePaginationResponseHeaders(&g.w, importer, g.UseFast, iface, m, mopt, pagination)
+				}
+				if mopt.RESTWrapResponse.Take() != "" {
+					g.w.W("return %s(ctx, w, %s)\n", encRespFuncName, wrapDataServer(stdstrings.Split(mopt.RESTWrapResponse.Take(), ".")))
+				} else {
+					g.w.W("return %s(ctx, w, response)\n", encRespFuncName)
+				}
 				g.w.W("}")
 			} else {
 				g.w.W(encRespFuncName)
@@ -507,9 +588,24 @@ func (g *RESTServerGenerator) Generate(ctx context.Context) []byte {
 			if g.UseFast {
 				g.w.W(".RouterHandle()")
 			}
+			if mopt.Idempotent != nil {
+				g.w.W(")")
+			}
+			if deprecated {
+				g.w.W(")")
+			}
 			g.w.W(")\n")
+
+			corsMethod := stdstrings.ToUpper(httpMethod)
+			corsRoutes.add(urlPath, corsMethod, corsRESTHeaders(corsMethod, m, mopt))
 		}
 	}
+	if g.CORS != nil {
+		writeCORSPreflightRoutes(&g.w, g.UseFast, g.CORS, corsRoutes)
+	}
+	if g.HealthEndpoints != nil {
+		writeHealthRoutes(&g.w, g.UseFast)
+	}
 	if g.UseFast {
 		g.w.W("return r.HandleRequest, nil\n")
 	} else {
@@ -520,6 +616,93 @@ func (g *RESTServerGenerator) Generate(ctx context.Context) []byte {
 	return g.w.Bytes()
 }
 
+// writeProblemErrorEncoder writes the error encoder that responds with RFC 7807 problem details,
+// the error code and data are sent as the code and data extension members.
+func (g *RESTServerGenerator) writeProblemErrorEncoder(contextPkg string, httpPkg string, kitHTTPPkg string, jsonPkg string) {
+	g.w.W("type problemDetails struct {\n")
+	g.w.W("Type string `json:\"type\"`\n")
+	g.w.W("Title string `json:\"title\"`\n")
+	g.w.W("Status int `json:\"status\"`\n")
+	g.w.W("Detail string `json:\"detail,omitempty\"`\n")
+	g.w.W("Instance string `json:\"instance,omitempty\"`\n")
+	g.w.W("Code string `json:\"code,omitempty\"`\n")
+	g.w.W("Data interface{} `json:\"data,omitempty\"`\n")
+	g.w.W("}\n\n")
+
+	g.w.W("func defaultErrorEncoder(ctx %s.Context, err error, ", contextPkg)
+	if g.UseFast {
+		g.w.W("w *%s.RequestCtx) {\n", httpPkg)
+	} else {
+		g.w.W("w %s.ResponseWriter) {\n", httpPkg)
+	}
+
+	g.w.W("problem := problemDetails{Type: \"about:blank\", Status: %s.StatusInternalServerError, Detail: err.Error()}\n", httpPkg)
+	g.w.W("if sc, ok := err.(%s.StatusCoder); ok {\n", kitHTTPPkg)
+	g.w.W("problem.Status = sc.StatusCode()\n")
+	g.w.W("}\n")
+	if g.UseFast {
+		g.w.W("problem.Title = %s.StatusMessage(problem.Status)\n", httpPkg)
+	} else {
+		g.w.W("problem.Title = %s.StatusText(problem.Status)\n", httpPkg)
+	}
+	g.w.W("if e, ok := err.(interface{ ProblemType() string }); ok {\n")
+	g.w.W("problem.Type = e.ProblemType()\n")
+	g.w.W("}\n")
+	g.w.W("if e, ok := err.(interface{ Code() string }); ok {\n")
+	g.w.W("problem.Code = e.Code()\n")
+	g.w.W("}\n")
+	g.w.W("if e, ok := err.(interface{ Data() interface{} }); ok {\n")
+	g.w.W("problem.Data = e.Data()\n")
+	g.w.W("}\n")
+	if g.UseFast {
+		g.w.W("problem.Instance = string(w.Path())\n")
+	} else {
+		g.w.W("if path, ok := ctx.Value(%s.ContextKeyRequestPath).(string); ok {\n", kitHTTPPkg)
+		g.w.W("problem.Instance = path\n")
+		g.w.W("}\n")
+	}
+
+	g.w.W("data, jsonErr := %s.Marshal(problem)\n", jsonPkg)
+	g.w.W("if jsonErr != nil {\n")
+	if g.UseFast {
+		g.w.W("w.SetBody([]byte(")
+	} else {
+		g.w.W("_, _ = w.Write([]byte(")
+	}
+	g.w.W("%s))\n", strconv.Quote("unexpected marshal error"))
+	g.w.W("return\n")
+	g.w.W("}\n")
+
+	if g.UseFast {
+		g.w.W("w.Response.Header")
+	} else {
+		g.w.W("w.Header()")
+	}
+	g.w.W(".Set(\"Content-Type\", \"application/problem+json\")\n")
+
+	g.w.W("if headerer, ok := err.(%s.Headerer); ok {\n", kitHTTPPkg)
+	if g.UseFast {
+		g.w.W("for k, v := range headerer.Headers() {\n")
+		g.w.W("w.Response.Header.Add(k, v)")
+		g.w.W("}\n")
+	} else {
+		g.w.W("for k, values := range headerer.Headers() {\n")
+		g.w.W("for _, v := range values {\n")
+		g.w.W("w.Header().Add(k, v)")
+		g.w.W("}\n}\n")
+	}
+	g.w.W("}\n")
+
+	if g.UseFast {
+		g.w.W("w.SetStatusCode(problem.Status)\n")
+		g.w.W("w.SetBody(data)\n")
+	} else {
+		g.w.W("w.WriteHeader(problem.Status)\n")
+		g.w.W("_, _ = w.Write(data)\n")
+	}
+	g.w.W("}\n\n")
+}
+
 func (g *RESTServerGenerator) OutputPath() string {
 	return ""
 }
@@ -531,14 +714,27 @@ func (g *RESTServerGenerator) Filename() string {
 func (g *RESTServerGenerator) writeEncodeResponseFunc(contextPkg, httpPkg, jsonPkg string) {
 	g.w.W("type downloader interface {\nContentType() string\nData() []byte\n}\n\n")
 
-	g.w.W("func encodeResponseHTTP(ctx %s.Context, ", contextPkg)
+	var responseWriterType string
 	if g.UseFast {
-		g.w.W("w *%s.Response", httpPkg)
+		responseWriterType = "*" + httpPkg + ".Response"
 	} else {
-		g.w.W("w %s.ResponseWriter", httpPkg)
+		responseWriterType = httpPkg + ".ResponseWriter"
+	}
+
+	marshal := jsonPkg + ".Marshal"
+	if g.Codecs != nil {
+		marshal = "codec.Marshal"
+
+		g.w.W("// makeEncodeResponseHTTP makes the response encoder that marshals the response with the codec negotiated by the Accept header.\n")
+		g.w.W("func makeEncodeResponseHTTP(codecs codecRegistry) func(%s.Context, %s, interface{}) error {\n", contextPkg, responseWriterType)
+		g.w.W("return func(ctx %s.Context, w %s, response interface{}) (err error) {\n", contextPkg, responseWriterType)
+		g.w.W("accept, _ := ctx.Value(codecAcceptContextKey{}).(string)\n")
+		g.w.W("codec := codecs.negotiate(accept)\n")
+		g.w.W("contentType := codec.ContentType()\n")
+	} else {
+		g.w.W("func encodeResponseHTTP(ctx %s.Context, w %s, response interface{}) (err error) {\n", contextPkg, responseWriterType)
+		g.w.W("contentType := \"application/json; charset=utf-8\"\n")
 	}
-	g.w.W(", response interface{}) (err error) {\n")
-	g.w.W("contentType := \"application/json; charset=utf-8\"\n")
 	g.w.W("statusCode := 200\n")
 
 	g.w.W("var data []byte\n")
@@ -555,7 +751,7 @@ func (g *RESTServerGenerator) writeEncodeResponseFunc(contextPkg, httpPkg, jsonP
 	g.w.W("contentType = download.ContentType()\n")
 	g.w.W("data = download.Data()\n")
 	g.w.W("} else {\n")
-	g.w.W("data, err = %s.Marshal(response)\n", jsonPkg)
+	g.w.W("data, err = %s(response)\n", marshal)
 	g.w.W("if err != nil {\n")
 	g.w.W("return err\n")
 	g.w.W("}\n")
@@ -577,9 +773,16 @@ func (g *RESTServerGenerator) writeEncodeResponseFunc(contextPkg, httpPkg, jsonP
 
 	g.w.W("return nil\n")
 	g.w.W("}\n\n")
+	if g.Codecs != nil {
+		g.w.W("}\n\n")
+	}
 }
 
 func (g *RESTServerGenerator) writeDefaultErrorEncoder(contextPkg string, httpPkg string, kitHTTPPkg string, jsonPkg string) {
+	if g.ErrorFormat == "problem" {
+		g.writeProblemErrorEncoder(contextPkg, httpPkg, kitHTTPPkg, jsonPkg)
+		return
+	}
 	g.w.W("type errorWrapper struct {\n")
 	g.w.W("Error string `json:\"error\"`\n")
 	g.w.W("Code string `json:\"code,omitempty\"`\n")
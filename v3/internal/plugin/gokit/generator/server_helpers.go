@@ -16,6 +16,12 @@ type ServerHelpers struct {
 	JSONRPCEnable    bool
 	HTTPServerEnable bool
 	UseFast          bool
+	TracingEnable    bool
+	CORS             *config.HTTPCORS
+	HealthEndpoints  *struct{}
+	Codecs           *config.CodecsEnable
+	MethodOptions    map[string]config.MethodOptions
+	AppName          string
 	Output           string
 	Pkg              string
 }
@@ -28,6 +34,10 @@ func (g *ServerHelpers) Generate(ctx context.Context) []byte {
 		var (
 			kitHTTPPkg string
 		)
+
+		authSchemes := makeAuthSchemes(g.Interfaces, g.MethodOptions)
+		rateLimits := makeRateLimits(g.Interfaces, g.MethodOptions)
+		idempotencyEnable := !g.JSONRPCEnable && hasIdempotency(g.Interfaces, g.MethodOptions)
 		if g.JSONRPCEnable {
 			if g.UseFast {
 				kitHTTPPkg = importer.Import("jsonrpc", "github.com/l-vitaly/go-kit/transport/fasthttp/jsonrpc")
@@ -76,8 +86,27 @@ func (g *ServerHelpers) Generate(ctx context.Context) []byte {
 		g.w.W("func GenericServerOptions(opt ...Option) ServerOption {\nreturn func(c *serverOpts) {\nfor _, o := range opt {\no(&c.genericOpts)\n}\n}\n}\n\n")
 		g.w.W("func ErrorEncoderOption(opt %s.ErrorEncoder) ServerOption {\nreturn func(c *serverOpts) {\n c.errorEncoder = opt\n}\n}\n\n", kitHTTPPkg)
 
+		writeAuthServerOptions(&g.w, authSchemes)
+		writeRateLimitServerOptions(&g.w, rateLimits)
+		writeCodecServerOptions(&g.w, g.Codecs)
+		if idempotencyEnable {
+			writeIdempotencyServerOptions(&g.w)
+		}
+		if g.HealthEndpoints != nil {
+			writeHealthServerOptions(&g.w, importer)
+		}
+
 		g.w.W("type %s struct {\n", serverOptType)
 		g.w.W("errorEncoder %s.ErrorEncoder\n", kitHTTPPkg)
+		writeAuthServerOptsFields(&g.w, authSchemes)
+		writeRateLimitServerOptsFields(&g.w, rateLimits)
+		writeCodecServerOptsFields(&g.w, g.Codecs)
+		if idempotencyEnable {
+			writeIdempotencyServerOptsFields(&g.w)
+		}
+		if g.HealthEndpoints != nil {
+			writeHealthServerOptsFields(&g.w)
+		}
 		g.w.W("genericOpts opts\n")
 		for _, iface := range g.Interfaces {
 			ifaceType := iface.Named.Type.(*option.IfaceType)
@@ -96,6 +125,49 @@ func (g *ServerHelpers) Generate(ctx context.Context) []byte {
 				g.w.W("func %sOptions(opt ...Option) ServerOption {\nreturn func(c *serverOpts) {\nfor _, o := range opt {\no(&c.%s.opts)\n}\n}\n}\n\n", serverOptFuncName, serverOptName)
 			}
 		}
+
+		if g.TracingEnable {
+			writeTracingPropagation(&g.w, importer, g.UseFast, "tracingServerBefore", false)
+		}
+		if authSchemes.enabled() {
+			writeAuthServer(&g.w, importer, g.UseFast, g.JSONRPCEnable, authSchemes)
+		}
+		if rateLimits.enabled {
+			writeRateLimitServer(&g.w, importer, g.UseFast, rateLimits)
+		}
+		paginationEnable := !g.JSONRPCEnable && hasPagination(g.Interfaces, g.MethodOptions)
+		deprecationEnable := !g.JSONRPCEnable && hasDeprecations(g.Interfaces)
+		if g.CORS != nil {
+			var exposeHeaders []string
+			if paginationEnable {
+				exposeHeaders = append(exposeHeaders, paginationHeaders...)
+			}
+			if deprecationEnable {
+				exposeHeaders = append(exposeHeaders, deprecationHeaders...)
+			}
+			if idempotencyEnable {
+				exposeHeaders = append(exposeHeaders, idempotencyReplayedHeader)
+			}
+			writeCORSServer(&g.w, importer, g.UseFast, g.CORS, exposeHeaders)
+		}
+		if g.Codecs != nil {
+			writeCodecs(&g.w, importer, g.UseFast, true, g.Codecs)
+			if g.JSONRPCEnable {
+				writeCodecJSONRPC(&g.w, importer, g.UseFast, true)
+			}
+		}
+		if paginationEnable {
+			writePaginationServer(&g.w, importer, g.UseFast)
+		}
+		if deprecationEnable {
+			writeDeprecationServer(&g.w, importer, g.UseFast)
+		}
+		if idempotencyEnable {
+			writeIdempotencyServer(&g.w, importer, g.UseFast, authSchemes)
+		}
+		if g.HealthEndpoints != nil {
+			writeHealthServer(&g.w, importer, g.UseFast, g.AppName, makeServiceMethods(g.Interfaces, g.MethodOptions, g.JSONRPCEnable))
+		}
 	}
 
 	return g.w.Bytes()
@@ -0,0 +1,772 @@
+package generator
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/swipe-io/strcase"
+	"github.com/swipe-io/swipe/v3/internal/plugin"
+	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/config"
+	"github.com/swipe-io/swipe/v3/option"
+	"github.com/swipe-io/swipe/v3/writer"
+)
+
+const swiftClientBase = `import Foundation
+#if canImport(FoundationNetworking)
+import FoundationNetworking
+#endif
+
+public enum JSONValue: Codable, Equatable {
+    case null
+    case bool(Bool)
+    case number(Double)
+    case string(String)
+    case array([JSONValue])
+    case object([String: JSONValue])
+
+    public init(from decoder: Decoder) throws {
+        let container = try decoder.singleValueContainer()
+        if container.decodeNil() {
+            self = .null
+        } else if let v = try? container.decode(Bool.self) {
+            self = .bool(v)
+        } else if let v = try? container.decode(Double.self) {
+            self = .number(v)
+        } else if let v = try? container.decode(String.self) {
+            self = .string(v)
+        } else if let v = try? container.decode([JSONValue].self) {
+            self = .array(v)
+        } else {
+            self = .object(try container.decode([String: JSONValue].self))
+        }
+    }
+
+    public func encode(to encoder: Encoder) throws {
+        var container = encoder.singleValueContainer()
+        switch self {
+        case .null:
+            try container.encodeNil()
+        case .bool(let v):
+            try container.encode(v)
+        case .number(let v):
+            try container.encode(v)
+        case .string(let v):
+            try container.encode(v)
+        case .array(let v):
+            try container.encode(v)
+        case .object(let v):
+            try container.encode(v)
+        }
+    }
+}
+
+public struct HTTPResponse {
+    public let status: Int
+    public let body: Data
+
+    public init(status: Int, body: Data) {
+        self.status = status
+        self.body = body
+    }
+}
+
+public protocol HTTPTransport {
+    func send(_ request: URLRequest) async throws -> HTTPResponse
+}
+
+public struct URLSessionTransport: HTTPTransport {
+    public let session: URLSession
+
+    public init(session: URLSession = .shared) {
+        self.session = session
+    }
+
+    public func send(_ request: URLRequest) async throws -> HTTPResponse {
+        let (data, response) = try await session.data(for: request)
+        return HTTPResponse(status: (response as? HTTPURLResponse)?.statusCode ?? 0, body: data)
+    }
+}
+
+struct DynamicKey: CodingKey {
+    var stringValue: String
+    var intValue: Int? { nil }
+
+    init(stringValue: String) {
+        self.stringValue = stringValue
+    }
+
+    init?(intValue: Int) {
+        return nil
+    }
+}
+
+func toStr(_ value: Any) -> String {
+    switch value {
+    case let v as Bool:
+        return v ? "true" : "false"
+    case let v as [Any]:
+        return v.map(toStr).joined(separator: ",")
+    case let v as any RawRepresentable:
+        return toStr(v.rawValue)
+    default:
+        return "\(value)"
+    }
+}
+
+private let pathAllowed = CharacterSet.urlPathAllowed.subtracting(CharacterSet(charactersIn: "/"))
+
+func pathEscape(_ value: String) -> String {
+    return value.addingPercentEncoding(withAllowedCharacters: pathAllowed) ?? value
+}
+
+func formEncode(_ items: [URLQueryItem]) -> String {
+    var components = URLComponents()
+    components.queryItems = items
+    return (components.percentEncodedQuery ?? "").replacingOccurrences(of: "+", with: "%s")
+}
+
+func buildRequest(_ baseURL: URL, _ method: String, _ path: String, _ query: [URLQueryItem], _ headers: [String: String], _ body: Data?) -> URLRequest {
+    var url = baseURL.absoluteString
+    while url.hasSuffix("/") {
+        url.removeLast()
+    }
+    url += path
+    if !query.isEmpty {
+        url += "?" + formEncode(query)
+    }
+    var request = URLRequest(url: URL(string: url)!)
+    request.httpMethod = method
+    request.httpBody = body
+    for (key, value) in headers {
+        request.setValue(value, forHTTPHeaderField: key)
+    }
+    return request
+}
+
+`
+
+const swiftJSONRPCClientBase = `struct JSONRPCErrorBody: Decodable {
+    var code: Int
+    var message: String
+    var data: JSONValue?
+}
+
+struct JSONRPCRequestBody<P: Encodable>: Encodable {
+    let jsonrpc: String
+    let id: Int
+    let method: String
+    let params: P
+}
+
+struct JSONRPCResponseBody<R: Decodable>: Decodable {
+    let result: R?
+    let error: JSONRPCErrorBody?
+}
+
+final class JSONRPCRequestID {
+    private var id = 0
+    private let lock = NSLock()
+
+    func next() -> Int {
+        lock.lock()
+        defer { lock.unlock() }
+        id += 1
+        return id
+    }
+}
+
+let jsonRPCRequestID = JSONRPCRequestID()
+
+`
+
+const swiftRESTClientBase = `struct RESTErrorBody: Decodable {
+    var error: String?
+    var title: String?
+    var detail: String?
+    var code: String?
+    var data: JSONValue?
+}
+
+func readErrorBody(_ body: Data) -> RESTErrorBody {
+    return (try? JSONDecoder().decode(RESTErrorBody.self, from: body)) ?? RESTErrorBody()
+}
+
+struct Wrapped<T: Encodable>: Encodable {
+    let path: [String]
+    let value: T
+
+    func encode(to encoder: Encoder) throws {
+        var container = encoder.container(keyedBy: DynamicKey.self)
+        for key in path.dropLast() {
+            container = container.nestedContainer(keyedBy: DynamicKey.self, forKey: DynamicKey(stringValue: key))
+        }
+        try container.encode(value, forKey: DynamicKey(stringValue: path[path.count - 1]))
+    }
+}
+
+private let wrapPathKey = CodingUserInfoKey(rawValue: "wrapPath")!
+
+struct Unwrapped<T: Decodable>: Decodable {
+    let value: T
+
+    init(from decoder: Decoder) throws {
+        let path = decoder.userInfo[wrapPathKey] as? [String] ?? []
+        var container = try decoder.container(keyedBy: DynamicKey.self)
+        for key in path.dropLast() {
+            container = try container.nestedContainer(keyedBy: DynamicKey.self, forKey: DynamicKey(stringValue: key))
+        }
+        value = try container.decode(T.self, forKey: DynamicKey(stringValue: path[path.count - 1]))
+    }
+}
+
+func decodeWrapped<T: Decodable>(_ type: T.Type, from data: Data, path: [String]) throws -> T {
+    let decoder = JSONDecoder()
+    decoder.userInfo[wrapPathKey] = path
+    return try decoder.decode(Unwrapped<T>.self, from: data).value
+}
+
+func multipartBody(_ fields: [(String, Any)]) -> (String, Data) {
+    let boundary = UUID().uuidString.replacingOccurrences(of: "-", with: "")
+    var body = Data()
+    for (name, value) in fields {
+        body.append(Data("--\(boundary)\r\n".utf8))
+        if let data = value as? Data {
+            body.append(Data("Content-Disposition: form-data; name=\"\(name)\"; filename=\"\(name)\"\r\n".utf8))
+            body.append(Data("Content-Type: application/octet-stream\r\n\r\n".utf8))
+            body.append(data)
+        } else {
+            body.append(Data("Content-Disposition: form-data; name=\"\(name)\"\r\n\r\n".utf8))
+            body.append(Data(toStr(value).utf8))
+        }
+        body.append(Data("\r\n".utf8))
+    }
+    body.append(Data("--\(boundary)--\r\n".utf8))
+    return ("multipart/form-data; boundary=\(boundary)", body)
+}
+
+`
+
+type SwiftClientGenerator struct {
+	w             writer.GoWriter
+	JSONRPCEnable bool
+	ErrorFormat   string
+	Interfaces    []*config.Interface
+	MethodOptions map[string]config.MethodOptions
+	IfaceErrors   map[string]map[string][]config.Error
+	Output        string
+}
+
+func (g *SwiftClientGenerator) Generate(ctx context.Context) []byte {
+	g.w.W(swiftClientBase, "%2B")
+
+	if g.JSONRPCEnable {
+		g.w.W("%s", swiftJSONRPCClientBase)
+	} else {
+		g.w.W("%s", swiftRESTClientBase)
+	}
+
+	var defTypes []*option.NamedType
+	defTypesDub := map[string]struct{}{}
+	addDefTypes := func(i interface{}) {
+		for _, named := range extractNamed(i) {
+			if _, ok := defTypesDub[named.ID()]; ok {
+				continue
+			}
+			defTypesDub[named.ID()] = struct{}{}
+			if _, ok := swiftExternalType(named); ok {
+				continue
+			}
+			if _, ok := named.Type.(*option.IfaceType); ok {
+				continue
+			}
+			if isFileUploadType(named) || plugin.IsFileDownloadType(named) {
+				continue
+			}
+			defTypes = append(defTypes, named)
+		}
+	}
+
+	for _, iface := range g.Interfaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+		for _, m := range ifaceType.Methods {
+			for _, p := range m.Sig.Params {
+				if !plugin.IsContext(p) {
+					addDefTypes(p.Type)
+				}
+			}
+			for _, r := range m.Sig.Results {
+				if !plugin.IsError(r) {
+					addDefTypes(r.Type)
+				}
+			}
+		}
+	}
+
+	for _, t := range defTypes {
+		g.w.W("%s", swiftTypeDef(t))
+	}
+
+	for _, iface := range g.Interfaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+		for _, m := range ifaceType.Methods {
+			if g.hasRequestStruct(iface, m) {
+				var fields []structField
+				for _, p := range m.Sig.Params {
+					if !plugin.IsContext(p) {
+						fields = append(fields, structField{Name: p.Name.Value, Var: p})
+					}
+				}
+				g.w.W("%s", swiftStruct(NameRequest(m, iface), "Encodable", "", fields))
+			}
+			if plugin.LenWithoutErrors(m.Sig.Results) > 1 {
+				var fields []structField
+				for _, r := range m.Sig.Results {
+					if !plugin.IsError(r) {
+						fields = append(fields, structField{Name: r.Name.Value, Var: r})
+					}
+				}
+				g.w.W("%s", swiftStruct(NameResponse(m, iface), "Codable", "public ", fields))
+			}
+		}
+	}
+
+	for _, iface := range g.Interfaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+		errorsDub := map[string]struct{}{}
+		g.w.W("public enum %s: Error {\n", g.errorName(iface))
+		for _, m := range ifaceType.Methods {
+			for _, e := range g.IfaceErrors[iface.Named.Name.Value][m.Name.Value] {
+				caseName := g.errorCaseName(e)
+				if _, ok := errorsDub[caseName]; ok {
+					continue
+				}
+				errorsDub[caseName] = struct{}{}
+				g.w.W("    case %s(message: String, data: JSONValue?)\n", caseName)
+			}
+		}
+		g.w.W("    case unknown(code: Int, errCode: String, message: String, data: JSONValue?)\n")
+		g.w.W("}\n\n")
+	}
+
+	for _, iface := range g.Interfaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+		for _, m := range ifaceType.Methods {
+			g.writeConvertError(iface, m)
+		}
+	}
+
+	clientName := "RESTClient"
+	urlName := "baseURL"
+	if g.JSONRPCEnable {
+		clientName = "JSONRPCClient"
+		urlName = "url"
+	}
+
+	for _, iface := range g.Interfaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+
+		g.w.W("public final class %s%s {\n", clientName, UcNameJS(iface))
+		g.w.W("    private let %s: URL\n", urlName)
+		g.w.W("    private let headers: [String: String]\n")
+		g.w.W("    private let transport: HTTPTransport\n\n")
+		g.w.W("    public init(%[1]s: URL, headers: [String: String] = [:], transport: HTTPTransport = URLSessionTransport()) {\n", urlName)
+		g.w.W("        self.%[1]s = %[1]s\n", urlName)
+		g.w.W("        self.headers = headers\n")
+		g.w.W("        self.transport = transport\n")
+		g.w.W("    }\n\n")
+
+		if g.JSONRPCEnable {
+			g.w.W("    private func call<P: Encodable, R: Decodable>(_ method: String, _ params: P, _ result: R.Type) async throws -> JSONRPCResponseBody<R> {\n")
+			g.w.W("        let body = try JSONEncoder().encode(JSONRPCRequestBody(jsonrpc: \"2.0\", id: jsonRPCRequestID.next(), method: method, params: params))\n")
+			g.w.W("        let headers = self.headers.merging([\"Content-Type\": \"application/json\"]) { _, new in new }\n")
+			g.w.W("        let response = try await transport.send(buildRequest(url, \"POST\", \"\", [], headers, body))\n")
+			g.w.W("        return try JSONDecoder().decode(JSONRPCResponseBody<R>.self, from: response.body)\n")
+			g.w.W("    }\n")
+		} else {
+			g.w.W("    private func send(_ method: String, _ path: String, _ query: [URLQueryItem], _ headers: [String: String], _ body: Data?) async throws -> HTTPResponse {\n")
+			g.w.W("        let headers = self.headers.merging(headers) { _, new in new }\n")
+			g.w.W("        return try await transport.send(buildRequest(baseURL, method, path, query, headers, body))\n")
+			g.w.W("    }\n")
+		}
+
+		for _, m := range ifaceType.Methods {
+			g.w.W("\n")
+			doc := strings.TrimSpace(m.Comment)
+			if errs := g.IfaceErrors[iface.Named.Name.Value][m.Name.Value]; len(errs) > 0 {
+				if doc != "" {
+					doc += "\n"
+				}
+				doc += "- Throws: " + g.errorName(iface)
+			}
+			for _, line := range strings.Split(doc, "\n") {
+				if line != "" {
+					g.w.W("    /// %s\n", line)
+				}
+			}
+			if isDeprecated(iface, m) {
+				g.w.W("    @available(*, deprecated)\n")
+			}
+			g.w.W("    public func %s(", swiftName(m.Name.Value))
+			var params []string
+			for _, p := range m.Sig.Params {
+				if plugin.IsContext(p) {
+					continue
+				}
+				if st, ok := p.Type.(*option.SliceType); ok && p.IsVariadic {
+					params = append(params, swiftName(p.Name.Value)+": "+swiftType(st.Value)+"...")
+				} else {
+					params = append(params, swiftName(p.Name.Value)+": "+swiftType(p.Type))
+				}
+			}
+			g.w.W("%s) async throws", strings.Join(params, ", "))
+			if resultType := g.resultType(iface, m); resultType != "" {
+				g.w.W(" -> %s", resultType)
+			}
+			g.w.W(" {\n")
+
+			if g.JSONRPCEnable {
+				g.writeJSONRPCMethod(iface, m)
+			} else {
+				g.writeRESTMethod(iface, m)
+			}
+			g.w.W("    }\n")
+		}
+		g.w.W("}\n\n")
+	}
+
+	if len(g.Interfaces) > 1 {
+		g.w.W("public final class %s {\n", clientName)
+		for _, iface := range g.Interfaces {
+			g.w.W("    public let %s: %s%s\n", swiftName(LcNameJS(iface)), clientName, UcNameJS(iface))
+		}
+		g.w.W("\n    public init(%s: URL, headers: [String: String] = [:], transport: HTTPTransport = URLSessionTransport()) {\n", urlName)
+		for _, iface := range g.Interfaces {
+			g.w.W("        self.%s = %s%s(%s: %s, headers: headers, transport: transport)\n", strings.Trim(swiftName(LcNameJS(iface)), "`"), clientName, UcNameJS(iface), urlName, urlName)
+		}
+		g.w.W("    }\n")
+		g.w.W("}\n")
+	} else if len(g.Interfaces) == 1 {
+		g.w.W("public typealias %[1]s = %[1]s%[2]s\n", clientName, UcNameJS(g.Interfaces[0]))
+	}
+	return g.w.Bytes()
+}
+
+func (g *SwiftClientGenerator) errorName(iface *config.Interface) string {
+	return UcNameWithAppPrefix(iface) + "Error"
+}
+
+func (g *SwiftClientGenerator) errorCaseName(e config.Error) string {
+	return swiftName(singular(e.Name))
+}
+
+func (g *SwiftClientGenerator) methodErrors(iface *config.Interface, m *option.FuncType) (result []config.Error) {
+	errorsDub := map[string]struct{}{}
+	for _, e := range g.IfaceErrors[iface.Named.Name.Value][m.Name.Value] {
+		caseName := g.errorCaseName(e)
+		if _, ok := errorsDub[caseName]; ok {
+			continue
+		}
+		errorsDub[caseName] = struct{}{}
+		result = append(result, e)
+	}
+	return
+}
+
+func (g *SwiftClientGenerator) hasRequestStruct(iface *config.Interface, m *option.FuncType) bool {
+	if g.JSONRPCEnable {
+		return true
+	}
+	mopt := g.MethodOptions[iface.Named.Name.Value+m.Name.Value]
+	switch strings.ToUpper(mopt.RESTMethod.Take()) {
+	case "POST", "PUT", "PATCH":
+	default:
+		return false
+	}
+	if bodyType := mopt.RESTBodyType.Take(); bodyType != "" && bodyType != "json" {
+		return false
+	}
+	for _, p := range m.Sig.Params {
+		if !plugin.IsContext(p) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *SwiftClientGenerator) resultType(iface *config.Interface, m *option.FuncType) string {
+	resultsLen := plugin.LenWithoutErrors(m.Sig.Results)
+	if resultsLen == 0 {
+		return ""
+	}
+	if resultsLen > 1 {
+		return NameResponse(m, iface)
+	}
+	for _, r := range m.Sig.Results {
+		if !plugin.IsError(r) {
+			return swiftType(r.Type)
+		}
+	}
+	return ""
+}
+
+func (g *SwiftClientGenerator) writeConvertError(iface *config.Interface, m *option.FuncType) {
+	methodErrors := g.methodErrors(iface, m)
+	funcName := LcNameIfaceMethod(iface, m) + "ConvertError"
+	prefix := strconv.Quote(LcNameIfaceMethod(iface, m) + ": ")
+
+	if g.JSONRPCEnable {
+		g.w.W("func %s(_ e: JSONRPCErrorBody) -> %s {\n", funcName, g.errorName(iface))
+		if len(methodErrors) > 0 {
+			g.w.W("    switch e.code {\n")
+			for _, e := range methodErrors {
+				g.w.W("    case %d:\n", e.Code)
+				g.w.W("        return .%s(message: e.message, data: e.data)\n", g.errorCaseName(e))
+			}
+			g.w.W("    default:\n")
+			g.w.W("        break\n")
+			g.w.W("    }\n")
+		}
+		g.w.W("    return .unknown(code: e.code, errCode: \"\", message: %s + e.message, data: e.data)\n", prefix)
+		g.w.W("}\n\n")
+		return
+	}
+
+	g.w.W("func %s(_ status: Int, _ e: RESTErrorBody) -> %s {\n", funcName, g.errorName(iface))
+	if g.ErrorFormat == "problem" {
+		g.w.W("    let message = e.detail ?? e.title ?? \"\"\n")
+	} else {
+		g.w.W("    let message = e.error ?? \"\"\n")
+	}
+	g.w.W("    let errCode = e.code ?? \"\"\n")
+	for _, e := range methodErrors {
+		g.w.W("    if status == %d && errCode == %s {\n", e.Code, strconv.Quote(e.ErrCode))
+		g.w.W("        return .%s(message: message, data: e.data)\n", g.errorCaseName(e))
+		g.w.W("    }\n")
+	}
+	g.w.W("    return .unknown(code: status, errCode: errCode, message: %s + message, data: e.data)\n", prefix)
+	g.w.W("}\n\n")
+}
+
+func (g *SwiftClientGenerator) requestValue(iface *config.Interface, m *option.FuncType) string {
+	var args []string
+	for _, p := range m.Sig.Params {
+		if !plugin.IsContext(p) {
+			args = append(args, strings.Trim(swiftName(p.Name.Value), "`")+": "+swiftName(p.Name.Value))
+		}
+	}
+	return NameRequest(m, iface) + "(" + strings.Join(args, ", ") + ")"
+}
+
+func (g *SwiftClientGenerator) writeResult(iface *config.Interface, m *option.FuncType, decode func(resultType string) string) {
+	if plugin.LenWithoutErrors(m.Sig.Results) == 0 {
+		return
+	}
+	g.w.W("        return %s\n", decode(g.resultType(iface, m)))
+}
+
+func (g *SwiftClientGenerator) writeJSONRPCMethod(iface *config.Interface, m *option.FuncType) {
+	var prefix string
+	if ns := rpcPrefix(iface); ns != "" {
+		prefix = ns + "."
+	}
+	resultType := g.resultType(iface, m)
+	if resultType == "" {
+		resultType = "JSONValue"
+	}
+	g.w.W("        let response = try await call(%s, %s, %s.self)\n", strconv.Quote(prefix+m.Name.Lower()), g.requestValue(iface, m), resultType)
+	g.w.W("        if let error = response.error {\n")
+	g.w.W("            throw %sConvertError(error)\n", LcNameIfaceMethod(iface, m))
+	g.w.W("        }\n")
+	if plugin.LenWithoutErrors(m.Sig.Results) == 0 {
+		return
+	}
+	if strings.HasSuffix(resultType, "?") {
+		g.w.W("        return response.result ?? nil\n")
+		return
+	}
+	g.w.W("        guard let result = response.result else {\n")
+	g.w.W("            throw %s.unknown(code: 0, errCode: \"\", message: %s, data: nil)\n", g.errorName(iface), strconv.Quote(LcNameIfaceMethod(iface, m)+": empty result"))
+	g.w.W("        }\n")
+	g.w.W("        return result\n")
+}
+
+func (g *SwiftClientGenerator) writeRESTMethod(iface *config.Interface, m *option.FuncType) {
+	mopt := g.MethodOptions[iface.Named.Name.Value+m.Name.Value]
+
+	httpMethod := strings.ToUpper(mopt.RESTMethod.Take())
+	if httpMethod == "" {
+		httpMethod = "GET"
+	}
+
+	bodyType := mopt.RESTBodyType.Take()
+	if bodyType == "" {
+		bodyType = "json"
+	}
+
+	var pathStr string
+	if mopt.RESTPath.IsValid() {
+		pathStr = mopt.RESTPath.Take()
+	} else {
+		pathStr = path.Join("/", strcase.ToKebab(m.Name.Value))
+	}
+	if prefix := restPrefix(iface, strcase.ToKebab(iface.Namespace)); prefix != "" {
+		pathStr = path.Join("/", prefix, "/", pathStr)
+	}
+
+	queryVars := make(map[string]string, len(mopt.RESTQueryVars.Value))
+	for i := 0; i < len(mopt.RESTQueryVars.Value); i += 2 {
+		queryVars[mopt.RESTQueryVars.Value[i+1]] = strings.TrimPrefix(mopt.RESTQueryVars.Value[i], "!")
+	}
+	headerVars := make(map[string]string, len(mopt.RESTHeaderVars.Value))
+	for i := 0; i < len(mopt.RESTHeaderVars.Value); i += 2 {
+		headerVars[mopt.RESTHeaderVars.Value[i+1]] = mopt.RESTHeaderVars.Value[i]
+	}
+
+	var (
+		queryParams  []*option.VarType
+		headerParams []*option.VarType
+		bodyParams   []*option.VarType
+		allParams    []*option.VarType
+	)
+	for _, p := range m.Sig.Params {
+		if plugin.IsContext(p) {
+			continue
+		}
+		allParams = append(allParams, p)
+		if _, ok := mopt.RESTPathVars[p.Name.Value]; ok {
+			continue
+		} else if _, ok := queryVars[p.Name.Value]; ok {
+			queryParams = append(queryParams, p)
+		} else if _, ok := headerVars[p.Name.Value]; ok {
+			headerParams = append(headerParams, p)
+		} else {
+			bodyParams = append(bodyParams, p)
+		}
+	}
+
+	var pathParts []string
+	var last int
+	for _, loc := range fetchPathVarRegexp.FindAllStringSubmatchIndex(pathStr, -1) {
+		name := pathStr[loc[2]:loc[3]]
+		if _, ok := mopt.RESTPathVars[name]; !ok {
+			continue
+		}
+		if loc[0] > last {
+			pathParts = append(pathParts, strconv.Quote(pathStr[last:loc[0]]))
+		}
+		pathParts = append(pathParts, "pathEscape(toStr("+swiftName(name)+"))")
+		last = loc[1]
+	}
+	if last < len(pathStr) || len(pathParts) == 0 {
+		pathParts = append(pathParts, strconv.Quote(pathStr[last:]))
+	}
+
+	optionalValue := func(p *option.VarType, fn func(indent, value string)) {
+		if plugin.IsPointer(p.Type) {
+			g.w.W("        if let %[1]s = %[1]s {\n", swiftName(p.Name.Value))
+			fn("            ", swiftName(p.Name.Value))
+			g.w.W("        }\n")
+			return
+		}
+		fn("        ", swiftName(p.Name.Value))
+	}
+
+	var hasBody bool
+	switch httpMethod {
+	case "POST", "PUT", "PATCH":
+		hasBody = len(allParams) > 0
+	}
+
+	queryDecl := "let"
+	if len(queryParams) > 0 || len(mopt.RESTQueryValues.Value) > 0 {
+		queryDecl = "var"
+	}
+	headersDecl := "let"
+	if len(headerParams) > 0 || hasBody {
+		headersDecl = "var"
+	}
+
+	g.w.W("        %s _query: [URLQueryItem] = []\n", queryDecl)
+	for _, p := range queryParams {
+		optionalValue(p, func(indent, value string) {
+			g.w.W("%s_query.append(URLQueryItem(name: %s, value: toStr(%s)))\n", indent, strconv.Quote(queryVars[p.Name.Value]), value)
+		})
+	}
+	for i := 0; i < len(mopt.RESTQueryValues.Value); i += 2 {
+		g.w.W("        _query.append(URLQueryItem(name: %s, value: %s))\n", strconv.Quote(mopt.RESTQueryValues.Value[i]), strconv.Quote(mopt.RESTQueryValues.Value[i+1]))
+	}
+
+	g.w.W("        %s _headers: [String: String] = [:]\n", headersDecl)
+	for _, p := range headerParams {
+		optionalValue(p, func(indent, value string) {
+			g.w.W("%s_headers[%s] = toStr(%s)\n", indent, strconv.Quote(headerVars[p.Name.Value]), value)
+		})
+	}
+
+	bodyExpr := "nil"
+	if hasBody {
+		bodyExpr = "_body"
+		switch bodyType {
+		case "json":
+			reqData := g.requestValue(iface, m)
+			if wrapRequest := mopt.RESTWrapRequest.Take(); wrapRequest != "" {
+				parts := make([]string, 0, strings.Count(wrapRequest, ".")+1)
+				for _, part := range strings.Split(wrapRequest, ".") {
+					parts = append(parts, strconv.Quote(part))
+				}
+				reqData = "Wrapped(path: [" + strings.Join(parts, ", ") + "], value: " + reqData + ")"
+			}
+			g.w.W("        _headers[\"Content-Type\"] = \"application/json\"\n")
+			g.w.W("        let _body = try JSONEncoder().encode(%s)\n", reqData)
+		case "urlencoded":
+			g.w.W("        var _form: [URLQueryItem] = []\n")
+			for _, p := range bodyParams {
+				optionalValue(p, func(indent, value string) {
+					g.w.W("%s_form.append(URLQueryItem(name: %s, value: toStr(%s)))\n", indent, strconv.Quote(p.Name.Value), value)
+				})
+			}
+			g.w.W("        _headers[\"Content-Type\"] = \"application/x-www-form-urlencoded; charset=utf-8\"\n")
+			g.w.W("        let _body = Data(formEncode(_form).utf8)\n")
+		case "multipart":
+			g.w.W("        var _fields: [(String, Any)] = []\n")
+			for _, p := range bodyParams {
+				optionalValue(p, func(indent, value string) {
+					g.w.W("%s_fields.append((%s, %s))\n", indent, strconv.Quote(p.Name.Value), value)
+				})
+			}
+			g.w.W("        let (_contentType, _body) = multipartBody(_fields)\n")
+			g.w.W("        _headers[\"Content-Type\"] = _contentType\n")
+		default:
+			bodyExpr = "nil"
+		}
+	}
+
+	g.w.W("        let _response = try await send(%s, %s, _query, _headers, %s)\n", strconv.Quote(httpMethod), strings.Join(pathParts, " + "), bodyExpr)
+	g.w.W("        if _response.status > 299 {\n")
+	g.w.W("            throw %sConvertError(_response.status, readErrorBody(_response.body))\n", LcNameIfaceMethod(iface, m))
+	g.w.W("        }\n")
+
+	g.writeResult(iface, m, func(resultType string) string {
+		if plugin.DownloadFile(m.Sig.Results) != nil {
+			return "_response.body"
+		}
+		if wrapResponse := mopt.RESTWrapResponse.Take(); wrapResponse != "" {
+			parts := make([]string, 0, strings.Count(wrapResponse, ".")+1)
+			for _, part := range strings.Split(wrapResponse, ".") {
+				parts = append(parts, strconv.Quote(part))
+			}
+			return "try decodeWrapped(" + resultType + ".self, from: _response.body, path: [" + strings.Join(parts, ", ") + "])"
+		}
+		return "try JSONDecoder().decode(" + resultType + ".self, from: _response.body)"
+	})
+}
+
+func (g *SwiftClientGenerator) OutputPath() string {
+	return g.Output
+}
+
+func (g *SwiftClientGenerator) Filename() string {
+	return "client.swift"
+}
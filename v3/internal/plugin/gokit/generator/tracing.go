@@ -0,0 +1,94 @@
+package generator
+
+import (
+	"context"
+
+	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/config"
+	"github.com/swipe-io/swipe/v3/internal/tracing"
+	"github.com/swipe-io/swipe/v3/option"
+	"github.com/swipe-io/swipe/v3/swipe"
+	"github.com/swipe-io/swipe/v3/writer"
+)
+
+type Tracing struct {
+	w             writer.GoWriter
+	Interfaces    []*config.Interface
+	MethodOptions map[string]config.MethodOptions
+}
+
+func (g *Tracing) Generate(ctx context.Context) []byte {
+	importer := ctx.Value(swipe.ImporterKey).(swipe.Importer)
+
+	var interfaces []tracing.Interface
+	for _, iface := range g.Interfaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+		tracingInterface := tracing.Interface{
+			Name:     iface.Named.Name.Value,
+			TypeName: NameInterface(iface),
+			LcName:   LcNameWithAppPrefix(iface),
+			UcName:   UcNameWithAppPrefix(iface),
+		}
+		for _, method := range ifaceType.Methods {
+			mopt := g.MethodOptions[iface.Named.Name.Value+method.Name.Value]
+			tracingMethod := tracing.Method{
+				Name:   method.Name,
+				Sig:    method.Sig,
+				Enable: mopt.Tracing.Take(),
+			}
+			for _, param := range mopt.TracingParams.Includes {
+				tracingMethod.ParamsIncludes = append(tracingMethod.ParamsIncludes, param)
+			}
+			for _, param := range mopt.TracingParams.Excludes {
+				tracingMethod.ParamsExcludes = append(tracingMethod.ParamsExcludes, param)
+			}
+			tracingInterface.Methods = append(tracingInterface.Methods, tracingMethod)
+		}
+		interfaces = append(interfaces, tracingInterface)
+	}
+
+	data := tracing.NewTracing(importer).SetInterfaces(interfaces).Build()
+	_, _ = g.w.Write(data)
+
+	return g.w.Bytes()
+}
+
+func (g *Tracing) OutputPath() string {
+	return ""
+}
+
+func (g *Tracing) Filename() string {
+	return "tracing.go"
+}
+
+func writeTracingPropagation(w *writer.GoWriter, importer swipe.Importer, useFast bool, funcName string, inject bool) {
+	contextPkg := importer.Import("context", "context")
+	otelPkg := importer.Import("otel", "go.opentelemetry.io/otel")
+
+	var carrier string
+	if useFast {
+		httpPkg := importer.Import("fasthttp", "github.com/valyala/fasthttp")
+
+		w.W("type fastHTTPHeaderCarrier struct {\nh *%s.RequestHeader\n}\n\n", httpPkg)
+		w.W("func (c fastHTTPHeaderCarrier) Get(key string) string {\nreturn string(c.h.Peek(key))\n}\n\n")
+		w.W("func (c fastHTTPHeaderCarrier) Set(key string, value string) {\nc.h.Set(key, value)\n}\n\n")
+		w.W("func (c fastHTTPHeaderCarrier) Keys() []string {\nkeys := make([]string, 0, c.h.Len())\n")
+		w.W("c.h.VisitAll(func(key, _ []byte) {\nkeys = append(keys, string(key))\n})\n")
+		w.W("return keys\n}\n\n")
+
+		w.W("func %s(ctx %s.Context, r *%s.Request) %s.Context {\n", funcName, contextPkg, httpPkg, contextPkg)
+		carrier = "fastHTTPHeaderCarrier{h: &r.Header}"
+	} else {
+		httpPkg := importer.Import("http", "net/http")
+		propagationPkg := importer.Import("propagation", "go.opentelemetry.io/otel/propagation")
+
+		w.W("func %s(ctx %s.Context, r *%s.Request) %s.Context {\n", funcName, contextPkg, httpPkg, contextPkg)
+		carrier = propagationPkg + ".HeaderCarrier(r.Header)"
+	}
+	if inject {
+		w.W("%s.GetTextMapPropagator().Inject(ctx, %s)\n", otelPkg, carrier)
+		w.W("return ctx\n")
+	} else {
+		w.W("return %s.GetTextMapPropagator().Extract(ctx, %s)\n", otelPkg, carrier)
+	}
+	w.W("}\n\n")
+}
@@ -0,0 +1,490 @@
+package generator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/swipe-io/swipe/v3/internal/plugin"
+	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/config"
+	"github.com/swipe-io/swipe/v3/option"
+	"github.com/swipe-io/swipe/v3/writer"
+)
+
+const tsClientErrorBase = `
+export class APIError extends Error {
+  constructor(
+    message: string,
+    readonly code: number,
+    readonly data?: unknown,
+    readonly errCode?: string
+  ) {
+    super(message);
+    Object.setPrototypeOf(this, new.target.prototype);
+    this.name = "APIError";
+  }
+}
+
+export class UnknownError extends APIError {
+  readonly kind = "UnknownError" as const;
+  constructor(message: string, code: number, data?: unknown, errCode?: string) {
+    super(message, code, data, errCode);
+    this.name = "UnknownError";
+  }
+}
+`
+
+const tsJSONRPCClientBase = `
+export interface JSONRPCRequest {
+  jsonrpc: "2.0";
+  id: number;
+  method: string;
+  params: unknown;
+}
+
+export interface JSONRPCErrorObject {
+  code: number;
+  message: string;
+  data?: unknown;
+}
+
+export interface JSONRPCResponse {
+  jsonrpc: "2.0";
+  id: number;
+  result?: unknown;
+  error?: JSONRPCErrorObject;
+}
+
+export interface JSONRPCTransport {
+  doRequest(requests: JSONRPCRequest[]): PromiseLike<JSONRPCResponse[]>;
+}
+
+interface JSONRPCSchedule {
+  request: JSONRPCRequest;
+  resolve: (result: unknown) => void;
+  reject: (reason: unknown) => void;
+}
+
+function isJSONRPCErrorObject(e: unknown): e is JSONRPCErrorObject {
+  return typeof e === "object" && e !== null && typeof (e as JSONRPCErrorObject).code === "number";
+}
+
+class JSONRPCScheduler {
+  private requestID = 0;
+  private scheduleRequests: Record<number, JSONRPCSchedule> = {};
+  private commitTimerID: ReturnType<typeof setTimeout> | null = null;
+
+  constructor(private readonly transport: JSONRPCTransport) {}
+
+  private scheduleCommit(): void {
+    if (this.commitTimerID) {
+      clearTimeout(this.commitTimerID);
+    }
+    this.commitTimerID = setTimeout(() => {
+      this.commitTimerID = null;
+      const scheduleRequests = this.scheduleRequests;
+      this.scheduleRequests = {};
+      const requests = Object.values(scheduleRequests).map((s) => s.request);
+      this.transport.doRequest(requests).then(
+        (responses) => {
+          for (const response of responses) {
+            const schedule = scheduleRequests[response.id];
+            if (!schedule) {
+              continue;
+            }
+            if (response.error) {
+              schedule.reject(response.error);
+              continue;
+            }
+            schedule.resolve(response.result);
+          }
+        },
+        (e) => {
+          for (const request of requests) {
+            scheduleRequests[request.id].reject(e);
+          }
+        }
+      );
+    }, 0);
+  }
+
+  scheduleRequest(method: string, params: unknown): Promise<unknown> {
+    const p = new Promise<unknown>((resolve, reject) => {
+      const request: JSONRPCRequest = {
+        jsonrpc: "2.0",
+        id: ++this.requestID,
+        method,
+        params,
+      };
+      this.scheduleRequests[request.id] = { request, resolve, reject };
+    });
+    this.scheduleCommit();
+    return p;
+  }
+}
+`
+
+const tsRESTClientBase = `
+export type FetchFunction = (input: string, init: RequestInit) => Promise<Response>;
+
+class RESTRequester {
+  constructor(
+    private readonly baseURL: string,
+    private readonly fetchFn: FetchFunction
+  ) {}
+
+  request(
+    method: string,
+    path: string,
+    query: URLSearchParams,
+    headers: Record<string, string>,
+    body?: BodyInit
+  ): Promise<Response> {
+    const qs = query.toString();
+    const url = this.baseURL.replace(/\/+$/, "") + path + (qs ? "?" + qs : "");
+    return this.fetchFn(url, { method, headers, body });
+  }
+}
+
+async function readErrorBody(resp: Response): Promise<RESTErrorBody> {
+  try {
+    return (await resp.json()) as RESTErrorBody;
+  } catch (e) {
+    return {};
+  }
+}
+
+async function readJSON(resp: Response): Promise<any> {
+  const text = await resp.text();
+  return text === "" ? null : JSON.parse(text);
+}
+`
+
+type TSClientGenerator struct {
+	w             writer.GoWriter
+	JSONRPCEnable bool
+	ErrorFormat   string
+	Interfaces    []*config.Interface
+	MethodOptions map[string]config.MethodOptions
+	IfaceErrors   map[string]map[string][]config.Error
+}
+
+func (g *TSClientGenerator) Generate(ctx context.Context) []byte {
+	g.w.W(tsClientErrorBase)
+
+	if g.JSONRPCEnable {
+		g.w.W(tsJSONRPCClientBase)
+	} else {
+		g.w.W("\nexport interface RESTErrorBody {\n")
+		if g.ErrorFormat == "problem" {
+			g.w.W("type?: string;\ntitle?: string;\nstatus?: number;\ndetail?: string;\ninstance?: string;\n")
+		} else {
+			g.w.W("error?: string;\n")
+		}
+		g.w.W("code?: string;\ndata?: unknown;\n}\n")
+		g.w.W(tsRESTClientBase)
+		if hasIdempotency(g.Interfaces, g.MethodOptions) {
+			g.w.W(tsIdempotencyBase)
+		}
+	}
+
+	var defTypes []*option.NamedType
+	defTypesDub := map[string]struct{}{}
+	addDefTypes := func(i interface{}) {
+		for _, named := range extractNamed(i) {
+			if _, ok := defTypesDub[named.ID()]; ok {
+				continue
+			}
+			defTypesDub[named.ID()] = struct{}{}
+			if _, ok := tsExternalType(named); ok {
+				continue
+			}
+			if _, ok := named.Type.(*option.IfaceType); ok {
+				continue
+			}
+			defTypes = append(defTypes, named)
+		}
+	}
+
+	mw := writer.TextWriter{}
+
+	for _, iface := range g.Interfaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+
+		if g.JSONRPCEnable {
+			mw.W("export class JSONRPCClient%s {\n", UcNameJS(iface))
+			mw.W("private readonly scheduler: JSONRPCScheduler;\n\n")
+			mw.W("constructor(transport: JSONRPCTransport) {\n")
+			mw.W("this.scheduler = new JSONRPCScheduler(transport);\n")
+			mw.W("}\n\n")
+		} else {
+			mw.W("export class RESTClient%s {\n", UcNameJS(iface))
+			mw.W("private readonly requester: RESTRequester;\n\n")
+			mw.W("constructor(baseURL: string, fetchFn: FetchFunction = (input, init) => fetch(input, init)) {\n")
+			mw.W("this.requester = new RESTRequester(baseURL, fetchFn);\n")
+			mw.W("}\n\n")
+		}
+
+		for _, m := range ifaceType.Methods {
+			for _, p := range m.Sig.Params {
+				if !plugin.IsContext(p) {
+					addDefTypes(p.Type)
+				}
+			}
+			for _, r := range m.Sig.Results {
+				if !plugin.IsError(r) {
+					addDefTypes(r.Type)
+				}
+			}
+
+			doc := m.Comment
+			for _, e := range g.methodErrors(iface, m) {
+				doc += "\n@throws {" + jsErrorName(iface, e) + "}"
+			}
+			mw.W(tsDoc(doc, isDeprecated(iface, m)))
+
+			if g.JSONRPCEnable {
+				g.writeJSONRPCMethod(&mw, iface, m)
+			} else {
+				g.writeRESTMethod(&mw, iface, m)
+			}
+		}
+		mw.W("}\n\n")
+	}
+
+	for _, t := range defTypes {
+		g.w.W(tsTypeDef(t))
+	}
+
+	errorsDub := map[string]struct{}{}
+	for _, iface := range g.Interfaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+		for _, m := range ifaceType.Methods {
+			for _, e := range g.methodErrors(iface, m) {
+				errorName := jsErrorName(iface, e)
+				if _, ok := errorsDub[errorName]; ok {
+					continue
+				}
+				errorsDub[errorName] = struct{}{}
+				g.w.W("export class %s extends APIError {\n", errorName)
+				g.w.W("readonly kind = %s as const;\n", strconv.Quote(errorName))
+				g.w.W("constructor(message: string, data?: unknown) {\n")
+				if g.JSONRPCEnable {
+					g.w.W("super(message, %d, data);\n", e.Code)
+				} else {
+					g.w.W("super(message, %d, data, %s);\n", e.Code, strconv.Quote(e.ErrCode))
+				}
+				g.w.W("this.name = %s;\n", strconv.Quote(errorName))
+				g.w.W("}\n}\n\n")
+			}
+		}
+	}
+
+	for _, iface := range g.Interfaces {
+		ifaceType := iface.Named.Type.(*option.IfaceType)
+		for _, m := range ifaceType.Methods {
+			g.writeErrorType(iface, m)
+			g.writeConvertError(iface, m)
+		}
+	}
+
+	g.w.W(mw.String())
+
+	clientName := "RESTClient"
+	if g.JSONRPCEnable {
+		clientName = "JSONRPCClient"
+	}
+
+	if len(g.Interfaces) > 1 {
+		g.w.W("export class %s {\n", clientName)
+		for _, iface := range g.Interfaces {
+			g.w.W("readonly %s: %s%s;\n", LcNameJS(iface), clientName, UcNameJS(iface))
+		}
+		if g.JSONRPCEnable {
+			g.w.W("\nconstructor(transport: JSONRPCTransport) {\n")
+		} else {
+			g.w.W("\nconstructor(baseURL: string, fetchFn?: FetchFunction) {\n")
+		}
+		for _, iface := range g.Interfaces {
+			if g.JSONRPCEnable {
+				g.w.W("this.%s = new %s%s(transport);\n", LcNameJS(iface), clientName, UcNameJS(iface))
+			} else {
+				g.w.W("this.%s = new %s%s(baseURL, fetchFn);\n", LcNameJS(iface), clientName, UcNameJS(iface))
+			}
+		}
+		g.w.W("}\n")
+		g.w.W("}\n\n")
+
+		g.w.W("export default %s;\n", clientName)
+	} else if len(g.Interfaces) == 1 {
+		g.w.W("export default %s%s;\n", clientName, UcNameJS(g.Interfaces[0]))
+	}
+	return g.w.Bytes()
+}
+
+func (g *TSClientGenerator) methodErrors(iface *config.Interface, m *option.FuncType) (result []config.Error) {
+	errorsDub := map[string]struct{}{}
+	for _, e := range g.IfaceErrors[iface.Named.Name.Value][m.Name.Value] {
+		errorName := jsErrorName(iface, e)
+		if _, ok := errorsDub[errorName]; ok {
+			continue
+		}
+		errorsDub[errorName] = struct{}{}
+		result = append(result, e)
+	}
+	return
+}
+
+func (g *TSClientGenerator) writeErrorType(iface *config.Interface, m *option.FuncType) {
+	errorNames := []string{"UnknownError"}
+	for _, e := range g.methodErrors(iface, m) {
+		errorNames = append(errorNames, jsErrorName(iface, e))
+	}
+	g.w.W("export type %sError = %s;\n\n", UcNameIfaceMethod(iface, m), strings.Join(errorNames, " | "))
+}
+
+func (g *TSClientGenerator) writeConvertError(iface *config.Interface, m *option.FuncType) {
+	methodErrors := g.methodErrors(iface, m)
+	errorType := UcNameIfaceMethod(iface, m) + "Error"
+
+	if g.JSONRPCEnable {
+		g.w.W("function %sConvertError(e: JSONRPCErrorObject): %s {\n", LcNameIfaceMethod(iface, m), errorType)
+		g.w.W("switch (e.code) {\n")
+		for _, e := range methodErrors {
+			g.w.W("case %d:\n", e.Code)
+			g.w.W("return new %s(e.message, e.data);\n", jsErrorName(iface, e))
+		}
+		g.w.W("default:\n")
+		g.w.W("return new UnknownError(%s + e.message, e.code, e.data);\n", strconv.Quote(LcNameIfaceMethod(iface, m)+": "))
+		g.w.W("}\n}\n\n")
+		return
+	}
+
+	g.w.W("function %sConvertError(status: number, e: RESTErrorBody): %s {\n", LcNameIfaceMethod(iface, m), errorType)
+	if g.ErrorFormat == "problem" {
+		g.w.W("const message = e.detail || e.title || \"\";\n")
+	} else {
+		g.w.W("const message = e.error || \"\";\n")
+	}
+	g.w.W("const errCode = e.code || \"\";\n")
+
+	var statusCodes []int64
+	errorsByStatus := map[int64][]config.Error{}
+	for _, e := range methodErrors {
+		if _, ok := errorsByStatus[e.Code]; !ok {
+			statusCodes = append(statusCodes, e.Code)
+		}
+		errorsByStatus[e.Code] = append(errorsByStatus[e.Code], e)
+	}
+	if len(statusCodes) > 0 {
+		g.w.W("switch (status) {\n")
+		for _, statusCode := range statusCodes {
+			g.w.W("case %d:\n", statusCode)
+			g.w.W("switch (errCode) {\n")
+			errCodeDub := map[string]struct{}{}
+			for _, e := range errorsByStatus[statusCode] {
+				if _, ok := errCodeDub[e.ErrCode]; ok {
+					continue
+				}
+				errCodeDub[e.ErrCode] = struct{}{}
+				g.w.W("case %s:\n", strconv.Quote(e.ErrCode))
+				g.w.W("return new %s(message, e.data);\n", jsErrorName(iface, e))
+			}
+			g.w.W("}\n")
+			g.w.W("break;\n")
+		}
+		g.w.W("}\n")
+	}
+	g.w.W("return new UnknownError(%s + message, status, e.data, errCode);\n", strconv.Quote(LcNameIfaceMethod(iface, m)+": "))
+	g.w.W("}\n\n")
+}
+
+func (g *TSClientGenerator) writeParams(w *writer.TextWriter, m *option.FuncType) {
+	params := make([]string, 0, len(m.Sig.Params))
+	for _, p := range m.Sig.Params {
+		if plugin.IsContext(p) {
+			continue
+		}
+		if p.IsVariadic {
+			params = append(params, fmt.Sprintf("...%s: %s", p.Name.Value, tsType(p.Type)))
+		} else {
+			params = append(params, fmt.Sprintf("%s: %s", p.Name.Value, tsType(p.Type)))
+		}
+	}
+	w.W(strings.Join(params, ", "))
+}
+
+func (g *TSClientGenerator) resultType(m *option.FuncType) string {
+	resultsLen := plugin.LenWithoutErrors(m.Sig.Results)
+	if resultsLen == 0 {
+		return "void"
+	}
+	if resultsLen > 1 {
+		results := make([]string, 0, resultsLen)
+		for _, r := range m.Sig.Results {
+			if plugin.IsError(r) {
+				continue
+			}
+			results = append(results, fmt.Sprintf("%s: %s", strconv.Quote(r.Name.Value), tsType(r.Type)))
+		}
+		return "{ " + strings.Join(results, "; ") + " }"
+	}
+	for _, r := range m.Sig.Results {
+		if !plugin.IsError(r) {
+			return tsType(r.Type)
+		}
+	}
+	return "void"
+}
+
+func (g *TSClientGenerator) writeJSONRPCMethod(w *writer.TextWriter, iface *config.Interface, m *option.FuncType) {
+	var prefix string
+	if ns := rpcPrefix(iface); ns != "" {
+		prefix = ns + "."
+	}
+
+	resultType := g.resultType(m)
+
+	w.W("%s(", m.Name.Lower())
+	g.writeParams(w, m)
+	w.W("): Promise<%s> {\n", resultType)
+
+	requestParams := make([]string, 0, len(m.Sig.Params))
+	for _, p := range m.Sig.Params {
+		if plugin.IsContext(p) {
+			continue
+		}
+		requestParams = append(requestParams, fmt.Sprintf("%[1]s: %[1]s", p.Name.Value))
+	}
+
+	w.W("return this.scheduler.scheduleRequest(%s, {%s}).then(\n", strconv.Quote(prefix+m.Name.Lower()), strings.Join(requestParams, ", "))
+	w.W("(result) => result as %s,\n", resultType)
+	w.W("(e: unknown) => {\n")
+	w.W("throw isJSONRPCErrorObject(e) ? %sConvertError(e) : e;\n", LcNameIfaceMethod(iface, m))
+	w.W("}\n")
+	w.W(");\n")
+	w.W("}\n\n")
+}
+
+func (g *TSClientGenerator) writeRESTMethod(w *writer.TextWriter, iface *config.Interface, m *option.FuncType) {
+	resultType := g.resultType(m)
+
+	w.W("async %s(", m.Name.Lower())
+	g.writeParams(w, m)
+	w.W("): Promise<%s> {\n", resultType)
+	writeFetchRequest(w, iface, m, g.MethodOptions[iface.Named.Name.Value+m.Name.Value], resultType)
+	w.W("}\n\n")
+
+	if pagination, ok := makePagination(m, g.MethodOptions[iface.Named.Name.Value+m.Name.Value]); ok {
+		writePaginationJSIterator(w, m, pagination, true)
+	}
+}
+
+func (g *TSClientGenerator) OutputPath() string {
+	return ""
+}
+
+func (g *TSClientGenerator) Filename() string {
+	return "client.ts"
+}
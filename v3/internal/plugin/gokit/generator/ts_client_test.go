@@ -0,0 +1,60 @@
+package generator
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/config"
+	"github.com/swipe-io/swipe/v3/option"
+	"github.com/swipe-io/swipe/v3/writer"
+)
+
+func Test_writeFetchRequestLocals(t *testing.T) {
+	stringType := &option.BasicType{Name: "string"}
+	m := &option.FuncType{
+		Name: option.String{Value: "Create"},
+		Sig: &option.SignType{
+			Params: option.VarsType{
+				{Name: option.String{Value: "query"}, Type: stringType},
+				{Name: option.String{Value: "headers"}, Type: stringType},
+				{Name: option.String{Value: "body"}, Type: stringType},
+				{Name: option.String{Value: "resp"}, Type: stringType},
+			},
+		},
+	}
+	mopt := config.MethodOptions{
+		RESTMethod:     option.ExprStringValue{Value: "POST"},
+		RESTQueryVars:  option.SliceStringValue{Value: []string{"q", "query"}},
+		RESTHeaderVars: option.SliceStringValue{Value: []string{"X-Headers", "headers"}},
+	}
+
+	tests := []struct {
+		name       string
+		bodyType   string
+		resultType string
+	}{
+		{"ts json", "json", "void"},
+		{"ts urlencoded", "urlencoded", "void"},
+		{"ts multipart", "multipart", "void"},
+		{"js json", "json", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mopt := mopt
+			mopt.RESTBodyType = option.StringValue{Value: &tt.bodyType}
+
+			var w writer.TextWriter
+			writeFetchRequest(&w, &config.Interface{Named: &option.NamedType{Name: option.String{Value: "Service"}}}, m, mopt, tt.resultType)
+			out := w.String()
+
+			for _, name := range []string{"query", "headers", "body", "resp", "data"} {
+				if regexp.MustCompile(`(const|let|var) ` + name + `\b`).MatchString(out) {
+					t.Errorf("the local %q shadows the method param:\n%s", name, out)
+				}
+			}
+			if !regexp.MustCompile("request\\(\"POST\", `/create`, _query, _headers, _body\\)").MatchString(out) {
+				t.Errorf("request does not use the generated locals:\n%s", out)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package generator
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/config"
+	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/openapi"
+	"github.com/swipe-io/swipe/v3/swipe"
+	"github.com/swipe-io/swipe/v3/writer"
+)
+
+// DeprecationDateLayout is the layout of the deprecation and sunset dates.
+const DeprecationDateLayout = "2006-01-02"
+
+var deprecationHeaders = []string{"Deprecation", "Sunset"}
+
+func hasDeprecations(ifaces []*config.Interface) bool {
+	for _, iface := range ifaces {
+		if len(iface.DeprecatedMethods) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// deprecationHeaderValues returns the Deprecation header value as the RFC 9745 structured date
+// and the Sunset header value as the RFC 8594 HTTP date, the sunset is empty when it is not set.
+func deprecationHeaderValues(d config.Deprecation) (deprecation, sunset string) {
+	since, _ := time.Parse(DeprecationDateLayout, d.Since)
+	deprecation = "@" + strconv.FormatInt(since.Unix(), 10)
+	if d.Sunset != "" {
+		t, _ := time.Parse(DeprecationDateLayout, d.Sunset)
+		sunset = t.Format(http.TimeFormat)
+	}
+	return
+}
+
+func deprecationOpenapiHeaders(d config.Deprecation) map[string]*openapi.Header {
+	headers := map[string]*openapi.Header{
+		"Deprecation": {
+			Description: "The date the method was deprecated at.",
+			Schema:      &openapi.Schema{Type: "string"},
+		},
+	}
+	if d.Sunset != "" {
+		headers["Sunset"] = &openapi.Header{
+			Description: "The date the method is removed at.",
+			Schema:      &openapi.Schema{Type: "string"},
+		}
+	}
+	return headers
+}
+
+func writeDeprecationServer(w *writer.GoWriter, importer swipe.Importer, useFast bool) {
+	w.W("// deprecationHandler sets the Deprecation and Sunset headers of the deprecated method responses.\n")
+	if useFast {
+		routerPkg := importer.Import("routing", "github.com/qiangxue/fasthttp-routing")
+
+		w.W("func deprecationHandler(deprecation, sunset string, next %[1]s.Handler) %[1]s.Handler {\n", routerPkg)
+		w.W("return func(c *%s.Context) error {\n", routerPkg)
+		w.W("c.Response.Header.Set(\"Deprecation\", deprecation)\n")
+		w.W("if sunset != \"\" {\nc.Response.Header.Set(\"Sunset\", sunset)\n}\n")
+		w.W("return next(c)\n")
+		w.W("}\n}\n\n")
+		return
+	}
+	httpPkg := importer.Import("http", "net/http")
+
+	w.W("func deprecationHandler(deprecation, sunset string, next %[1]s.Handler) %[1]s.Handler {\n", httpPkg)
+	w.W("return %s.HandlerFunc(func(w %s.ResponseWriter, r *%s.Request) {\n", httpPkg, httpPkg, httpPkg)
+	w.W("w.Header().Set(\"Deprecation\", deprecation)\n")
+	w.W("if sunset != \"\" {\nw.Header().Set(\"Sunset\", sunset)\n}\n")
+	w.W("next.ServeHTTP(w, r)\n")
+	w.W("})\n}\n\n")
+}
+
+// OpenapiVersions groups the interfaces by the version, the interfaces of each version are documented separately.
+func OpenapiVersions(ifaces []*config.Interface) (versions []string, groups map[string][]*config.Interface) {
+	groups = map[string][]*config.Interface{}
+	for _, iface := range ifaces {
+		v := iface.Version.Take()
+		if _, ok := groups[v]; !ok {
+			versions = append(versions, v)
+		}
+		groups[v] = append(groups[v], iface)
+	}
+	return
+}
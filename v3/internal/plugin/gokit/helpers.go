@@ -308,6 +308,9 @@ func fillMethodDefaultOptions(method, methodDefault config.MethodOptions) config
 	if !method.RESTBodyType.IsValid() {
 		method.RESTBodyType = methodDefault.RESTBodyType
 	}
+	if !method.RESTCodec.IsValid() {
+		method.RESTCodec = methodDefault.RESTCodec
+	}
 	if method.RESTHeaderVars.Value == nil {
 		method.RESTHeaderVars.Value = methodDefault.RESTHeaderVars.Value
 	}
@@ -338,5 +341,40 @@ func fillMethodDefaultOptions(method, methodDefault config.MethodOptions) config
 	if method.LoggingParams.Includes == nil {
 		method.LoggingParams.Includes = methodDefault.LoggingParams.Includes
 	}
+	if !method.Tracing.IsValid() {
+		method.Tracing = methodDefault.Tracing
+	}
+	if method.TracingParams.Excludes == nil {
+		method.TracingParams.Excludes = methodDefault.TracingParams.Excludes
+	}
+	if method.TracingParams.Includes == nil {
+		method.TracingParams.Includes = methodDefault.TracingParams.Includes
+	}
+	if !method.Validation.IsValid() {
+		method.Validation = methodDefault.Validation
+	}
+	if method.BearerAuth == nil {
+		method.BearerAuth = methodDefault.BearerAuth
+	}
+	if method.APIKeyAuth == nil {
+		method.APIKeyAuth = methodDefault.APIKeyAuth
+	}
+	if method.BasicAuth == nil {
+		method.BasicAuth = methodDefault.BasicAuth
+	}
+	if method.RateLimit == nil {
+		method.RateLimit = methodDefault.RateLimit
+	}
+	if !method.RateLimitKeyHeader.IsValid() {
+		method.RateLimitKeyHeader = methodDefault.RateLimitKeyHeader
+	}
+	// the default skips the GET methods, they are idempotent already
+	if method.Idempotent == nil {
+		switch strings.ToUpper(method.RESTMethod.Take()) {
+		case "", "GET", "HEAD", "OPTIONS":
+		default:
+			method.Idempotent = methodDefault.Idempotent
+		}
+	}
 	return method
 }
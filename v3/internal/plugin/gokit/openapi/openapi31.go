@@ -0,0 +1,80 @@
+package openapi
+
+// UpgradeTo31 converts the document to OpenAPI 3.1, schemas follow the JSON Schema 2020-12 semantics:
+// nullable becomes a "null" type, example becomes examples and a single value enum becomes const.
+func (o *OpenAPI) UpgradeTo31() {
+	o.OpenAPI = "3.1.0"
+	for _, s := range o.Components.Schemas {
+		upgradeSchemaTo31(s)
+	}
+	for _, p := range o.Paths {
+		upgradePathTo31(p)
+	}
+	for _, p := range o.Webhooks {
+		upgradePathTo31(p)
+	}
+}
+
+// UpgradeTo31 converts the schema to the JSON Schema 2020-12 semantics.
+func (s *Schema) UpgradeTo31() {
+	upgradeSchemaTo31(s)
+}
+
+func upgradePathTo31(p *Path) {
+	for _, op := range []*Operation{p.Get, p.Post, p.Patch, p.Put, p.Delete} {
+		if op == nil {
+			continue
+		}
+		for _, param := range op.Parameters {
+			if param.Schema != nil {
+				upgradeSchemaTo31(param.Schema)
+			}
+		}
+		if op.RequestBody != nil {
+			upgradeContentTo31(op.RequestBody.Content)
+		}
+		for _, r := range op.Responses {
+			upgradeContentTo31(r.Content)
+		}
+	}
+}
+
+func upgradeContentTo31(c Content) {
+	for _, m := range c {
+		if m.Schema != nil {
+			upgradeSchemaTo31(m.Schema)
+		}
+	}
+}
+
+func upgradeSchemaTo31(s *Schema) {
+	for _, p := range s.Properties {
+		upgradeSchemaTo31(p)
+	}
+	if s.Items != nil {
+		upgradeSchemaTo31(s.Items)
+	}
+	for i := range s.AnyOf {
+		upgradeSchemaTo31(&s.AnyOf[i])
+	}
+	for i := range s.OneOf {
+		upgradeSchemaTo31(&s.OneOf[i])
+	}
+	if s.Example != nil {
+		s.Examples = []interface{}{s.Example}
+		s.Example = nil
+	}
+	if len(s.Enum) == 1 {
+		s.Const = s.Enum[0]
+		s.Enum = nil
+	}
+	if s.Nullable {
+		s.Nullable = false
+		if s.Ref != "" {
+			s.OneOf = []Schema{{Ref: s.Ref}, {Type: "null"}}
+			s.Ref = ""
+		} else if t, ok := s.Type.(string); ok && t != "" {
+			s.Type = []string{t, "null"}
+		}
+	}
+}
@@ -1,5 +1,5 @@
 // Code generated by ffjson <https://github.com/pquerna/ffjson>. DO NOT EDIT.
-// source: pkg/openapi/gen.go
+// source: internal/plugin/gokit/openapi/openapi.go
 
 package openapi
 
@@ -8,7 +8,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-
 	fflib "github.com/pquerna/ffjson/fflib/v1"
 )
 
@@ -37,6 +36,15 @@ func (j *Components) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	_ = obj
 	_ = err
 	buf.WriteString(`{ `)
+	if len(j.SecuritySchemes) != 0 {
+		buf.WriteString(`"securitySchemes":`)
+		/* Falling back. type=map[string]openapi.SecurityScheme kind=map */
+		err = buf.Encode(j.SecuritySchemes)
+		if err != nil {
+			return err
+		}
+		buf.WriteByte(',')
+	}
 	if len(j.Schemas) != 0 {
 		buf.WriteString(`"schemas":`)
 		/* Falling back. type=openapi.Schemas kind=map */
@@ -55,9 +63,13 @@ const (
 	ffjtComponentsbase = iota
 	ffjtComponentsnosuchkey
 
+	ffjtComponentsSecuritySchemes
+
 	ffjtComponentsSchemas
 )
 
+var ffjKeyComponentsSecuritySchemes = []byte("securitySchemes")
+
 var ffjKeyComponentsSchemas = []byte("schemas")
 
 // UnmarshalJSON umarshall json - template of ffjson
@@ -123,7 +135,12 @@ mainparse:
 
 				case 's':
 
-					if bytes.Equal(ffjKeyComponentsSchemas, kn) {
+					if bytes.Equal(ffjKeyComponentsSecuritySchemes, kn) {
+						currentKey = ffjtComponentsSecuritySchemes
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyComponentsSchemas, kn) {
 						currentKey = ffjtComponentsSchemas
 						state = fflib.FFParse_want_colon
 						goto mainparse
@@ -137,6 +154,12 @@ mainparse:
 					goto mainparse
 				}
 
+				if fflib.EqualFoldRight(ffjKeyComponentsSecuritySchemes, kn) {
+					currentKey = ffjtComponentsSecuritySchemes
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
 				currentKey = ffjtComponentsnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
@@ -154,6 +177,9 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
+				case ffjtComponentsSecuritySchemes:
+					goto handle_SecuritySchemes
+
 				case ffjtComponentsSchemas:
 					goto handle_Schemas
 
@@ -171,6 +197,105 @@ mainparse:
 		}
 	}
 
+handle_SecuritySchemes:
+
+	/* handler: j.SecuritySchemes type=map[string]openapi.SecurityScheme kind=map quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_bracket && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.SecuritySchemes = nil
+		} else {
+
+			j.SecuritySchemes = make(map[string]SecurityScheme, 0)
+
+			wantVal := true
+
+			for {
+
+				var k string
+
+				var tmpJSecuritySchemes SecurityScheme
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_bracket {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: k type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						k = string(string(outBuf))
+
+					}
+				}
+
+				// Expect ':' after key
+				tok = fs.Scan()
+				if tok != fflib.FFTok_colon {
+					return fs.WrapErr(fmt.Errorf("wanted colon token, but got token: %v", tok))
+				}
+
+				tok = fs.Scan()
+				/* handler: tmpJSecuritySchemes type=openapi.SecurityScheme kind=struct quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						err = tmpJSecuritySchemes.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						if err != nil {
+							return err
+						}
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				j.SecuritySchemes[k] = tmpJSecuritySchemes
+
+				wantVal = false
+			}
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
 handle_Schemas:
 
 	/* handler: j.Schemas type=openapi.Schemas kind=map quoted=false*/
@@ -849,7 +974,7 @@ done:
 }
 
 // MarshalJSON marshal bytes to json - template
-func (j *Info) MarshalJSON() ([]byte, error) {
+func (j *Header) MarshalJSON() ([]byte, error) {
 	var buf fflib.Buffer
 	if j == nil {
 		buf.WriteString("null")
@@ -863,7 +988,7 @@ func (j *Info) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *Info) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *Header) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -873,43 +998,18 @@ func (j *Info) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	_ = obj
 	_ = err
 	buf.WriteString(`{ `)
-	if len(j.Title) != 0 {
-		buf.WriteString(`"title":`)
-		fflib.WriteJsonString(buf, string(j.Title))
-		buf.WriteByte(',')
-	}
 	if len(j.Description) != 0 {
 		buf.WriteString(`"description":`)
 		fflib.WriteJsonString(buf, string(j.Description))
 		buf.WriteByte(',')
 	}
-	if len(j.TermsOfService) != 0 {
-		buf.WriteString(`"termsOfService":`)
-		fflib.WriteJsonString(buf, string(j.TermsOfService))
-		buf.WriteByte(',')
-	}
-	if j.Contact != nil {
-		if true {
-			buf.WriteString(`"contact":`)
-
-			{
-
-				err = j.Contact.MarshalJSONBuf(buf)
-				if err != nil {
-					return err
-				}
-
-			}
-			buf.WriteByte(',')
-		}
-	}
-	if j.License != nil {
+	if j.Schema != nil {
 		if true {
-			buf.WriteString(`"license":`)
+			buf.WriteString(`"schema":`)
 
 			{
 
-				err = j.License.MarshalJSONBuf(buf)
+				err = j.Schema.MarshalJSONBuf(buf)
 				if err != nil {
 					return err
 				}
@@ -918,55 +1018,34 @@ func (j *Info) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 			buf.WriteByte(',')
 		}
 	}
-	if len(j.Version) != 0 {
-		buf.WriteString(`"version":`)
-		fflib.WriteJsonString(buf, string(j.Version))
-		buf.WriteByte(',')
-	}
 	buf.Rewind(1)
 	buf.WriteByte('}')
 	return nil
 }
 
 const (
-	ffjtInfobase = iota
-	ffjtInfonosuchkey
-
-	ffjtInfoTitle
-
-	ffjtInfoDescription
-
-	ffjtInfoTermsOfService
-
-	ffjtInfoContact
+	ffjtHeaderbase = iota
+	ffjtHeadernosuchkey
 
-	ffjtInfoLicense
+	ffjtHeaderDescription
 
-	ffjtInfoVersion
+	ffjtHeaderSchema
 )
 
-var ffjKeyInfoTitle = []byte("title")
-
-var ffjKeyInfoDescription = []byte("description")
-
-var ffjKeyInfoTermsOfService = []byte("termsOfService")
-
-var ffjKeyInfoContact = []byte("contact")
-
-var ffjKeyInfoLicense = []byte("license")
+var ffjKeyHeaderDescription = []byte("description")
 
-var ffjKeyInfoVersion = []byte("version")
+var ffjKeyHeaderSchema = []byte("schema")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *Info) UnmarshalJSON(input []byte) error {
+func (j *Header) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *Info) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *Header) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtInfobase
+	currentKey := ffjtHeaderbase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -1012,96 +1091,43 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtInfonosuchkey
+				currentKey = ffjtHeadernosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
 				switch kn[0] {
 
-				case 'c':
-
-					if bytes.Equal(ffjKeyInfoContact, kn) {
-						currentKey = ffjtInfoContact
-						state = fflib.FFParse_want_colon
-						goto mainparse
-					}
-
 				case 'd':
 
-					if bytes.Equal(ffjKeyInfoDescription, kn) {
-						currentKey = ffjtInfoDescription
-						state = fflib.FFParse_want_colon
-						goto mainparse
-					}
-
-				case 'l':
-
-					if bytes.Equal(ffjKeyInfoLicense, kn) {
-						currentKey = ffjtInfoLicense
-						state = fflib.FFParse_want_colon
-						goto mainparse
-					}
-
-				case 't':
-
-					if bytes.Equal(ffjKeyInfoTitle, kn) {
-						currentKey = ffjtInfoTitle
-						state = fflib.FFParse_want_colon
-						goto mainparse
-
-					} else if bytes.Equal(ffjKeyInfoTermsOfService, kn) {
-						currentKey = ffjtInfoTermsOfService
+					if bytes.Equal(ffjKeyHeaderDescription, kn) {
+						currentKey = ffjtHeaderDescription
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 'v':
+				case 's':
 
-					if bytes.Equal(ffjKeyInfoVersion, kn) {
-						currentKey = ffjtInfoVersion
+					if bytes.Equal(ffjKeyHeaderSchema, kn) {
+						currentKey = ffjtHeaderSchema
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				}
 
-				if fflib.EqualFoldRight(ffjKeyInfoVersion, kn) {
-					currentKey = ffjtInfoVersion
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
-
-				if fflib.EqualFoldRight(ffjKeyInfoLicense, kn) {
-					currentKey = ffjtInfoLicense
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
-
-				if fflib.SimpleLetterEqualFold(ffjKeyInfoContact, kn) {
-					currentKey = ffjtInfoContact
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
-
-				if fflib.EqualFoldRight(ffjKeyInfoTermsOfService, kn) {
-					currentKey = ffjtInfoTermsOfService
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
-
-				if fflib.EqualFoldRight(ffjKeyInfoDescription, kn) {
-					currentKey = ffjtInfoDescription
+				if fflib.EqualFoldRight(ffjKeyHeaderSchema, kn) {
+					currentKey = ffjtHeaderSchema
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyInfoTitle, kn) {
-					currentKey = ffjtInfoTitle
+				if fflib.EqualFoldRight(ffjKeyHeaderDescription, kn) {
+					currentKey = ffjtHeaderDescription
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtInfonosuchkey
+				currentKey = ffjtHeadernosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			}
@@ -1118,25 +1144,13 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
-				case ffjtInfoTitle:
-					goto handle_Title
-
-				case ffjtInfoDescription:
+				case ffjtHeaderDescription:
 					goto handle_Description
 
-				case ffjtInfoTermsOfService:
-					goto handle_TermsOfService
-
-				case ffjtInfoContact:
-					goto handle_Contact
-
-				case ffjtInfoLicense:
-					goto handle_License
-
-				case ffjtInfoVersion:
-					goto handle_Version
+				case ffjtHeaderSchema:
+					goto handle_Schema
 
-				case ffjtInfonosuchkey:
+				case ffjtHeadernosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -1150,35 +1164,9 @@ mainparse:
 		}
 	}
 
-handle_Title:
+handle_Description:
 
-	/* handler: j.Title type=string kind=string quoted=false*/
-
-	{
-
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
-		}
-
-		if tok == fflib.FFTok_null {
-
-		} else {
-
-			outBuf := fs.Output.Bytes()
-
-			j.Title = string(string(outBuf))
-
-		}
-	}
-
-	state = fflib.FFParse_after_value
-	goto mainparse
-
-handle_Description:
-
-	/* handler: j.Description type=string kind=string quoted=false*/
+	/* handler: j.Description type=string kind=string quoted=false*/
 
 	{
 
@@ -1202,74 +1190,22 @@ handle_Description:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_TermsOfService:
-
-	/* handler: j.TermsOfService type=string kind=string quoted=false*/
-
-	{
-
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
-		}
-
-		if tok == fflib.FFTok_null {
-
-		} else {
-
-			outBuf := fs.Output.Bytes()
-
-			j.TermsOfService = string(string(outBuf))
-
-		}
-	}
-
-	state = fflib.FFParse_after_value
-	goto mainparse
-
-handle_Contact:
-
-	/* handler: j.Contact type=openapi.Contact kind=struct quoted=false*/
-
-	{
-		if tok == fflib.FFTok_null {
-
-			j.Contact = nil
-
-		} else {
-
-			if j.Contact == nil {
-				j.Contact = new(Contact)
-			}
-
-			err = j.Contact.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
-			if err != nil {
-				return err
-			}
-		}
-		state = fflib.FFParse_after_value
-	}
-
-	state = fflib.FFParse_after_value
-	goto mainparse
-
-handle_License:
+handle_Schema:
 
-	/* handler: j.License type=openapi.License kind=struct quoted=false*/
+	/* handler: j.Schema type=openapi.Schema kind=struct quoted=false*/
 
 	{
 		if tok == fflib.FFTok_null {
 
-			j.License = nil
+			j.Schema = nil
 
 		} else {
 
-			if j.License == nil {
-				j.License = new(License)
+			if j.Schema == nil {
+				j.Schema = new(Schema)
 			}
 
-			err = j.License.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			err = j.Schema.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
 			if err != nil {
 				return err
 			}
@@ -1280,32 +1216,6 @@ handle_License:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Version:
-
-	/* handler: j.Version type=string kind=string quoted=false*/
-
-	{
-
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
-		}
-
-		if tok == fflib.FFTok_null {
-
-		} else {
-
-			outBuf := fs.Output.Bytes()
-
-			j.Version = string(string(outBuf))
-
-		}
-	}
-
-	state = fflib.FFParse_after_value
-	goto mainparse
-
 wantedvalue:
 	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
 wrongtokenerror:
@@ -1325,7 +1235,7 @@ done:
 }
 
 // MarshalJSON marshal bytes to json - template
-func (j *License) MarshalJSON() ([]byte, error) {
+func (j *Info) MarshalJSON() ([]byte, error) {
 	var buf fflib.Buffer
 	if j == nil {
 		buf.WriteString("null")
@@ -1339,7 +1249,7 @@ func (j *License) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *License) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *Info) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -1349,14 +1259,54 @@ func (j *License) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	_ = obj
 	_ = err
 	buf.WriteString(`{ `)
-	if len(j.Name) != 0 {
-		buf.WriteString(`"name":`)
-		fflib.WriteJsonString(buf, string(j.Name))
+	if len(j.Title) != 0 {
+		buf.WriteString(`"title":`)
+		fflib.WriteJsonString(buf, string(j.Title))
 		buf.WriteByte(',')
 	}
-	if len(j.URL) != 0 {
-		buf.WriteString(`"url":`)
-		fflib.WriteJsonString(buf, string(j.URL))
+	if len(j.Description) != 0 {
+		buf.WriteString(`"description":`)
+		fflib.WriteJsonString(buf, string(j.Description))
+		buf.WriteByte(',')
+	}
+	if len(j.TermsOfService) != 0 {
+		buf.WriteString(`"termsOfService":`)
+		fflib.WriteJsonString(buf, string(j.TermsOfService))
+		buf.WriteByte(',')
+	}
+	if j.Contact != nil {
+		if true {
+			buf.WriteString(`"contact":`)
+
+			{
+
+				err = j.Contact.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if j.License != nil {
+		if true {
+			buf.WriteString(`"license":`)
+
+			{
+
+				err = j.License.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if len(j.Version) != 0 {
+		buf.WriteString(`"version":`)
+		fflib.WriteJsonString(buf, string(j.Version))
 		buf.WriteByte(',')
 	}
 	buf.Rewind(1)
@@ -1365,28 +1315,44 @@ func (j *License) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 }
 
 const (
-	ffjtLicensebase = iota
-	ffjtLicensenosuchkey
+	ffjtInfobase = iota
+	ffjtInfonosuchkey
 
-	ffjtLicenseName
+	ffjtInfoTitle
 
-	ffjtLicenseURL
+	ffjtInfoDescription
+
+	ffjtInfoTermsOfService
+
+	ffjtInfoContact
+
+	ffjtInfoLicense
+
+	ffjtInfoVersion
 )
 
-var ffjKeyLicenseName = []byte("name")
+var ffjKeyInfoTitle = []byte("title")
 
-var ffjKeyLicenseURL = []byte("url")
+var ffjKeyInfoDescription = []byte("description")
+
+var ffjKeyInfoTermsOfService = []byte("termsOfService")
+
+var ffjKeyInfoContact = []byte("contact")
+
+var ffjKeyInfoLicense = []byte("license")
+
+var ffjKeyInfoVersion = []byte("version")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *License) UnmarshalJSON(input []byte) error {
+func (j *Info) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *License) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *Info) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtLicensebase
+	currentKey := ffjtInfobase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -1432,43 +1398,96 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtLicensenosuchkey
+				currentKey = ffjtInfonosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
 				switch kn[0] {
 
-				case 'n':
+				case 'c':
 
-					if bytes.Equal(ffjKeyLicenseName, kn) {
-						currentKey = ffjtLicenseName
+					if bytes.Equal(ffjKeyInfoContact, kn) {
+						currentKey = ffjtInfoContact
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 'u':
+				case 'd':
 
-					if bytes.Equal(ffjKeyLicenseURL, kn) {
-						currentKey = ffjtLicenseURL
+					if bytes.Equal(ffjKeyInfoDescription, kn) {
+						currentKey = ffjtInfoDescription
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'l':
+
+					if bytes.Equal(ffjKeyInfoLicense, kn) {
+						currentKey = ffjtInfoLicense
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffjKeyInfoTitle, kn) {
+						currentKey = ffjtInfoTitle
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyInfoTermsOfService, kn) {
+						currentKey = ffjtInfoTermsOfService
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'v':
+
+					if bytes.Equal(ffjKeyInfoVersion, kn) {
+						currentKey = ffjtInfoVersion
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyLicenseURL, kn) {
-					currentKey = ffjtLicenseURL
+				if fflib.EqualFoldRight(ffjKeyInfoVersion, kn) {
+					currentKey = ffjtInfoVersion
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyLicenseName, kn) {
-					currentKey = ffjtLicenseName
-					state = fflib.FFParse_want_colon
+				if fflib.EqualFoldRight(ffjKeyInfoLicense, kn) {
+					currentKey = ffjtInfoLicense
+					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtLicensenosuchkey
+				if fflib.SimpleLetterEqualFold(ffjKeyInfoContact, kn) {
+					currentKey = ffjtInfoContact
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyInfoTermsOfService, kn) {
+					currentKey = ffjtInfoTermsOfService
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyInfoDescription, kn) {
+					currentKey = ffjtInfoDescription
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyInfoTitle, kn) {
+					currentKey = ffjtInfoTitle
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtInfonosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			}
@@ -1485,13 +1504,25 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
-				case ffjtLicenseName:
-					goto handle_Name
+				case ffjtInfoTitle:
+					goto handle_Title
 
-				case ffjtLicenseURL:
-					goto handle_URL
+				case ffjtInfoDescription:
+					goto handle_Description
 
-				case ffjtLicensenosuchkey:
+				case ffjtInfoTermsOfService:
+					goto handle_TermsOfService
+
+				case ffjtInfoContact:
+					goto handle_Contact
+
+				case ffjtInfoLicense:
+					goto handle_License
+
+				case ffjtInfoVersion:
+					goto handle_Version
+
+				case ffjtInfonosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -1505,9 +1536,9 @@ mainparse:
 		}
 	}
 
-handle_Name:
+handle_Title:
 
-	/* handler: j.Name type=string kind=string quoted=false*/
+	/* handler: j.Title type=string kind=string quoted=false*/
 
 	{
 
@@ -1523,7 +1554,7 @@ handle_Name:
 
 			outBuf := fs.Output.Bytes()
 
-			j.Name = string(string(outBuf))
+			j.Title = string(string(outBuf))
 
 		}
 	}
@@ -1531,9 +1562,9 @@ handle_Name:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_URL:
+handle_Description:
 
-	/* handler: j.URL type=string kind=string quoted=false*/
+	/* handler: j.Description type=string kind=string quoted=false*/
 
 	{
 
@@ -1549,7 +1580,111 @@ handle_URL:
 
 			outBuf := fs.Output.Bytes()
 
-			j.URL = string(string(outBuf))
+			j.Description = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_TermsOfService:
+
+	/* handler: j.TermsOfService type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.TermsOfService = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Contact:
+
+	/* handler: j.Contact type=openapi.Contact kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.Contact = nil
+
+		} else {
+
+			if j.Contact == nil {
+				j.Contact = new(Contact)
+			}
+
+			err = j.Contact.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_License:
+
+	/* handler: j.License type=openapi.License kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.License = nil
+
+		} else {
+
+			if j.License == nil {
+				j.License = new(License)
+			}
+
+			err = j.License.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Version:
+
+	/* handler: j.Version type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Version = string(string(outBuf))
 
 		}
 	}
@@ -1576,7 +1711,7 @@ done:
 }
 
 // MarshalJSON marshal bytes to json - template
-func (j *Media) MarshalJSON() ([]byte, error) {
+func (j *License) MarshalJSON() ([]byte, error) {
 	var buf fflib.Buffer
 	if j == nil {
 		buf.WriteString("null")
@@ -1590,7 +1725,7 @@ func (j *Media) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *Media) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *License) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -1600,20 +1735,15 @@ func (j *Media) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	_ = obj
 	_ = err
 	buf.WriteString(`{ `)
-	if j.Schema != nil {
-		if true {
-			buf.WriteString(`"schema":`)
-
-			{
-
-				err = j.Schema.MarshalJSONBuf(buf)
-				if err != nil {
-					return err
-				}
-
-			}
-			buf.WriteByte(',')
-		}
+	if len(j.Name) != 0 {
+		buf.WriteString(`"name":`)
+		fflib.WriteJsonString(buf, string(j.Name))
+		buf.WriteByte(',')
+	}
+	if len(j.URL) != 0 {
+		buf.WriteString(`"url":`)
+		fflib.WriteJsonString(buf, string(j.URL))
+		buf.WriteByte(',')
 	}
 	buf.Rewind(1)
 	buf.WriteByte('}')
@@ -1621,24 +1751,28 @@ func (j *Media) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 }
 
 const (
-	ffjtMediabase = iota
-	ffjtMedianosuchkey
+	ffjtLicensebase = iota
+	ffjtLicensenosuchkey
 
-	ffjtMediaSchema
+	ffjtLicenseName
+
+	ffjtLicenseURL
 )
 
-var ffjKeyMediaSchema = []byte("schema")
+var ffjKeyLicenseName = []byte("name")
+
+var ffjKeyLicenseURL = []byte("url")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *Media) UnmarshalJSON(input []byte) error {
+func (j *License) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *Media) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *License) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtMediabase
+	currentKey := ffjtLicensebase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -1684,29 +1818,43 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtMedianosuchkey
+				currentKey = ffjtLicensenosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
 				switch kn[0] {
 
-				case 's':
+				case 'n':
 
-					if bytes.Equal(ffjKeyMediaSchema, kn) {
-						currentKey = ffjtMediaSchema
+					if bytes.Equal(ffjKeyLicenseName, kn) {
+						currentKey = ffjtLicenseName
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'u':
+
+					if bytes.Equal(ffjKeyLicenseURL, kn) {
+						currentKey = ffjtLicenseURL
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				}
 
-				if fflib.EqualFoldRight(ffjKeyMediaSchema, kn) {
-					currentKey = ffjtMediaSchema
+				if fflib.SimpleLetterEqualFold(ffjKeyLicenseURL, kn) {
+					currentKey = ffjtLicenseURL
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtMedianosuchkey
+				if fflib.SimpleLetterEqualFold(ffjKeyLicenseName, kn) {
+					currentKey = ffjtLicenseName
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtLicensenosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			}
@@ -1723,10 +1871,13 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
-				case ffjtMediaSchema:
-					goto handle_Schema
+				case ffjtLicenseName:
+					goto handle_Name
 
-				case ffjtMedianosuchkey:
+				case ffjtLicenseURL:
+					goto handle_URL
+
+				case ffjtLicensenosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -1740,14 +1891,249 @@ mainparse:
 		}
 	}
 
-handle_Schema:
+handle_Name:
 
-	/* handler: j.Schema type=openapi.Schema kind=struct quoted=false*/
+	/* handler: j.Name type=string kind=string quoted=false*/
 
 	{
-		if tok == fflib.FFTok_null {
-
-			j.Schema = nil
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Name = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_URL:
+
+	/* handler: j.URL type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.URL = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *Media) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *Media) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ `)
+	if j.Schema != nil {
+		if true {
+			buf.WriteString(`"schema":`)
+
+			{
+
+				err = j.Schema.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtMediabase = iota
+	ffjtMedianosuchkey
+
+	ffjtMediaSchema
+)
+
+var ffjKeyMediaSchema = []byte("schema")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *Media) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *Media) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtMediabase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtMedianosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 's':
+
+					if bytes.Equal(ffjKeyMediaSchema, kn) {
+						currentKey = ffjtMediaSchema
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.EqualFoldRight(ffjKeyMediaSchema, kn) {
+					currentKey = ffjtMediaSchema
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtMedianosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtMediaSchema:
+					goto handle_Schema
+
+				case ffjtMedianosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Schema:
+
+	/* handler: j.Schema type=openapi.Schema kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.Schema = nil
 
 		} else {
 
@@ -1897,6 +2283,15 @@ func (j *OpenAPI) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 		}
 		buf.WriteByte(',')
 	}
+	if len(j.Webhooks) != 0 {
+		buf.WriteString(`"webhooks":`)
+		/* Falling back. type=map[string]*openapi.Path kind=map */
+		err = buf.Encode(j.Webhooks)
+		if err != nil {
+			return err
+		}
+		buf.WriteByte(',')
+	}
 	if true {
 		buf.WriteString(`"components":`)
 
@@ -1931,6 +2326,8 @@ const (
 
 	ffjtOpenAPIPaths
 
+	ffjtOpenAPIWebhooks
+
 	ffjtOpenAPIComponents
 )
 
@@ -1946,6 +2343,8 @@ var ffjKeyOpenAPISchemes = []byte("schemes")
 
 var ffjKeyOpenAPIPaths = []byte("paths")
 
+var ffjKeyOpenAPIWebhooks = []byte("webhooks")
+
 var ffjKeyOpenAPIComponents = []byte("components")
 
 // UnmarshalJSON umarshall json - template of ffjson
@@ -2062,6 +2461,14 @@ mainparse:
 						goto mainparse
 					}
 
+				case 'w':
+
+					if bytes.Equal(ffjKeyOpenAPIWebhooks, kn) {
+						currentKey = ffjtOpenAPIWebhooks
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
 				}
 
 				if fflib.EqualFoldRight(ffjKeyOpenAPIComponents, kn) {
@@ -2070,6 +2477,12 @@ mainparse:
 					goto mainparse
 				}
 
+				if fflib.EqualFoldRight(ffjKeyOpenAPIWebhooks, kn) {
+					currentKey = ffjtOpenAPIWebhooks
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
 				if fflib.EqualFoldRight(ffjKeyOpenAPIPaths, kn) {
 					currentKey = ffjtOpenAPIPaths
 					state = fflib.FFParse_want_colon
@@ -2141,6 +2554,9 @@ mainparse:
 				case ffjtOpenAPIPaths:
 					goto handle_Paths
 
+				case ffjtOpenAPIWebhooks:
+					goto handle_Webhooks
+
 				case ffjtOpenAPIComponents:
 					goto handle_Components
 
@@ -2186,7 +2602,7 @@ handle_OpenAPI:
 
 handle_Info:
 
-	/* handler: j.info type=openapi.info kind=struct quoted=false*/
+	/* handler: j.Info type=openapi.Info kind=struct quoted=false*/
 
 	{
 		if tok == fflib.FFTok_null {
@@ -2206,7 +2622,7 @@ handle_Info:
 
 handle_Servers:
 
-	/* handler: j.servers type=[]openapi.Server kind=slice quoted=false*/
+	/* handler: j.Servers type=[]openapi.Server kind=slice quoted=false*/
 
 	{
 
@@ -2519,6 +2935,111 @@ handle_Paths:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
+handle_Webhooks:
+
+	/* handler: j.Webhooks type=map[string]*openapi.Path kind=map quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_bracket && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.Webhooks = nil
+		} else {
+
+			j.Webhooks = make(map[string]*Path, 0)
+
+			wantVal := true
+
+			for {
+
+				var k string
+
+				var tmpJWebhooks *Path
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_bracket {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: k type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						k = string(string(outBuf))
+
+					}
+				}
+
+				// Expect ':' after key
+				tok = fs.Scan()
+				if tok != fflib.FFTok_colon {
+					return fs.WrapErr(fmt.Errorf("wanted colon token, but got token: %v", tok))
+				}
+
+				tok = fs.Scan()
+				/* handler: tmpJWebhooks type=*openapi.Path kind=ptr quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+						tmpJWebhooks = nil
+
+					} else {
+
+						if tmpJWebhooks == nil {
+							tmpJWebhooks = new(Path)
+						}
+
+						err = tmpJWebhooks.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						if err != nil {
+							return err
+						}
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				j.Webhooks[k] = tmpJWebhooks
+
+				wantVal = false
+			}
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
 handle_Components:
 
 	/* handler: j.Components type=openapi.Components kind=struct quoted=false*/
@@ -2693,6 +3214,34 @@ func (j *Operation) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 		}
 		buf.WriteByte(',')
 	}
+	if j.Deprecated != false {
+		if j.Deprecated {
+			buf.WriteString(`"deprecated":true`)
+		} else {
+			buf.WriteString(`"deprecated":false`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(j.Security) != 0 {
+		buf.WriteString(`"security":`)
+		if j.Security != nil {
+			buf.WriteString(`[`)
+			for i, v := range j.Security {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				/* Falling back. type=openapi.Security kind=map */
+				err = buf.Encode(v)
+				if err != nil {
+					return err
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
 	buf.Rewind(1)
 	buf.WriteByte('}')
 	return nil
@@ -2719,6 +3268,10 @@ const (
 	ffjtOperationRequestBody
 
 	ffjtOperationResponses
+
+	ffjtOperationDeprecated
+
+	ffjtOperationSecurity
 )
 
 var ffjKeyOperationTags = []byte("tags")
@@ -2739,6 +3292,10 @@ var ffjKeyOperationRequestBody = []byte("requestBody")
 
 var ffjKeyOperationResponses = []byte("responses")
 
+var ffjKeyOperationDeprecated = []byte("deprecated")
+
+var ffjKeyOperationSecurity = []byte("security")
+
 // UnmarshalJSON umarshall json - template of ffjson
 func (j *Operation) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
@@ -2814,6 +3371,11 @@ mainparse:
 						currentKey = ffjtOperationDescription
 						state = fflib.FFParse_want_colon
 						goto mainparse
+
+					} else if bytes.Equal(ffjKeyOperationDeprecated, kn) {
+						currentKey = ffjtOperationDeprecated
+						state = fflib.FFParse_want_colon
+						goto mainparse
 					}
 
 				case 'o':
@@ -2856,6 +3418,11 @@ mainparse:
 						currentKey = ffjtOperationSummary
 						state = fflib.FFParse_want_colon
 						goto mainparse
+
+					} else if bytes.Equal(ffjKeyOperationSecurity, kn) {
+						currentKey = ffjtOperationSecurity
+						state = fflib.FFParse_want_colon
+						goto mainparse
 					}
 
 				case 't':
@@ -2868,6 +3435,18 @@ mainparse:
 
 				}
 
+				if fflib.EqualFoldRight(ffjKeyOperationSecurity, kn) {
+					currentKey = ffjtOperationSecurity
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyOperationDeprecated, kn) {
+					currentKey = ffjtOperationDeprecated
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
 				if fflib.EqualFoldRight(ffjKeyOperationResponses, kn) {
 					currentKey = ffjtOperationResponses
 					state = fflib.FFParse_want_colon
@@ -2966,6 +3545,12 @@ mainparse:
 				case ffjtOperationResponses:
 					goto handle_Responses
 
+				case ffjtOperationDeprecated:
+					goto handle_Deprecated
+
+				case ffjtOperationSecurity:
+					goto handle_Security
+
 				case ffjtOperationnosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
@@ -3447,13 +4032,19 @@ handle_Responses:
 				}
 
 				tok = fs.Scan()
-				/* handler: tmpJResponses type=openapi.Response kind=struct quoted=false*/
+				/* handler: tmpJResponses type=*openapi.Response kind=ptr quoted=false*/
 
 				{
 					if tok == fflib.FFTok_null {
 
+						tmpJResponses = nil
+
 					} else {
 
+						if tmpJResponses == nil {
+							tmpJResponses = new(Response)
+						}
+
 						err = tmpJResponses.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
 						if err != nil {
 							return err
@@ -3473,6 +4064,61 @@ handle_Responses:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
+handle_Deprecated:
+
+	/* handler: j.Deprecated type=bool kind=bool quoted=false*/
+
+	{
+		if tok != fflib.FFTok_bool && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for bool", tok))
+		}
+	}
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+			tmpb := fs.Output.Bytes()
+
+			if bytes.Compare([]byte{'t', 'r', 'u', 'e'}, tmpb) == 0 {
+
+				j.Deprecated = true
+
+			} else if bytes.Compare([]byte{'f', 'a', 'l', 's', 'e'}, tmpb) == 0 {
+
+				j.Deprecated = false
+
+			} else {
+				err = errors.New("unexpected bytes for true/false value")
+				return fs.WrapErr(err)
+			}
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Security:
+
+	/* handler: j.Security type=[]openapi.Security kind=slice quoted=false*/
+
+	{
+		/* Falling back. type=[]openapi.Security kind=slice */
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = json.Unmarshal(tbuf, &j.Security)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
 wantedvalue:
 	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
 wrongtokenerror:
@@ -3544,6 +4190,14 @@ func (j *Parameter) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 		}
 		buf.WriteByte(',')
 	}
+	if j.Deprecated != false {
+		if j.Deprecated {
+			buf.WriteString(`"deprecated":true`)
+		} else {
+			buf.WriteString(`"deprecated":false`)
+		}
+		buf.WriteByte(',')
+	}
 	if j.Schema != nil {
 		if true {
 			buf.WriteString(`"schema":`)
@@ -3578,6 +4232,8 @@ const (
 
 	ffjtParameterRequired
 
+	ffjtParameterDeprecated
+
 	ffjtParameterSchema
 )
 
@@ -3591,6 +4247,8 @@ var ffjKeyParameterDescription = []byte("description")
 
 var ffjKeyParameterRequired = []byte("required")
 
+var ffjKeyParameterDeprecated = []byte("deprecated")
+
 var ffjKeyParameterSchema = []byte("schema")
 
 // UnmarshalJSON umarshall json - template of ffjson
@@ -3668,6 +4326,11 @@ mainparse:
 						currentKey = ffjtParameterDescription
 						state = fflib.FFParse_want_colon
 						goto mainparse
+
+					} else if bytes.Equal(ffjKeyParameterDeprecated, kn) {
+						currentKey = ffjtParameterDeprecated
+						state = fflib.FFParse_want_colon
+						goto mainparse
 					}
 
 				case 'i':
@@ -3710,6 +4373,12 @@ mainparse:
 					goto mainparse
 				}
 
+				if fflib.SimpleLetterEqualFold(ffjKeyParameterDeprecated, kn) {
+					currentKey = ffjtParameterDeprecated
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
 				if fflib.SimpleLetterEqualFold(ffjKeyParameterRequired, kn) {
 					currentKey = ffjtParameterRequired
 					state = fflib.FFParse_want_colon
@@ -3772,6 +4441,9 @@ mainparse:
 				case ffjtParameterRequired:
 					goto handle_Required
 
+				case ffjtParameterDeprecated:
+					goto handle_Deprecated
+
 				case ffjtParameterSchema:
 					goto handle_Schema
 
@@ -3928,6 +4600,41 @@ handle_Required:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
+handle_Deprecated:
+
+	/* handler: j.Deprecated type=bool kind=bool quoted=false*/
+
+	{
+		if tok != fflib.FFTok_bool && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for bool", tok))
+		}
+	}
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+			tmpb := fs.Output.Bytes()
+
+			if bytes.Compare([]byte{'t', 'r', 'u', 'e'}, tmpb) == 0 {
+
+				j.Deprecated = true
+
+			} else if bytes.Compare([]byte{'f', 'a', 'l', 's', 'e'}, tmpb) == 0 {
+
+				j.Deprecated = false
+
+			} else {
+				err = errors.New("unexpected bytes for true/false value")
+				return fs.WrapErr(err)
+			}
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
 handle_Schema:
 
 	/* handler: j.Schema type=openapi.Schema kind=struct quoted=false*/
@@ -4998,6 +5705,15 @@ func (j *Response) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 		fflib.WriteJsonString(buf, string(j.Description))
 		buf.WriteByte(',')
 	}
+	if len(j.Headers) != 0 {
+		buf.WriteString(`"headers":`)
+		/* Falling back. type=map[string]*openapi.Header kind=map */
+		err = buf.Encode(j.Headers)
+		if err != nil {
+			return err
+		}
+		buf.WriteByte(',')
+	}
 	if len(j.Content) != 0 {
 		buf.WriteString(`"content":`)
 		/* Falling back. type=openapi.Content kind=map */
@@ -5018,11 +5734,15 @@ const (
 
 	ffjtResponseDescription
 
+	ffjtResponseHeaders
+
 	ffjtResponseContent
 )
 
 var ffjKeyResponseDescription = []byte("description")
 
+var ffjKeyResponseHeaders = []byte("headers")
+
 var ffjKeyResponseContent = []byte("content")
 
 // UnmarshalJSON umarshall json - template of ffjson
@@ -5102,6 +5822,14 @@ mainparse:
 						goto mainparse
 					}
 
+				case 'h':
+
+					if bytes.Equal(ffjKeyResponseHeaders, kn) {
+						currentKey = ffjtResponseHeaders
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
 				}
 
 				if fflib.SimpleLetterEqualFold(ffjKeyResponseContent, kn) {
@@ -5110,6 +5838,12 @@ mainparse:
 					goto mainparse
 				}
 
+				if fflib.EqualFoldRight(ffjKeyResponseHeaders, kn) {
+					currentKey = ffjtResponseHeaders
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
 				if fflib.EqualFoldRight(ffjKeyResponseDescription, kn) {
 					currentKey = ffjtResponseDescription
 					state = fflib.FFParse_want_colon
@@ -5136,6 +5870,9 @@ mainparse:
 				case ffjtResponseDescription:
 					goto handle_Description
 
+				case ffjtResponseHeaders:
+					goto handle_Headers
+
 				case ffjtResponseContent:
 					goto handle_Content
 
@@ -5179,23 +5916,23 @@ handle_Description:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Content:
+handle_Headers:
 
-	/* handler: j.Content type=openapi.Content kind=map quoted=false*/
+	/* handler: j.Headers type=map[string]*openapi.Header kind=map quoted=false*/
 
 	{
 
 		{
 			if tok != fflib.FFTok_left_bracket && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for Content", tok))
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
 			}
 		}
 
 		if tok == fflib.FFTok_null {
-			j.Content = nil
+			j.Headers = nil
 		} else {
 
-			j.Content = make(map[string]Media, 0)
+			j.Headers = make(map[string]*Header, 0)
 
 			wantVal := true
 
@@ -5203,7 +5940,7 @@ handle_Content:
 
 				var k string
 
-				var tmpJContent Media
+				var tmpJHeaders *Header
 
 				tok = fs.Scan()
 				if tok == fflib.FFTok_error {
@@ -5252,14 +5989,119 @@ handle_Content:
 				}
 
 				tok = fs.Scan()
-				/* handler: tmpJContent type=openapi.Media kind=struct quoted=false*/
+				/* handler: tmpJHeaders type=*openapi.Header kind=ptr quoted=false*/
 
 				{
 					if tok == fflib.FFTok_null {
 
+						tmpJHeaders = nil
+
 					} else {
 
-						err = tmpJContent.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						if tmpJHeaders == nil {
+							tmpJHeaders = new(Header)
+						}
+
+						err = tmpJHeaders.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						if err != nil {
+							return err
+						}
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				j.Headers[k] = tmpJHeaders
+
+				wantVal = false
+			}
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Content:
+
+	/* handler: j.Content type=openapi.Content kind=map quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_bracket && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for Content", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.Content = nil
+		} else {
+
+			j.Content = make(map[string]Media, 0)
+
+			wantVal := true
+
+			for {
+
+				var k string
+
+				var tmpJContent Media
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_bracket {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: k type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						k = string(string(outBuf))
+
+					}
+				}
+
+				// Expect ':' after key
+				tok = fs.Scan()
+				if tok != fflib.FFTok_colon {
+					return fs.WrapErr(fmt.Errorf("wanted colon token, but got token: %v", tok))
+				}
+
+				tok = fs.Scan()
+				/* handler: tmpJContent type=openapi.Media kind=struct quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						err = tmpJContent.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
 						if err != nil {
 							return err
 						}
@@ -5331,9 +6173,21 @@ func (j *Schema) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 		fflib.WriteJsonString(buf, string(j.Ref))
 		buf.WriteByte(',')
 	}
-	if len(j.Type) != 0 {
+	if j.Type != nil {
 		buf.WriteString(`"type":`)
-		fflib.WriteJsonString(buf, string(j.Type))
+		/* Interface types must use runtime reflection. type=interface {} kind=interface */
+		err = buf.Encode(j.Type)
+		if err != nil {
+			return err
+		}
+		buf.WriteByte(',')
+	}
+	if j.Nullable != false {
+		if j.Nullable {
+			buf.WriteString(`"nullable":true`)
+		} else {
+			buf.WriteString(`"nullable":false`)
+		}
 		buf.WriteByte(',')
 	}
 	if len(j.Format) != 0 {
@@ -5350,6 +6204,22 @@ func (j *Schema) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 		}
 		buf.WriteByte(',')
 	}
+	if len(j.Required) != 0 {
+		buf.WriteString(`"required":`)
+		if j.Required != nil {
+			buf.WriteString(`[`)
+			for i, v := range j.Required {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.WriteJsonString(buf, string(v))
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
 	if j.Items != nil {
 		if true {
 			buf.WriteString(`"items":`)
@@ -5389,6 +6259,30 @@ func (j *Schema) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 		}
 		buf.WriteByte(',')
 	}
+	if len(j.OneOf) != 0 {
+		buf.WriteString(`"oneOf":`)
+		if j.OneOf != nil {
+			buf.WriteString(`[`)
+			for i, v := range j.OneOf {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+
+				{
+
+					err = v.MarshalJSONBuf(buf)
+					if err != nil {
+						return err
+					}
+
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
 	if len(j.Enum) != 0 {
 		buf.WriteString(`"enum":`)
 		if j.Enum != nil {
@@ -5397,7 +6291,11 @@ func (j *Schema) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 				if i != 0 {
 					buf.WriteString(`,`)
 				}
-				fflib.WriteJsonString(buf, string(v))
+				/* Interface types must use runtime reflection. type=interface {} kind=interface */
+				err = buf.Encode(v)
+				if err != nil {
+					return err
+				}
 			}
 			buf.WriteString(`]`)
 		} else {
@@ -5405,6 +6303,57 @@ func (j *Schema) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 		}
 		buf.WriteByte(',')
 	}
+	if j.Const != nil {
+		buf.WriteString(`"const":`)
+		/* Interface types must use runtime reflection. type=interface {} kind=interface */
+		err = buf.Encode(j.Const)
+		if err != nil {
+			return err
+		}
+		buf.WriteByte(',')
+	}
+	if j.MinLength != nil {
+		if true {
+			buf.WriteString(`"minLength":`)
+			fflib.FormatBits2(buf, uint64(*j.MinLength), 10, *j.MinLength < 0)
+			buf.WriteByte(',')
+		}
+	}
+	if j.MaxLength != nil {
+		if true {
+			buf.WriteString(`"maxLength":`)
+			fflib.FormatBits2(buf, uint64(*j.MaxLength), 10, *j.MaxLength < 0)
+			buf.WriteByte(',')
+		}
+	}
+	if j.Minimum != nil {
+		if true {
+			buf.WriteString(`"minimum":`)
+			fflib.AppendFloat(buf, float64(*j.Minimum), 'g', -1, 64)
+			buf.WriteByte(',')
+		}
+	}
+	if j.Maximum != nil {
+		if true {
+			buf.WriteString(`"maximum":`)
+			fflib.AppendFloat(buf, float64(*j.Maximum), 'g', -1, 64)
+			buf.WriteByte(',')
+		}
+	}
+	if j.MinItems != nil {
+		if true {
+			buf.WriteString(`"minItems":`)
+			fflib.FormatBits2(buf, uint64(*j.MinItems), 10, *j.MinItems < 0)
+			buf.WriteByte(',')
+		}
+	}
+	if j.MaxItems != nil {
+		if true {
+			buf.WriteString(`"maxItems":`)
+			fflib.FormatBits2(buf, uint64(*j.MaxItems), 10, *j.MaxItems < 0)
+			buf.WriteByte(',')
+		}
+	}
 	if j.Example != nil {
 		buf.WriteString(`"example":`)
 		/* Interface types must use runtime reflection. type=interface {} kind=interface */
@@ -5414,6 +6363,34 @@ func (j *Schema) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 		}
 		buf.WriteByte(',')
 	}
+	if len(j.Examples) != 0 {
+		buf.WriteString(`"examples":`)
+		if j.Examples != nil {
+			buf.WriteString(`[`)
+			for i, v := range j.Examples {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				/* Interface types must use runtime reflection. type=interface {} kind=interface */
+				err = buf.Encode(v)
+				if err != nil {
+					return err
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if j.Deprecated != false {
+		if j.Deprecated {
+			buf.WriteString(`"deprecated":true`)
+		} else {
+			buf.WriteString(`"deprecated":false`)
+		}
+		buf.WriteByte(',')
+	}
 	buf.Rewind(1)
 	buf.WriteByte('}')
 	return nil
@@ -5429,17 +6406,41 @@ const (
 
 	ffjtSchemaType
 
+	ffjtSchemaNullable
+
 	ffjtSchemaFormat
 
 	ffjtSchemaProperties
 
+	ffjtSchemaRequired
+
 	ffjtSchemaItems
 
 	ffjtSchemaAnyOf
 
+	ffjtSchemaOneOf
+
 	ffjtSchemaEnum
 
+	ffjtSchemaConst
+
+	ffjtSchemaMinLength
+
+	ffjtSchemaMaxLength
+
+	ffjtSchemaMinimum
+
+	ffjtSchemaMaximum
+
+	ffjtSchemaMinItems
+
+	ffjtSchemaMaxItems
+
 	ffjtSchemaExample
+
+	ffjtSchemaExamples
+
+	ffjtSchemaDeprecated
 )
 
 var ffjKeySchemaDescription = []byte("description")
@@ -5448,18 +6449,42 @@ var ffjKeySchemaRef = []byte("$ref")
 
 var ffjKeySchemaType = []byte("type")
 
+var ffjKeySchemaNullable = []byte("nullable")
+
 var ffjKeySchemaFormat = []byte("format")
 
 var ffjKeySchemaProperties = []byte("properties")
 
+var ffjKeySchemaRequired = []byte("required")
+
 var ffjKeySchemaItems = []byte("items")
 
 var ffjKeySchemaAnyOf = []byte("anyOf")
 
+var ffjKeySchemaOneOf = []byte("oneOf")
+
 var ffjKeySchemaEnum = []byte("enum")
 
+var ffjKeySchemaConst = []byte("const")
+
+var ffjKeySchemaMinLength = []byte("minLength")
+
+var ffjKeySchemaMaxLength = []byte("maxLength")
+
+var ffjKeySchemaMinimum = []byte("minimum")
+
+var ffjKeySchemaMaximum = []byte("maximum")
+
+var ffjKeySchemaMinItems = []byte("minItems")
+
+var ffjKeySchemaMaxItems = []byte("maxItems")
+
 var ffjKeySchemaExample = []byte("example")
 
+var ffjKeySchemaExamples = []byte("examples")
+
+var ffjKeySchemaDeprecated = []byte("deprecated")
+
 // UnmarshalJSON umarshall json - template of ffjson
 func (j *Schema) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
@@ -5537,12 +6562,25 @@ mainparse:
 						goto mainparse
 					}
 
+				case 'c':
+
+					if bytes.Equal(ffjKeySchemaConst, kn) {
+						currentKey = ffjtSchemaConst
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
 				case 'd':
 
 					if bytes.Equal(ffjKeySchemaDescription, kn) {
 						currentKey = ffjtSchemaDescription
 						state = fflib.FFParse_want_colon
 						goto mainparse
+
+					} else if bytes.Equal(ffjKeySchemaDeprecated, kn) {
+						currentKey = ffjtSchemaDeprecated
+						state = fflib.FFParse_want_colon
+						goto mainparse
 					}
 
 				case 'e':
@@ -5556,6 +6594,11 @@ mainparse:
 						currentKey = ffjtSchemaExample
 						state = fflib.FFParse_want_colon
 						goto mainparse
+
+					} else if bytes.Equal(ffjKeySchemaExamples, kn) {
+						currentKey = ffjtSchemaExamples
+						state = fflib.FFParse_want_colon
+						goto mainparse
 					}
 
 				case 'f':
@@ -5574,38 +6617,155 @@ mainparse:
 						goto mainparse
 					}
 
-				case 'p':
+				case 'm':
 
-					if bytes.Equal(ffjKeySchemaProperties, kn) {
-						currentKey = ffjtSchemaProperties
+					if bytes.Equal(ffjKeySchemaMinLength, kn) {
+						currentKey = ffjtSchemaMinLength
 						state = fflib.FFParse_want_colon
 						goto mainparse
-					}
 
-				case 't':
+					} else if bytes.Equal(ffjKeySchemaMaxLength, kn) {
+						currentKey = ffjtSchemaMaxLength
+						state = fflib.FFParse_want_colon
+						goto mainparse
 
-					if bytes.Equal(ffjKeySchemaType, kn) {
-						currentKey = ffjtSchemaType
+					} else if bytes.Equal(ffjKeySchemaMinimum, kn) {
+						currentKey = ffjtSchemaMinimum
 						state = fflib.FFParse_want_colon
 						goto mainparse
-					}
 
-				}
+					} else if bytes.Equal(ffjKeySchemaMaximum, kn) {
+						currentKey = ffjtSchemaMaximum
+						state = fflib.FFParse_want_colon
+						goto mainparse
 
-				if fflib.SimpleLetterEqualFold(ffjKeySchemaExample, kn) {
-					currentKey = ffjtSchemaExample
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
+					} else if bytes.Equal(ffjKeySchemaMinItems, kn) {
+						currentKey = ffjtSchemaMinItems
+						state = fflib.FFParse_want_colon
+						goto mainparse
 
-				if fflib.SimpleLetterEqualFold(ffjKeySchemaEnum, kn) {
-					currentKey = ffjtSchemaEnum
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
+					} else if bytes.Equal(ffjKeySchemaMaxItems, kn) {
+						currentKey = ffjtSchemaMaxItems
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
 
-				if fflib.SimpleLetterEqualFold(ffjKeySchemaAnyOf, kn) {
-					currentKey = ffjtSchemaAnyOf
+				case 'n':
+
+					if bytes.Equal(ffjKeySchemaNullable, kn) {
+						currentKey = ffjtSchemaNullable
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'o':
+
+					if bytes.Equal(ffjKeySchemaOneOf, kn) {
+						currentKey = ffjtSchemaOneOf
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'p':
+
+					if bytes.Equal(ffjKeySchemaProperties, kn) {
+						currentKey = ffjtSchemaProperties
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'r':
+
+					if bytes.Equal(ffjKeySchemaRequired, kn) {
+						currentKey = ffjtSchemaRequired
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffjKeySchemaType, kn) {
+						currentKey = ffjtSchemaType
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeySchemaDeprecated, kn) {
+					currentKey = ffjtSchemaDeprecated
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeySchemaExamples, kn) {
+					currentKey = ffjtSchemaExamples
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeySchemaExample, kn) {
+					currentKey = ffjtSchemaExample
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeySchemaMaxItems, kn) {
+					currentKey = ffjtSchemaMaxItems
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeySchemaMinItems, kn) {
+					currentKey = ffjtSchemaMinItems
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeySchemaMaximum, kn) {
+					currentKey = ffjtSchemaMaximum
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeySchemaMinimum, kn) {
+					currentKey = ffjtSchemaMinimum
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeySchemaMaxLength, kn) {
+					currentKey = ffjtSchemaMaxLength
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeySchemaMinLength, kn) {
+					currentKey = ffjtSchemaMinLength
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeySchemaConst, kn) {
+					currentKey = ffjtSchemaConst
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeySchemaEnum, kn) {
+					currentKey = ffjtSchemaEnum
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeySchemaOneOf, kn) {
+					currentKey = ffjtSchemaOneOf
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeySchemaAnyOf, kn) {
+					currentKey = ffjtSchemaAnyOf
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
@@ -5616,6 +6776,12 @@ mainparse:
 					goto mainparse
 				}
 
+				if fflib.SimpleLetterEqualFold(ffjKeySchemaRequired, kn) {
+					currentKey = ffjtSchemaRequired
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
 				if fflib.EqualFoldRight(ffjKeySchemaProperties, kn) {
 					currentKey = ffjtSchemaProperties
 					state = fflib.FFParse_want_colon
@@ -5628,6 +6794,12 @@ mainparse:
 					goto mainparse
 				}
 
+				if fflib.SimpleLetterEqualFold(ffjKeySchemaNullable, kn) {
+					currentKey = ffjtSchemaNullable
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
 				if fflib.SimpleLetterEqualFold(ffjKeySchemaType, kn) {
 					currentKey = ffjtSchemaType
 					state = fflib.FFParse_want_colon
@@ -5672,24 +6844,60 @@ mainparse:
 				case ffjtSchemaType:
 					goto handle_Type
 
+				case ffjtSchemaNullable:
+					goto handle_Nullable
+
 				case ffjtSchemaFormat:
 					goto handle_Format
 
 				case ffjtSchemaProperties:
 					goto handle_Properties
 
+				case ffjtSchemaRequired:
+					goto handle_Required
+
 				case ffjtSchemaItems:
 					goto handle_Items
 
 				case ffjtSchemaAnyOf:
 					goto handle_AnyOf
 
+				case ffjtSchemaOneOf:
+					goto handle_OneOf
+
 				case ffjtSchemaEnum:
 					goto handle_Enum
 
+				case ffjtSchemaConst:
+					goto handle_Const
+
+				case ffjtSchemaMinLength:
+					goto handle_MinLength
+
+				case ffjtSchemaMaxLength:
+					goto handle_MaxLength
+
+				case ffjtSchemaMinimum:
+					goto handle_Minimum
+
+				case ffjtSchemaMaximum:
+					goto handle_Maximum
+
+				case ffjtSchemaMinItems:
+					goto handle_MinItems
+
+				case ffjtSchemaMaxItems:
+					goto handle_MaxItems
+
 				case ffjtSchemaExample:
 					goto handle_Example
 
+				case ffjtSchemaExamples:
+					goto handle_Examples
+
+				case ffjtSchemaDeprecated:
+					goto handle_Deprecated
+
 				case ffjtSchemanosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
@@ -5758,23 +6966,52 @@ handle_Ref:
 
 handle_Type:
 
-	/* handler: j.Type type=string kind=string quoted=false*/
+	/* handler: j.Type type=interface {} kind=interface quoted=false*/
 
 	{
+		/* Falling back. type=interface {} kind=interface */
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
 
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
+		err = json.Unmarshal(tbuf, &j.Type)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Nullable:
+
+	/* handler: j.Nullable type=bool kind=bool quoted=false*/
+
+	{
+		if tok != fflib.FFTok_bool && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for bool", tok))
 		}
+	}
 
+	{
 		if tok == fflib.FFTok_null {
 
 		} else {
+			tmpb := fs.Output.Bytes()
 
-			outBuf := fs.Output.Bytes()
+			if bytes.Compare([]byte{'t', 'r', 'u', 'e'}, tmpb) == 0 {
 
-			j.Type = string(string(outBuf))
+				j.Nullable = true
+
+			} else if bytes.Compare([]byte{'f', 'a', 'l', 's', 'e'}, tmpb) == 0 {
+
+				j.Nullable = false
+
+			} else {
+				err = errors.New("unexpected bytes for true/false value")
+				return fs.WrapErr(err)
+			}
 
 		}
 	}
@@ -5913,6 +7150,80 @@ handle_Properties:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
+handle_Required:
+
+	/* handler: j.Required type=[]string kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.Required = nil
+		} else {
+
+			j.Required = []string{}
+
+			wantVal := true
+
+			for {
+
+				var tmpJRequired string
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmpJRequired type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						tmpJRequired = string(string(outBuf))
+
+					}
+				}
+
+				j.Required = append(j.Required, tmpJRequired)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
 handle_Items:
 
 	/* handler: j.Items type=openapi.Schema kind=struct quoted=false*/
@@ -6007,9 +7318,9 @@ handle_AnyOf:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Enum:
+handle_OneOf:
 
-	/* handler: j.Enum type=[]string kind=slice quoted=false*/
+	/* handler: j.OneOf type=[]openapi.Schema kind=slice quoted=false*/
 
 	{
 
@@ -6020,16 +7331,16 @@ handle_Enum:
 		}
 
 		if tok == fflib.FFTok_null {
-			j.Enum = nil
+			j.OneOf = nil
 		} else {
 
-			j.Enum = []string{}
+			j.OneOf = []Schema{}
 
 			wantVal := true
 
 			for {
 
-				var tmpJEnum string
+				var tmpJOneOf Schema
 
 				tok = fs.Scan()
 				if tok == fflib.FFTok_error {
@@ -6050,28 +7361,22 @@ handle_Enum:
 					wantVal = true
 				}
 
-				/* handler: tmpJEnum type=string kind=string quoted=false*/
+				/* handler: tmpJOneOf type=openapi.Schema kind=struct quoted=false*/
 
 				{
-
-					{
-						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-						}
-					}
-
 					if tok == fflib.FFTok_null {
 
 					} else {
 
-						outBuf := fs.Output.Bytes()
-
-						tmpJEnum = string(string(outBuf))
-
+						err = tmpJOneOf.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						if err != nil {
+							return err
+						}
 					}
+					state = fflib.FFParse_after_value
 				}
 
-				j.Enum = append(j.Enum, tmpJEnum)
+				j.OneOf = append(j.OneOf, tmpJOneOf)
 
 				wantVal = false
 			}
@@ -6081,20 +7386,813 @@ handle_Enum:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Example:
+handle_Enum:
 
-	/* handler: j.Example type=interface {} kind=interface quoted=false*/
+	/* handler: j.Enum type=[]interface {} kind=slice quoted=false*/
 
 	{
-		/* Falling back. type=interface {} kind=interface */
-		tbuf, err := fs.CaptureField(tok)
-		if err != nil {
-			return fs.WrapErr(err)
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
 		}
 
-		err = json.Unmarshal(tbuf, &j.Example)
-		if err != nil {
-			return fs.WrapErr(err)
+		if tok == fflib.FFTok_null {
+			j.Enum = nil
+		} else {
+
+			j.Enum = []interface{}{}
+
+			wantVal := true
+
+			for {
+
+				var tmpJEnum interface{}
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmpJEnum type=interface {} kind=interface quoted=false*/
+
+				{
+					/* Falling back. type=interface {} kind=interface */
+					tbuf, err := fs.CaptureField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+
+					err = json.Unmarshal(tbuf, &tmpJEnum)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+				}
+
+				j.Enum = append(j.Enum, tmpJEnum)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Const:
+
+	/* handler: j.Const type=interface {} kind=interface quoted=false*/
+
+	{
+		/* Falling back. type=interface {} kind=interface */
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = json.Unmarshal(tbuf, &j.Const)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_MinLength:
+
+	/* handler: j.MinLength type=int64 kind=int64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+			j.MinLength = nil
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			ttypval := int64(tval)
+			j.MinLength = &ttypval
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_MaxLength:
+
+	/* handler: j.MaxLength type=int64 kind=int64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+			j.MaxLength = nil
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			ttypval := int64(tval)
+			j.MaxLength = &ttypval
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Minimum:
+
+	/* handler: j.Minimum type=float64 kind=float64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_double && tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for float64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+			j.Minimum = nil
+
+		} else {
+
+			tval, err := fflib.ParseFloat(fs.Output.Bytes(), 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			ttypval := float64(tval)
+			j.Minimum = &ttypval
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Maximum:
+
+	/* handler: j.Maximum type=float64 kind=float64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_double && tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for float64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+			j.Maximum = nil
+
+		} else {
+
+			tval, err := fflib.ParseFloat(fs.Output.Bytes(), 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			ttypval := float64(tval)
+			j.Maximum = &ttypval
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_MinItems:
+
+	/* handler: j.MinItems type=int64 kind=int64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+			j.MinItems = nil
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			ttypval := int64(tval)
+			j.MinItems = &ttypval
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_MaxItems:
+
+	/* handler: j.MaxItems type=int64 kind=int64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+			j.MaxItems = nil
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			ttypval := int64(tval)
+			j.MaxItems = &ttypval
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Example:
+
+	/* handler: j.Example type=interface {} kind=interface quoted=false*/
+
+	{
+		/* Falling back. type=interface {} kind=interface */
+		tbuf, err := fs.CaptureField(tok)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+
+		err = json.Unmarshal(tbuf, &j.Example)
+		if err != nil {
+			return fs.WrapErr(err)
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Examples:
+
+	/* handler: j.Examples type=[]interface {} kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.Examples = nil
+		} else {
+
+			j.Examples = []interface{}{}
+
+			wantVal := true
+
+			for {
+
+				var tmpJExamples interface{}
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmpJExamples type=interface {} kind=interface quoted=false*/
+
+				{
+					/* Falling back. type=interface {} kind=interface */
+					tbuf, err := fs.CaptureField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+
+					err = json.Unmarshal(tbuf, &tmpJExamples)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+				}
+
+				j.Examples = append(j.Examples, tmpJExamples)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Deprecated:
+
+	/* handler: j.Deprecated type=bool kind=bool quoted=false*/
+
+	{
+		if tok != fflib.FFTok_bool && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for bool", tok))
+		}
+	}
+
+	{
+		if tok == fflib.FFTok_null {
+
+		} else {
+			tmpb := fs.Output.Bytes()
+
+			if bytes.Compare([]byte{'t', 'r', 'u', 'e'}, tmpb) == 0 {
+
+				j.Deprecated = true
+
+			} else if bytes.Compare([]byte{'f', 'a', 'l', 's', 'e'}, tmpb) == 0 {
+
+				j.Deprecated = false
+
+			} else {
+				err = errors.New("unexpected bytes for true/false value")
+				return fs.WrapErr(err)
+			}
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *SecurityScheme) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *SecurityScheme) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ "type":`)
+	fflib.WriteJsonString(buf, string(j.Type))
+	buf.WriteByte(',')
+	if len(j.Scheme) != 0 {
+		buf.WriteString(`"scheme":`)
+		fflib.WriteJsonString(buf, string(j.Scheme))
+		buf.WriteByte(',')
+	}
+	if len(j.BearerFormat) != 0 {
+		buf.WriteString(`"bearerFormat":`)
+		fflib.WriteJsonString(buf, string(j.BearerFormat))
+		buf.WriteByte(',')
+	}
+	if len(j.In) != 0 {
+		buf.WriteString(`"in":`)
+		fflib.WriteJsonString(buf, string(j.In))
+		buf.WriteByte(',')
+	}
+	if len(j.Name) != 0 {
+		buf.WriteString(`"name":`)
+		fflib.WriteJsonString(buf, string(j.Name))
+		buf.WriteByte(',')
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtSecuritySchemebase = iota
+	ffjtSecuritySchemenosuchkey
+
+	ffjtSecuritySchemeType
+
+	ffjtSecuritySchemeScheme
+
+	ffjtSecuritySchemeBearerFormat
+
+	ffjtSecuritySchemeIn
+
+	ffjtSecuritySchemeName
+)
+
+var ffjKeySecuritySchemeType = []byte("type")
+
+var ffjKeySecuritySchemeScheme = []byte("scheme")
+
+var ffjKeySecuritySchemeBearerFormat = []byte("bearerFormat")
+
+var ffjKeySecuritySchemeIn = []byte("in")
+
+var ffjKeySecuritySchemeName = []byte("name")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *SecurityScheme) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *SecurityScheme) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtSecuritySchemebase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtSecuritySchemenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'b':
+
+					if bytes.Equal(ffjKeySecuritySchemeBearerFormat, kn) {
+						currentKey = ffjtSecuritySchemeBearerFormat
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'i':
+
+					if bytes.Equal(ffjKeySecuritySchemeIn, kn) {
+						currentKey = ffjtSecuritySchemeIn
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'n':
+
+					if bytes.Equal(ffjKeySecuritySchemeName, kn) {
+						currentKey = ffjtSecuritySchemeName
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeySecuritySchemeScheme, kn) {
+						currentKey = ffjtSecuritySchemeScheme
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffjKeySecuritySchemeType, kn) {
+						currentKey = ffjtSecuritySchemeType
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeySecuritySchemeName, kn) {
+					currentKey = ffjtSecuritySchemeName
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeySecuritySchemeIn, kn) {
+					currentKey = ffjtSecuritySchemeIn
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeySecuritySchemeBearerFormat, kn) {
+					currentKey = ffjtSecuritySchemeBearerFormat
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeySecuritySchemeScheme, kn) {
+					currentKey = ffjtSecuritySchemeScheme
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeySecuritySchemeType, kn) {
+					currentKey = ffjtSecuritySchemeType
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtSecuritySchemenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtSecuritySchemeType:
+					goto handle_Type
+
+				case ffjtSecuritySchemeScheme:
+					goto handle_Scheme
+
+				case ffjtSecuritySchemeBearerFormat:
+					goto handle_BearerFormat
+
+				case ffjtSecuritySchemeIn:
+					goto handle_In
+
+				case ffjtSecuritySchemeName:
+					goto handle_Name
+
+				case ffjtSecuritySchemenosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Type:
+
+	/* handler: j.Type type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Type = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Scheme:
+
+	/* handler: j.Scheme type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Scheme = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_BearerFormat:
+
+	/* handler: j.BearerFormat type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.BearerFormat = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_In:
+
+	/* handler: j.In type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.In = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Name:
+
+	/* handler: j.Name type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Name = string(string(outBuf))
+
 		}
 	}
 
@@ -0,0 +1,49 @@
+package openrpc
+
+import (
+	"github.com/swipe-io/swipe/v3/internal/plugin/gokit/openapi"
+)
+
+type Tag struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+type ContentDescriptor struct {
+	Name        string          `json:"name"`
+	Summary     string          `json:"summary,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Required    bool            `json:"required,omitempty"`
+	Schema      *openapi.Schema `json:"schema"`
+	Deprecated  bool            `json:"deprecated,omitempty"`
+}
+
+type Error struct {
+	Code    int64       `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+type Method struct {
+	Name           string              `json:"name"`
+	Tags           []Tag               `json:"tags,omitempty"`
+	Summary        string              `json:"summary,omitempty"`
+	Description    string              `json:"description,omitempty"`
+	ParamStructure string              `json:"paramStructure,omitempty"`
+	Params         []ContentDescriptor `json:"params"`
+	Result         *ContentDescriptor  `json:"result"`
+	Errors         []Error             `json:"errors,omitempty"`
+	Deprecated     bool                `json:"deprecated,omitempty"`
+}
+
+type Components struct {
+	Schemas openapi.Schemas `json:"schemas,omitempty"`
+}
+
+type OpenRPC struct {
+	OpenRPC    string           `json:"openrpc"`
+	Info       openapi.Info     `json:"info"`
+	Servers    []openapi.Server `json:"servers,omitempty"`
+	Methods    []Method         `json:"methods"`
+	Components Components       `json:"components,omitempty"`
+}
@@ -7,6 +7,9 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/swipe-io/swipe/v3/internal/plugin"
@@ -47,6 +50,7 @@ func (p *Plugin) Configure(cfg *swipe.Config, module *option.Module, options map
 	p.workdir = cfg.WorkDir
 
 	p.config.AppName = strcase.ToCamel(appName)
+	p.config.ModulePath = module.Path
 
 	funcDeclTypes := makeFuncDeclTypes(cfg.Packages)
 	funcDeclIfaceTypes := makeFuncIfaceDeclTypes(cfg.Packages, funcDeclTypes)
@@ -63,6 +67,15 @@ func (p *Plugin) Configure(cfg *swipe.Config, module *option.Module, options map
 		}
 	}
 
+	for _, iface := range p.config.Interfaces {
+		iface.DeprecatedMethods = map[string]config.Deprecation{}
+		for _, d := range iface.Deprecations {
+			for _, m := range d.Methods {
+				iface.DeprecatedMethods[m.Name.Value] = d
+			}
+		}
+	}
+
 	for _, iface := range p.config.Interfaces {
 		ifaceType := iface.Named.Type.(*option.IfaceType)
 		for _, m := range ifaceType.Methods {
@@ -75,6 +88,54 @@ func (p *Plugin) Configure(cfg *swipe.Config, module *option.Module, options map
 			if !p.config.InstrumentingEnable && dstMethodOption.Instrumenting.Take() {
 				p.config.InstrumentingEnable = true
 			}
+			if !p.config.TracingEnable && dstMethodOption.Tracing.Take() {
+				p.config.TracingEnable = true
+			}
+			if !p.config.ValidationEnable && dstMethodOption.Validation.Take() {
+				p.config.ValidationEnable = true
+			}
+			if dstMethodOption.APIKeyAuth != nil {
+				switch dstMethodOption.APIKeyAuth.In {
+				default:
+					errs = append(errs, fmt.Errorf("%s.%s: api key auth location must be header or query, got %q", iface.Named.Name.Value, m.Name.Value, dstMethodOption.APIKeyAuth.In))
+					continue
+				case "header", "query":
+				}
+				if dstMethodOption.APIKeyAuth.Name == "" {
+					errs = append(errs, fmt.Errorf("%s.%s: api key auth name is required", iface.Named.Name.Value, m.Name.Value))
+					continue
+				}
+			}
+			if dstMethodOption.BearerAuth != nil || dstMethodOption.APIKeyAuth != nil || dstMethodOption.BasicAuth != nil {
+				p.config.AuthEnable = true
+			}
+			if dstMethodOption.RateLimit != nil {
+				if dstMethodOption.RateLimit.Rps <= 0 {
+					errs = append(errs, fmt.Errorf("%s.%s: rate limit rps must be greater than zero", iface.Named.Name.Value, m.Name.Value))
+					continue
+				}
+				if dstMethodOption.RateLimit.Burst <= 0 {
+					errs = append(errs, fmt.Errorf("%s.%s: rate limit burst must be greater than zero", iface.Named.Name.Value, m.Name.Value))
+					continue
+				}
+			}
+
+			if codec := dstMethodOption.RESTCodec.Take(); codec != "" {
+				if p.config.CodecsEnable == nil {
+					errs = append(errs, fmt.Errorf("%s.%s: RESTCodec requires CodecsEnable", iface.Named.Name.Value, m.Name.Value))
+					continue
+				}
+				if !strings.Contains(codec, "/") && codec != "json" && !p.config.CodecsEnable.Contains(codec) {
+					errs = append(errs, fmt.Errorf("%s.%s: codec %q is not enabled in CodecsEnable, custom codecs are set by the media type", iface.Named.Name.Value, m.Name.Value, codec))
+					continue
+				}
+				switch dstMethodOption.RESTBodyType.Take() {
+				default:
+					errs = append(errs, fmt.Errorf("%s.%s: RESTCodec requires the json body type", iface.Named.Name.Value, m.Name.Value))
+					continue
+				case "", "json":
+				}
+			}
 
 			if p.config.JSONRPCEnable == nil && dstMethodOption.RESTPath.Value != nil {
 				pathVars, err := plugin.PathVars(dstMethodOption.RESTPath.Take())
@@ -84,6 +145,46 @@ func (p *Plugin) Configure(cfg *swipe.Config, module *option.Module, options map
 				}
 				dstMethodOption.RESTPathVars = pathVars
 			}
+
+			if kind := dstMethodOption.Paginated.Take(); kind != "" {
+				if p.config.JSONRPCEnable != nil {
+					errs = append(errs, fmt.Errorf("%s.%s: Paginated is not supported with JSONRPCEnable, the pagination uses the query vars and the response headers", iface.Named.Name.Value, m.Name.Value))
+					continue
+				}
+				pagination, err := plugin.FindPagination(m, kind)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("%s.%s: %w", iface.Named.Name.Value, m.Name.Value, err))
+					continue
+				}
+				// the limit and the offset or cursor params are mapped to the query vars of the same name,
+				// the slice is copied because the default options share it between the methods.
+				queryVars := append([]string{}, dstMethodOption.RESTQueryVars.Value...)
+				for _, v := range []*option.VarType{pagination.Limit, pagination.Page} {
+					if _, ok := plugin.FindParam(v, queryVars); ok {
+						continue
+					}
+					_, isHeaderVar := plugin.FindParam(v, dstMethodOption.RESTHeaderVars.Value)
+					if _, isPathVar := dstMethodOption.RESTPathVars[v.Name.Value]; isHeaderVar || isPathVar {
+						errs = append(errs, fmt.Errorf("%s.%s: paginated method %s param must be a query var", iface.Named.Name.Value, m.Name.Value, v.Name.Value))
+						continue
+					}
+					queryVars = append(queryVars, v.Name.Value, v.Name.Value)
+				}
+				dstMethodOption.RESTQueryVars.Value = queryVars
+			}
+
+			if dstMethodOption.Idempotent != nil {
+				if p.config.JSONRPCEnable != nil {
+					errs = append(errs, fmt.Errorf("%s.%s: Idempotent is not supported with JSONRPCEnable, the key is sent in the Idempotency-Key header", iface.Named.Name.Value, m.Name.Value))
+					continue
+				}
+				switch strings.ToUpper(dstMethodOption.RESTMethod.Take()) {
+				case "", "GET", "HEAD", "OPTIONS":
+					errs = append(errs, fmt.Errorf("%s.%s: Idempotent requires a non-GET RESTMethod, the GET methods are idempotent already", iface.Named.Name.Value, m.Name.Value))
+					continue
+				}
+			}
+
 			p.config.MethodOptionsMap[iface.Named.Name.Value+m.Name.Value] = dstMethodOption
 		}
 	}
@@ -131,6 +232,10 @@ func (p *Plugin) Options() []byte {
 func (p *Plugin) Generators() (generators []swipe.Generator, errs []error) {
 	goClientEnable := p.config.ClientsEnable.Langs.Contains("go")
 	jsClientEnable := p.config.ClientsEnable.Langs.Contains("js")
+	tsClientEnable := p.config.ClientsEnable.Langs.Contains("ts")
+	pythonClientEnable := p.config.ClientsEnable.Langs.Contains("python")
+	kotlinClientEnable := p.config.ClientsEnable.Langs.Contains("kotlin")
+	swiftClientEnable := p.config.ClientsEnable.Langs.Contains("swift")
 	jsonRPCEnable := p.config.JSONRPCEnable != nil
 	httpServerEnable := p.config.HTTPServer != nil
 	useFast := p.config.HTTPFast != nil
@@ -153,6 +258,28 @@ func (p *Plugin) Generators() (generators []swipe.Generator, errs []error) {
 			URL:           p.config.CURLURL.Take(),
 		})
 	}
+	if p.config.PostmanEnable != nil {
+		generators = append(generators, &generator.Postman{
+			AppName:       p.config.AppName,
+			Info:          p.config.OpenapiInfo,
+			Servers:       p.config.OpenapiServers,
+			Interfaces:    p.config.Interfaces,
+			MethodOptions: p.config.MethodOptionsMap,
+			JSONRPCEnable: jsonRPCEnable,
+			JSONRPCPath:   p.config.JSONRPCPath.Take(),
+			Output:        p.config.PostmanOutput.Take(),
+		})
+	}
+	if p.config.HTTPFileEnable != nil {
+		generators = append(generators, &generator.HTTPFile{
+			Servers:       p.config.OpenapiServers,
+			Interfaces:    p.config.Interfaces,
+			MethodOptions: p.config.MethodOptionsMap,
+			JSONRPCEnable: jsonRPCEnable,
+			JSONRPCPath:   p.config.JSONRPCPath.Take(),
+			Output:        p.config.HTTPFileOutput.Take(),
+		})
+	}
 	if p.config.LoggingEnable {
 		generators = append(generators,
 			&generator.Logging{
@@ -171,7 +298,80 @@ func (p *Plugin) Generators() (generators []swipe.Generator, errs []error) {
 		)
 	}
 
-	if p.config.InstrumentingEnable || p.config.LoggingEnable || httpServerEnable {
+	if p.config.TracingEnable {
+		generators = append(generators,
+			&generator.Tracing{
+				Interfaces:    p.config.Interfaces,
+				MethodOptions: p.config.MethodOptionsMap,
+			},
+		)
+	}
+
+	if p.config.ValidationEnable {
+		generators = append(generators,
+			&generator.Validation{
+				Interfaces:    p.config.Interfaces,
+				MethodOptions: p.config.MethodOptionsMap,
+			},
+		)
+	}
+
+	if p.config.MockEnable != nil {
+		mockOutput := p.config.MockOutput.Take()
+		var mockPkg string
+		if mockOutput != "" {
+			mockPkg = strcase.ToSnake(filepath.Base(mockOutput))
+		}
+		generators = append(generators, &generator.MockGenerator{
+			Interfaces: p.config.Interfaces,
+			Output:     mockOutput,
+			Pkg:        mockPkg,
+		})
+	}
+
+	// the generated tests use the mocks from the server package.
+	if p.config.ContractTestsEnable != nil || (p.config.FuzzEnable != nil && !jsonRPCEnable) {
+		if p.config.MockEnable == nil || p.config.MockOutput.Take() != "" {
+			generators = append(generators, &generator.MockGenerator{
+				Interfaces: p.config.Interfaces,
+			})
+		}
+	}
+
+	if p.config.ContractTestsEnable != nil {
+		clientPath, err := filepath.Abs(filepath.Join(p.workdir, output))
+		if err != nil {
+			errs = append(errs, err)
+			return
+		}
+		for i, iface := range p.config.Interfaces {
+			generators = append(generators, &generator.ContractTestGenerator{
+				Interface:     iface,
+				Interfaces:    p.config.Interfaces,
+				MethodOptions: p.config.MethodOptionsMap,
+				IfaceErrors:   p.config.IfaceErrors,
+				JSONRPCEnable: jsonRPCEnable,
+				JSONRPCPath:   p.config.JSONRPCPath.Take(),
+				ClientPkgName: pkg,
+				ClientPkgPath: p.config.ModulePath + filepath.ToSlash(strings.Replace(clientPath, p.workdir, "", -1)),
+				WriteHelpers:  i == 0,
+			})
+		}
+	}
+
+	if p.config.FuzzEnable != nil {
+		for _, iface := range p.config.Interfaces {
+			generators = append(generators, &generator.FuzzTestGenerator{
+				Interface:     iface,
+				Interfaces:    p.config.Interfaces,
+				MethodOptions: p.config.MethodOptionsMap,
+				JSONRPCEnable: jsonRPCEnable,
+				UseFast:       useFast,
+			})
+		}
+	}
+
+	if p.config.InstrumentingEnable || p.config.LoggingEnable || p.config.TracingEnable || p.config.ValidationEnable || httpServerEnable {
 		generators = append(generators, &generator.InterfaceGenerator{
 			Interfaces: p.config.Interfaces,
 		})
@@ -185,6 +385,12 @@ func (p *Plugin) Generators() (generators []swipe.Generator, errs []error) {
 				JSONRPCEnable:    jsonRPCEnable,
 				HTTPServerEnable: httpServerEnable,
 				UseFast:          useFast,
+				TracingEnable:    p.config.TracingEnable,
+				CORS:             p.config.HTTPCORS,
+				HealthEndpoints:  p.config.HealthEndpoints,
+				Codecs:           p.config.CodecsEnable,
+				MethodOptions:    p.config.MethodOptionsMap,
+				AppName:          p.config.AppName,
 			},
 			&generator.Endpoint{
 				Interfaces:       p.config.Interfaces,
@@ -192,18 +398,28 @@ func (p *Plugin) Generators() (generators []swipe.Generator, errs []error) {
 			},
 		)
 		if p.config.OpenapiEnable != nil {
-			generators = append(generators, &generator.Openapi{
-				JSONRPCEnable: jsonRPCEnable,
-				Contact:       p.config.OpenapiContact,
-				Info:          p.config.OpenapiInfo,
-				MethodTags:    p.config.OpenapiMethodTags,
-				Licence:       p.config.OpenapiLicence,
-				Servers:       p.config.OpenapiServers,
-				Output:        p.config.OpenapiOutput.Take(),
-				Interfaces:    p.config.Interfaces,
-				MethodOptions: p.config.MethodOptionsMap,
-				IfaceErrors:   p.config.IfaceErrors,
-			})
+			// each interface version is documented separately
+			versions, versionInterfaces := generator.OpenapiVersions(p.config.Interfaces)
+			for _, version := range versions {
+				generators = append(generators, &generator.Openapi{
+					JSONRPCEnable:    jsonRPCEnable,
+					ValidationEnable: p.config.ValidationEnable,
+					Contact:          p.config.OpenapiContact,
+					Info:             p.config.OpenapiInfo,
+					MethodTags:       p.config.OpenapiMethodTags,
+					Licence:          p.config.OpenapiLicence,
+					Servers:          p.config.OpenapiServers,
+					Output:           p.config.OpenapiOutput.Take(),
+					Interfaces:       versionInterfaces[version],
+					MethodOptions:    p.config.MethodOptionsMap,
+					IfaceErrors:      p.config.IfaceErrors,
+					RESTErrorFormat:  p.config.RESTErrorFormat.Take(),
+					Codecs:           p.config.CodecsEnable,
+					Version:          p.config.OpenapiVersion.Take(),
+					APIVersion:       version,
+					Webhooks:         p.config.OpenapiWebhooks,
+				})
+			}
 		}
 		if p.config.HasExternal {
 			generators = append(generators, &generator.GatewayGenerator{
@@ -212,9 +428,14 @@ func (p *Plugin) Generators() (generators []swipe.Generator, errs []error) {
 		}
 		if jsonRPCEnable {
 			generators = append(generators, &generator.JSONRPCServerGenerator{
-				UseFast:     useFast,
-				Interfaces:  p.config.Interfaces,
-				JSONRPCPath: p.config.JSONRPCPath.Take(),
+				UseFast:         useFast,
+				TracingEnable:   p.config.TracingEnable,
+				CORS:            p.config.HTTPCORS,
+				Codecs:          p.config.CodecsEnable,
+				HealthEndpoints: p.config.HealthEndpoints,
+				Interfaces:      p.config.Interfaces,
+				MethodOptions:   p.config.MethodOptionsMap,
+				JSONRPCPath:     p.config.JSONRPCPath.Take(),
 			})
 			if jsClientEnable {
 				generators = append(generators, &generator.JSONRPCJSClientGenerator{
@@ -231,13 +452,91 @@ func (p *Plugin) Generators() (generators []swipe.Generator, errs []error) {
 					Output:          p.config.JSONRPCDocOutput.Take(),
 				})
 			}
+			if p.config.OpenRPCEnable != nil {
+				generators = append(generators, &generator.OpenRPC{
+					AppName:          p.config.AppName,
+					ValidationEnable: p.config.ValidationEnable,
+					Info:             p.config.OpenapiInfo,
+					Contact:          p.config.OpenapiContact,
+					Licence:          p.config.OpenapiLicence,
+					Servers:          p.config.OpenapiServers,
+					Interfaces:       p.config.Interfaces,
+					MethodOptions:    p.config.MethodOptionsMap,
+					IfaceErrors:      p.config.IfaceErrors,
+					Output:           p.config.OpenRPCOutput.Take(),
+				})
+			}
 
 		} else {
 			generators = append(generators, &generator.RESTServerGenerator{
-				UseFast:       useFast,
+				UseFast:         useFast,
+				JSONRPCEnable:   jsonRPCEnable,
+				TracingEnable:   p.config.TracingEnable,
+				CORS:            p.config.HTTPCORS,
+				HealthEndpoints: p.config.HealthEndpoints,
+				Codecs:          p.config.CodecsEnable,
+				ErrorFormat:     p.config.RESTErrorFormat.Take(),
+				MethodOptions:   p.config.MethodOptionsMap,
+				Interfaces:      p.config.Interfaces,
+			})
+			if jsClientEnable {
+				generators = append(generators, &generator.RESTJSClientGenerator{
+					ErrorFormat:   p.config.RESTErrorFormat.Take(),
+					Interfaces:    p.config.Interfaces,
+					MethodOptions: p.config.MethodOptionsMap,
+					IfaceErrors:   p.config.IfaceErrors,
+				})
+			}
+		}
+		if tsClientEnable {
+			generators = append(generators, &generator.TSClientGenerator{
+				JSONRPCEnable: jsonRPCEnable,
+				ErrorFormat:   p.config.RESTErrorFormat.Take(),
+				Interfaces:    p.config.Interfaces,
+				MethodOptions: p.config.MethodOptionsMap,
+				IfaceErrors:   p.config.IfaceErrors,
+			})
+		}
+		if pythonClientEnable {
+			pythonOutput := p.config.PythonClientOutput.Take()
+			if pythonOutput == "" {
+				pythonOutput = "./pyclient"
+			}
+			generators = append(generators, &generator.PythonClientGenerator{
 				JSONRPCEnable: jsonRPCEnable,
+				ErrorFormat:   p.config.RESTErrorFormat.Take(),
+				Interfaces:    p.config.Interfaces,
 				MethodOptions: p.config.MethodOptionsMap,
+				IfaceErrors:   p.config.IfaceErrors,
+				Output:        pythonOutput,
+			})
+		}
+		if kotlinClientEnable {
+			kotlinOutput := p.config.KotlinClientOutput.Take()
+			if kotlinOutput == "" {
+				kotlinOutput = "./ktclient"
+			}
+			generators = append(generators, &generator.KotlinClientGenerator{
+				JSONRPCEnable: jsonRPCEnable,
+				ErrorFormat:   p.config.RESTErrorFormat.Take(),
 				Interfaces:    p.config.Interfaces,
+				MethodOptions: p.config.MethodOptionsMap,
+				IfaceErrors:   p.config.IfaceErrors,
+				Output:        kotlinOutput,
+			})
+		}
+		if swiftClientEnable {
+			swiftOutput := p.config.SwiftClientOutput.Take()
+			if swiftOutput == "" {
+				swiftOutput = "./swiftclient"
+			}
+			generators = append(generators, &generator.SwiftClientGenerator{
+				JSONRPCEnable: jsonRPCEnable,
+				ErrorFormat:   p.config.RESTErrorFormat.Take(),
+				Interfaces:    p.config.Interfaces,
+				MethodOptions: p.config.MethodOptionsMap,
+				IfaceErrors:   p.config.IfaceErrors,
+				Output:        swiftOutput,
 			})
 		}
 	}
@@ -252,6 +551,9 @@ func (p *Plugin) Generators() (generators []swipe.Generator, errs []error) {
 				Interfaces:    p.config.Interfaces,
 				JSONRPCEnable: jsonRPCEnable,
 				UseFast:       useFast,
+				TracingEnable: p.config.TracingEnable,
+				Codecs:        p.config.CodecsEnable,
+				MethodOptions: p.config.MethodOptionsMap,
 				IfaceErrors:   p.config.IfaceErrors,
 				Pkg:           pkg,
 				Output:        output,
@@ -271,20 +573,27 @@ func (p *Plugin) Generators() (generators []swipe.Generator, errs []error) {
 				UseFast:       useFast,
 				JSONRPCEnable: jsonRPCEnable,
 				Interfaces:    p.config.Interfaces,
+				MethodOptions: p.config.MethodOptionsMap,
 				Pkg:           pkg,
 				Output:        output,
 			})
 		if jsonRPCEnable {
 			generators = append(generators, &generator.JSONRPCClientGenerator{
-				Interfaces: p.config.Interfaces,
-				UseFast:    useFast,
-				Pkg:        pkg,
-				Output:     output,
+				Interfaces:    p.config.Interfaces,
+				UseFast:       useFast,
+				TracingEnable: p.config.TracingEnable,
+				Codecs:        p.config.CodecsEnable,
+				MethodOptions: p.config.MethodOptionsMap,
+				Pkg:           pkg,
+				Output:        output,
 			})
 		} else {
 			generators = append(generators, &generator.RESTClientGenerator{
 				Interfaces:    p.config.Interfaces,
 				UseFast:       useFast,
+				TracingEnable: p.config.TracingEnable,
+				Codecs:        p.config.CodecsEnable,
+				ErrorFormat:   p.config.RESTErrorFormat.Take(),
 				MethodOptions: p.config.MethodOptionsMap,
 				Pkg:           pkg,
 				Output:        output,
@@ -308,10 +617,133 @@ func (p *Plugin) checkExternalPackage() (errs []error, hasExternal bool) {
 }
 
 func (p *Plugin) validateConfig() (errs []error) {
+	// the contract and fuzz tests use the mocks as well
+	mockEnable := p.config.MockEnable != nil || p.config.ContractTestsEnable != nil || (p.config.FuzzEnable != nil && p.config.JSONRPCEnable == nil)
+	ifaceNames := map[string]struct{}{}
 	for _, iface := range p.config.Interfaces {
 		if _, ok := iface.Named.Type.(*option.IfaceType); !ok {
 			errs = append(errs, fmt.Errorf("type is not an interface"))
 		}
+		name := generator.UcNameWithAppPrefix(iface)
+		if _, ok := ifaceNames[name]; ok {
+			errs = append(errs, fmt.Errorf("Interface: %s is registered twice, use InterfaceVersion or ClientName to tell them apart", iface.Named.Name.Value))
+		}
+		ifaceNames[name] = struct{}{}
+		if version := iface.Version.Take(); version != "" && !versionRegexp.MatchString(version) {
+			errs = append(errs, fmt.Errorf("InterfaceVersion: %s: invalid version %q, expected a letter followed by letters, digits or underscores", iface.Named.Name.Value, version))
+		}
+		errs = append(errs, validateDeprecations(iface)...)
+		if mockEnable {
+			errs = append(errs, validateMock(iface)...)
+		}
+	}
+	if p.config.HTTPCORS != nil && len(p.config.HTTPCORS.Origins) == 0 {
+		errs = append(errs, errors.New("HTTPCORS: at least one origin is required"))
+	}
+	switch p.config.RESTErrorFormat.Take() {
+	default:
+		errs = append(errs, fmt.Errorf("RESTErrorFormat: unknown format %q, expected \"json\" or \"problem\"", p.config.RESTErrorFormat.Take()))
+	case "", "json", "problem":
+	}
+	if p.config.CodecsEnable != nil {
+		for _, name := range p.config.CodecsEnable.Codecs {
+			if _, ok := generator.CodecMediaTypes[name]; !ok {
+				errs = append(errs, fmt.Errorf("CodecsEnable: unknown codec %q, expected \"json\", \"msgpack\" or \"cbor\"", name))
+			}
+		}
+	}
+	if p.config.HealthEndpoints != nil && p.config.HTTPServer == nil {
+		errs = append(errs, errors.New("HealthEndpoints: health endpoints require HTTPServer"))
+	}
+	if p.config.OpenRPCEnable != nil && p.config.JSONRPCEnable == nil {
+		errs = append(errs, errors.New("OpenRPCEnable: OpenRPC document requires JSONRPCEnable"))
+	}
+	if p.config.ContractTestsEnable != nil {
+		if p.config.HTTPServer == nil {
+			errs = append(errs, errors.New("ContractTestsEnable: contract tests require HTTPServer"))
+		}
+		if p.config.HTTPFast != nil {
+			errs = append(errs, errors.New("ContractTestsEnable: contract tests are not supported with HTTPFast"))
+		}
+		if !p.config.ClientsEnable.Langs.Contains("go") {
+			errs = append(errs, errors.New("ContractTestsEnable: contract tests require the go client in ClientsEnable"))
+		}
+		for _, iface := range p.config.Interfaces {
+			if iface.Gateway != nil {
+				errs = append(errs, fmt.Errorf("ContractTestsEnable: contract tests are not supported for the gateway interface %s", iface.Named.Name.Value))
+			}
+		}
+	}
+	if p.config.FuzzEnable != nil {
+		if p.config.HTTPServer == nil {
+			errs = append(errs, errors.New("FuzzEnable: fuzz tests require HTTPServer"))
+		}
+		for _, iface := range p.config.Interfaces {
+			if iface.Gateway != nil {
+				errs = append(errs, fmt.Errorf("FuzzEnable: fuzz tests are not supported for the gateway interface %s", iface.Named.Name.Value))
+			}
+		}
+	}
+	errs = append(errs, plugin.ValidateOpenapiVersion(p.config.OpenapiVersion.Take(), len(p.config.OpenapiWebhooks) > 0)...)
+	for _, w := range p.config.OpenapiWebhooks {
+		if _, ok := w.Method.Type.(*option.SignType); !ok {
+			errs = append(errs, fmt.Errorf("OpenapiWebhook: %s is not a method", w.Method.Name))
+		}
+		if w.Name == "" {
+			errs = append(errs, fmt.Errorf("OpenapiWebhook: %s: name is required", w.Method.Name))
+		}
+	}
+	return
+}
+
+var versionRegexp = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+func validateMock(iface *config.Interface) (errs []error) {
+	ifaceType, ok := iface.Named.Type.(*option.IfaceType)
+	if !ok {
+		return
+	}
+	methods := map[string]struct{}{"Reset": {}}
+	for _, m := range ifaceType.Methods {
+		for _, name := range generator.MockMethods(m) {
+			methods[name] = struct{}{}
+		}
+	}
+	for _, m := range ifaceType.Methods {
+		if _, ok := methods[m.Name.Value]; ok {
+			errs = append(errs, fmt.Errorf("%s.%s: the method name is taken by the generated mock, rename the method", iface.Named.Name.Value, m.Name.Value))
+		}
+	}
+	return
+}
+
+func validateDeprecations(iface *config.Interface) (errs []error) {
+	for _, d := range iface.Deprecations {
+		since, err := time.Parse(generator.DeprecationDateLayout, d.Since)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("Deprecated: %s: invalid since date %q, expected the %s format", iface.Named.Name.Value, d.Since, generator.DeprecationDateLayout))
+		}
+		if d.Sunset != "" {
+			sunset, err := time.Parse(generator.DeprecationDateLayout, d.Sunset)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("Deprecated: %s: invalid sunset date %q, expected the %s format", iface.Named.Name.Value, d.Sunset, generator.DeprecationDateLayout))
+			} else if !sunset.After(since) {
+				errs = append(errs, fmt.Errorf("Deprecated: %s: sunset date %s must be after the since date %s", iface.Named.Name.Value, d.Sunset, d.Since))
+			}
+		}
+		if len(d.Methods) == 0 {
+			errs = append(errs, fmt.Errorf("Deprecated: %s: at least one method is required", iface.Named.Name.Value))
+		}
+		for _, m := range d.Methods {
+			sig, ok := m.Type.(*option.SignType)
+			if !ok {
+				errs = append(errs, fmt.Errorf("Deprecated: %s is not a method", m.Name))
+				continue
+			}
+			if recv, ok := sig.Recv.(*option.NamedType); !ok || recv.Name.Value != iface.Named.Name.Value {
+				errs = append(errs, fmt.Errorf("Deprecated: %s is not a method of %s", m.Name, iface.Named.Name.Value))
+			}
+		}
 	}
 	return
 }
@@ -0,0 +1,94 @@
+package postman
+
+const SchemaV21 = "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"
+
+type Info struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Schema      string `json:"schema"`
+}
+
+type Variable struct {
+	Key         string `json:"key"`
+	Value       string `json:"value"`
+	Type        string `json:"type,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type AuthAttribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+	Type  string `json:"type"`
+}
+
+type Auth struct {
+	Type   string          `json:"type"`
+	Bearer []AuthAttribute `json:"bearer,omitempty"`
+	Basic  []AuthAttribute `json:"basic,omitempty"`
+}
+
+type Header struct {
+	Key         string `json:"key"`
+	Value       string `json:"value"`
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+}
+
+type QueryParam struct {
+	Key         string `json:"key"`
+	Value       string `json:"value"`
+	Description string `json:"description,omitempty"`
+}
+
+type URL struct {
+	Raw      string       `json:"raw"`
+	Host     []string     `json:"host"`
+	Path     []string     `json:"path,omitempty"`
+	Query    []QueryParam `json:"query,omitempty"`
+	Variable []Variable   `json:"variable,omitempty"`
+}
+
+type FormParam struct {
+	Key   string `json:"key"`
+	Value string `json:"value,omitempty"`
+	Type  string `json:"type"`
+	Src   string `json:"src,omitempty"`
+}
+
+type BodyRawOptions struct {
+	Language string `json:"language"`
+}
+
+type BodyOptions struct {
+	Raw BodyRawOptions `json:"raw"`
+}
+
+type Body struct {
+	Mode       string       `json:"mode"`
+	Raw        string       `json:"raw,omitempty"`
+	URLEncoded []FormParam  `json:"urlencoded,omitempty"`
+	FormData   []FormParam  `json:"formdata,omitempty"`
+	Options    *BodyOptions `json:"options,omitempty"`
+}
+
+type Request struct {
+	Method      string   `json:"method"`
+	Header      []Header `json:"header"`
+	Body        *Body    `json:"body,omitempty"`
+	URL         URL      `json:"url"`
+	Auth        *Auth    `json:"auth,omitempty"`
+	Description string   `json:"description,omitempty"`
+}
+
+type Item struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Item        []Item   `json:"item,omitempty"`
+	Request     *Request `json:"request,omitempty"`
+}
+
+type Collection struct {
+	Info     Info       `json:"info"`
+	Item     []Item     `json:"item"`
+	Variable []Variable `json:"variable,omitempty"`
+}
@@ -1,6 +1,7 @@
 package plugin
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	stdstrings "strings"
@@ -153,3 +154,128 @@ func IsFileDownloadType(i interface{}) bool {
 	}
 	return false
 }
+
+func IsPointer(i interface{}) bool {
+	switch t := i.(type) {
+	case *option.BasicType:
+		return t.IsPointer
+	case *option.NamedType:
+		return t.IsPointer
+	case *option.StructType:
+		return t.IsPointer
+	case *option.SliceType:
+		return t.IsPointer
+	case *option.ArrayType:
+		return t.IsPointer
+	case *option.MapType:
+		return t.IsPointer
+	}
+	return false
+}
+
+func ValidateOpenapiVersion(version string, hasWebhooks bool) (errs []error) {
+	switch version {
+	default:
+		errs = append(errs, fmt.Errorf("OpenapiVersion: unknown version %q, expected \"3.0\" or \"3.1\"", version))
+	case "", "3.0":
+		if hasWebhooks {
+			errs = append(errs, errors.New("OpenapiWebhook: webhooks require OpenapiVersion(\"3.1\")"))
+		}
+	case "3.1":
+	}
+	return
+}
+
+// Pagination is the paginated method convention: the limit param with the offset or cursor param,
+// and the slice result with the total or the next cursor result.
+type Pagination struct {
+	Kind       string
+	Limit      *option.VarType
+	Page       *option.VarType
+	Items      *option.VarType
+	Total      *option.VarType
+	NextCursor *option.VarType
+}
+
+func FindPagination(m *option.FuncType, kind string) (p Pagination, err error) {
+	var pageName string
+	switch kind {
+	default:
+		return p, fmt.Errorf("unknown pagination %q, expected \"offset\" or \"cursor\"", kind)
+	case "offset":
+		pageName = "offset"
+	case "cursor":
+		pageName = "cursor"
+	}
+	p.Kind = kind
+	for _, v := range m.Sig.Params {
+		switch v.Name.Value {
+		case "limit":
+			p.Limit = v
+		case pageName:
+			p.Page = v
+		}
+	}
+	if p.Limit == nil || !isIntType(p.Limit.Type) {
+		return p, errors.New("paginated method requires the integer limit param")
+	}
+	if p.Page == nil {
+		return p, fmt.Errorf("paginated method requires the %s param", pageName)
+	}
+	if kind == "offset" && !isIntType(p.Page.Type) {
+		return p, errors.New("offset param must be an integer")
+	}
+	if kind == "cursor" && !isStringType(p.Page.Type) {
+		return p, errors.New("cursor param must be a string")
+	}
+	if !m.Sig.IsNamed {
+		return p, errors.New("paginated method requires named results")
+	}
+	for _, v := range m.Sig.Results {
+		if IsError(v) {
+			continue
+		}
+		switch v.Name.Value {
+		case "total":
+			if !isIntType(v.Type) {
+				return p, errors.New("total result must be an integer")
+			}
+			p.Total = v
+			continue
+		case "nextCursor":
+			if kind == "cursor" {
+				if !isStringType(v.Type) {
+					return p, errors.New("nextCursor result must be a string")
+				}
+				p.NextCursor = v
+				continue
+			}
+		}
+		if t, ok := v.Type.(*option.SliceType); ok && !t.IsPointer {
+			if p.Items != nil {
+				return p, errors.New("paginated method must return a single slice of items")
+			}
+			p.Items = v
+		}
+	}
+	if p.Items == nil {
+		return p, errors.New("paginated method must return a slice of items")
+	}
+	if kind == "offset" && p.Total == nil {
+		return p, errors.New("offset pagination requires the integer total result")
+	}
+	if kind == "cursor" && p.NextCursor == nil {
+		return p, errors.New("cursor pagination requires the string nextCursor result")
+	}
+	return p, nil
+}
+
+func isIntType(i interface{}) bool {
+	t, ok := i.(*option.BasicType)
+	return ok && !t.IsPointer && (t.IsAnyInt() || t.IsAnyUint())
+}
+
+func isStringType(i interface{}) bool {
+	t, ok := i.(*option.BasicType)
+	return ok && !t.IsPointer && t.IsString()
+}
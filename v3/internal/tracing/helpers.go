@@ -0,0 +1,87 @@
+package tracing
+
+import (
+	"strconv"
+
+	"github.com/swipe-io/swipe/v3/internal/plugin"
+	"github.com/swipe-io/swipe/v3/option"
+	"github.com/swipe-io/swipe/v3/swipe"
+)
+
+func makeAttributes(importer swipe.Importer, include, exclude map[string]struct{}, data ...*option.VarType) (result []string) {
+	for _, v := range data {
+		if plugin.IsContext(v) {
+			continue
+		}
+		if len(include) > 0 {
+			if _, ok := include[v.Name.Value]; !ok {
+				continue
+			}
+		}
+		if len(exclude) > 0 {
+			if _, ok := exclude[v.Name.Value]; ok {
+				continue
+			}
+		}
+		if attr := makeAttribute(importer, v.Name.Value, v.Type); attr != "" {
+			result = append(result, attr)
+		}
+	}
+	return
+}
+
+func makeAttribute(importer swipe.Importer, name string, t interface{}) string {
+	fn, key, value := attributeFunc(name, t)
+	if fn == "" {
+		return ""
+	}
+	attributePkg := importer.Import("attribute", "go.opentelemetry.io/otel/attribute")
+	return attributePkg + "." + fn + "(" + strconv.Quote(key) + ", " + value + ")"
+}
+
+func attributeFunc(name string, t interface{}) (fn, key, value string) {
+	switch t := t.(type) {
+	case *option.NamedType:
+		if plugin.IsFileDownloadType(t) {
+			return "Int", name + ".len", "len(" + name + ".Data())"
+		}
+		if hasMethodString(t) {
+			return "String", name, name + ".String()"
+		}
+	case *option.BasicType:
+		if t.IsPointer {
+			return
+		}
+		switch {
+		case t.IsString():
+			return "String", name, name
+		case t.IsBool():
+			return "Bool", name, name
+		case t.IsInt64():
+			return "Int64", name, name
+		case t.IsAnyInt(), t.IsAnyUint():
+			return "Int64", name, "int64(" + name + ")"
+		case t.IsFloat64():
+			return "Float64", name, name
+		case t.IsAnyFloat():
+			return "Float64", name, "float64(" + name + ")"
+		}
+	case *option.SliceType, *option.ArrayType, *option.MapType:
+		return "Int", name + ".len", "len(" + name + ")"
+	}
+	return
+}
+
+func hasMethodString(v *option.NamedType) bool {
+	for _, method := range v.Methods {
+		if method.Name.Value != "String" {
+			continue
+		}
+		if len(method.Sig.Params) == 0 && len(method.Sig.Results) == 1 {
+			if t, ok := method.Sig.Results[0].Type.(*option.BasicType); ok {
+				return t.IsString()
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,144 @@
+package tracing
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/swipe-io/swipe/v3/internal/plugin"
+	"github.com/swipe-io/swipe/v3/option"
+	"github.com/swipe-io/swipe/v3/swipe"
+	"github.com/swipe-io/swipe/v3/writer"
+)
+
+type Interface struct {
+	Name     string
+	TypeName string
+	LcName   string
+	UcName   string
+	Methods  []Method
+}
+
+type Method struct {
+	Name           option.String
+	Sig            *option.SignType
+	Enable         bool
+	ParamsIncludes []string
+	ParamsExcludes []string
+}
+
+type Tracing struct {
+	w          writer.GoWriter
+	importer   swipe.Importer
+	interfaces []Interface
+}
+
+func (t *Tracing) SetInterfaces(interfaces []Interface) *Tracing {
+	t.interfaces = interfaces
+	return t
+}
+
+func (t *Tracing) Build() []byte {
+	otelPkg := t.importer.Import("otel", "go.opentelemetry.io/otel")
+	tracePkg := t.importer.Import("trace", "go.opentelemetry.io/otel/trace")
+
+	for _, iface := range t.interfaces {
+		middlewareNameType := iface.LcName + "TracingMiddleware"
+		middlewareFuncName := fmt.Sprintf("Tracing%sMiddleware", iface.UcName)
+		middlewareTypeName := iface.UcName + "Middleware"
+
+		t.w.WriteTypeStruct(
+			middlewareNameType,
+			[]string{
+				"next", iface.TypeName,
+				"tracer", tracePkg + ".Tracer",
+			},
+		)
+		for _, m := range iface.Methods {
+			t.w.W("func (s *%s) %s %s {\n", middlewareNameType, m.Name.Value, swipe.TypeString(m.Sig, false, t.importer))
+
+			if m.Enable {
+				t.writeSpan(iface, m)
+			}
+
+			if len(m.Sig.Results) > 0 {
+				for i, result := range m.Sig.Results {
+					if i > 0 {
+						t.w.W(",")
+					}
+					t.w.W(result.Name.Value)
+				}
+				t.w.W(" = ")
+			}
+
+			t.w.W("s.next.%s(", m.Name)
+			for i, param := range m.Sig.Params {
+				if i > 0 {
+					t.w.W(",")
+				}
+				var variadic string
+				if param.IsVariadic {
+					variadic = "..."
+				}
+				t.w.W(param.Name.Value + variadic)
+			}
+			t.w.W(")\n")
+
+			t.w.W("return\n")
+
+			t.w.W("}\n")
+		}
+		t.w.W("func %[1]s(tracer %[4]s.Tracer) %[5]s {\n", middlewareFuncName, iface.TypeName, middlewareNameType, tracePkg, middlewareTypeName)
+		t.w.W("if tracer == nil {\ntracer = %s.Tracer(%s)\n}\n", otelPkg, strconv.Quote(iface.Name))
+		t.w.W("return func(next %[1]s) %[1]s {\nreturn &%[2]s{\nnext: next,\ntracer: tracer,\n}\n}\n}\n", iface.TypeName, middlewareNameType)
+	}
+	return t.w.Bytes()
+}
+
+func (t *Tracing) writeSpan(iface Interface, m Method) {
+	includes := map[string]struct{}{}
+	excludes := map[string]struct{}{}
+
+	for _, v := range m.ParamsIncludes {
+		includes[v] = struct{}{}
+	}
+	for _, v := range m.ParamsExcludes {
+		excludes[v] = struct{}{}
+	}
+
+	attributes := makeAttributes(t.importer, includes, excludes, m.Sig.Params...)
+
+	ctxAssign, ctxName := "_", t.importer.Import("context", "context")+".Background()"
+	if contexts := plugin.Contexts(m.Sig.Params); len(contexts) > 0 {
+		ctxAssign, ctxName = contexts[0].Name.Value, contexts[0].Name.Value
+	}
+
+	t.w.W("%s, span := s.tracer.Start(%s, %s", ctxAssign, ctxName, strconv.Quote(iface.Name+"."+m.Name.Value))
+	if len(attributes) > 0 {
+		tracePkg := t.importer.Import("trace", "go.opentelemetry.io/otel/trace")
+		t.w.W(", %s.WithAttributes(\n", tracePkg)
+		for _, attr := range attributes {
+			t.w.W("%s,\n", attr)
+		}
+		t.w.W(")")
+	}
+	t.w.W(")\n")
+
+	errorVar := plugin.Error(m.Sig.Results)
+	if errorVar != nil {
+		codesPkg := t.importer.Import("codes", "go.opentelemetry.io/otel/codes")
+
+		t.w.W("defer func() {\n")
+		t.w.W("if %s != nil {\n", errorVar.Name)
+		t.w.W("span.RecordError(%s)\n", errorVar.Name)
+		t.w.W("span.SetStatus(%s.Error, %s.Error())\n", codesPkg, errorVar.Name)
+		t.w.W("}\n")
+		t.w.W("span.End()\n")
+		t.w.W("}()\n")
+	} else {
+		t.w.W("defer span.End()\n")
+	}
+}
+
+func NewTracing(importer swipe.Importer) *Tracing {
+	return &Tracing{importer: importer}
+}
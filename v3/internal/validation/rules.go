@@ -0,0 +1,171 @@
+package validation
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/swipe-io/swipe/v3/option"
+)
+
+var paramTagRegexp = regexp.MustCompile(`validate:"([^"]*)"`)
+
+type Rule struct {
+	Name  string
+	Param string
+}
+
+type Constraints struct {
+	Required  bool
+	Format    string
+	Enum      []string
+	MinLength *int64
+	MaxLength *int64
+	Minimum   *float64
+	Maximum   *float64
+	MinItems  *int64
+	MaxItems  *int64
+}
+
+func ParseRules(tag string) (rules []Rule) {
+	for _, s := range strings.Split(tag, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		name, param, _ := strings.Cut(s, "=")
+		rules = append(rules, Rule{Name: name, Param: param})
+	}
+	return
+}
+
+// ParamRules returns the rules defined for a method param
+// in the method comment, for example: // @name validate:"required,min=3".
+func ParamRules(p *option.VarType) []Rule {
+	matches := paramTagRegexp.FindStringSubmatch(p.Comment)
+	if len(matches) != 2 {
+		return nil
+	}
+	return ParseRules(matches[1])
+}
+
+// TrimParamRules removes the validate rules from a param comment.
+func TrimParamRules(comment string) string {
+	return strings.TrimSpace(paramTagRegexp.ReplaceAllString(comment, ""))
+}
+
+func FieldRules(f *option.StructFieldType) []Rule {
+	if f.Tags == nil {
+		return nil
+	}
+	tag, err := f.Tags.Get("validate")
+	if err != nil {
+		return nil
+	}
+	return ParseRules(tag.Value())
+}
+
+func MakeConstraints(t interface{}, rules []Rule) (c Constraints) {
+	kind := kindOf(t)
+	for _, r := range rules {
+		switch r.Name {
+		case "required":
+			c.Required = true
+		case "email":
+			c.Format = "email"
+		case "oneof":
+			if kind == kindString {
+				c.Enum = strings.Fields(r.Param)
+			}
+		case "min", "max":
+			v, err := strconv.ParseFloat(r.Param, 64)
+			if err != nil {
+				continue
+			}
+			n := int64(v)
+			switch kind {
+			case kindString:
+				if r.Name == "min" {
+					c.MinLength = &n
+				} else {
+					c.MaxLength = &n
+				}
+			case kindNumeric:
+				if r.Name == "min" {
+					c.Minimum = &v
+				} else {
+					c.Maximum = &v
+				}
+			case kindLen:
+				if r.Name == "min" {
+					c.MinItems = &n
+				} else {
+					c.MaxItems = &n
+				}
+			}
+		}
+	}
+	return
+}
+
+type valueKind int
+
+const (
+	kindUnknown valueKind = iota
+	kindString
+	kindNumeric
+	kindBool
+	kindLen
+	kindStruct
+)
+
+func kindOf(t interface{}) valueKind {
+	switch t := t.(type) {
+	case *option.BasicType:
+		switch {
+		case t.IsString():
+			return kindString
+		case t.IsNumeric():
+			return kindNumeric
+		case t.IsBool():
+			return kindBool
+		}
+	case *option.NamedType:
+		switch t.Type.(type) {
+		case *option.StructType:
+			return kindStruct
+		case *option.BasicType, *option.SliceType, *option.MapType, *option.ArrayType:
+			return kindOf(t.Type)
+		}
+	case *option.SliceType, *option.MapType, *option.ArrayType:
+		return kindLen
+	}
+	return kindUnknown
+}
+
+// collectionElem returns the element type of the slice, array or map,
+// keyed reports whether the elements are indexed by the map keys.
+func collectionElem(t interface{}) (elem interface{}, keyed bool, ok bool) {
+	if named, isNamed := t.(*option.NamedType); isNamed {
+		t = named.Type
+	}
+	switch t := t.(type) {
+	case *option.SliceType:
+		return t.Value, false, true
+	case *option.ArrayType:
+		return t.Value, false, true
+	case *option.MapType:
+		return t.Value, true, true
+	}
+	return nil, false, false
+}
+
+func isPointer(t interface{}) bool {
+	switch t := t.(type) {
+	case *option.BasicType:
+		return t.IsPointer
+	case *option.NamedType:
+		return t.IsPointer
+	}
+	return false
+}
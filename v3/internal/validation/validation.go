@@ -0,0 +1,460 @@
+package validation
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/swipe-io/strcase"
+	"github.com/swipe-io/swipe/v3/internal/plugin"
+	"github.com/swipe-io/swipe/v3/option"
+	"github.com/swipe-io/swipe/v3/swipe"
+	"github.com/swipe-io/swipe/v3/writer"
+)
+
+type Interface struct {
+	TypeName string
+	LcName   string
+	UcName   string
+	Methods  []Method
+}
+
+type Method struct {
+	Name   option.String
+	Sig    *option.SignType
+	Enable bool
+}
+
+type Validation struct {
+	w          writer.GoWriter
+	importer   swipe.Importer
+	interfaces []Interface
+	structs    []*option.NamedType
+	visited    map[string]bool
+	depth      int
+}
+
+func (v *Validation) SetInterfaces(interfaces []Interface) *Validation {
+	v.interfaces = interfaces
+	return v
+}
+
+func (v *Validation) Build() []byte {
+	v.writeError()
+
+	for _, iface := range v.interfaces {
+		middlewareNameType := iface.LcName + "ValidationMiddleware"
+		middlewareFuncName := fmt.Sprintf("Validation%sMiddleware", iface.UcName)
+		middlewareTypeName := iface.UcName + "Middleware"
+
+		v.w.WriteTypeStruct(
+			middlewareNameType,
+			[]string{
+				"next", iface.TypeName,
+			},
+		)
+		for _, m := range iface.Methods {
+			v.w.W("func (s *%s) %s %s {\n", middlewareNameType, m.Name.Value, swipe.TypeString(m.Sig, false, v.importer))
+
+			if errorVar := plugin.Error(m.Sig.Results); m.Enable && errorVar != nil {
+				v.w.W("verr := &ValidationError{}\n")
+				for _, p := range m.Sig.Params {
+					if plugin.IsContext(p) {
+						continue
+					}
+					v.writeValue(strconv.Quote(p.Name.Value), p.Name.Value, p.Type, ParamRules(p))
+				}
+				v.w.W("if len(verr.Fields) > 0 {\n")
+				v.w.W("%s = verr\n", errorVar.Name)
+				v.w.W("return\n")
+				v.w.W("}\n")
+			}
+
+			if len(m.Sig.Results) > 0 {
+				for i, result := range m.Sig.Results {
+					if i > 0 {
+						v.w.W(",")
+					}
+					v.w.W(result.Name.Value)
+				}
+				v.w.W(" = ")
+			}
+
+			v.w.W("s.next.%s(", m.Name)
+			for i, param := range m.Sig.Params {
+				if i > 0 {
+					v.w.W(",")
+				}
+				var variadic string
+				if param.IsVariadic {
+					variadic = "..."
+				}
+				v.w.W(param.Name.Value + variadic)
+			}
+			v.w.W(")\n")
+
+			v.w.W("return\n")
+
+			v.w.W("}\n")
+		}
+		v.w.W("func %[1]s() %[3]s {\nreturn func(next %[2]s) %[2]s {\nreturn &%[4]s{\nnext: next,\n}\n}\n}\n", middlewareFuncName, iface.TypeName, middlewareTypeName, middlewareNameType)
+	}
+
+	for i := 0; i < len(v.structs); i++ {
+		v.writeStructValidator(v.structs[i])
+	}
+
+	return v.w.Bytes()
+}
+
+func (v *Validation) writeError() {
+	stringsPkg := v.importer.Import("strings", "strings")
+
+	v.w.W("type ValidationFieldError struct {\n")
+	v.w.W("Field string `json:\"field\"`\n")
+	v.w.W("Rule string `json:\"rule\"`\n")
+	v.w.W("Message string `json:\"message\"`\n")
+	v.w.W("}\n\n")
+
+	v.w.W("type ValidationError struct {\n")
+	v.w.W("Fields []ValidationFieldError `json:\"fields\"`\n")
+	v.w.W("}\n\n")
+
+	v.w.W("func (e *ValidationError) Error() string {\n")
+	v.w.W("messages := make([]string, 0, len(e.Fields))\n")
+	v.w.W("for _, f := range e.Fields {\n")
+	v.w.W("messages = append(messages, f.Field+\": \"+f.Message)\n")
+	v.w.W("}\n")
+	v.w.W("return \"validation failed: \" + %s.Join(messages, \"; \")\n", stringsPkg)
+	v.w.W("}\n\n")
+
+	v.w.W("func (e *ValidationError) StatusCode() int {\nreturn 400\n}\n\n")
+	v.w.W("func (e *ValidationError) ErrorCode() int {\nreturn -32602\n}\n\n")
+	v.w.W("func (e *ValidationError) Code() string {\nreturn \"validation_failed\"\n}\n\n")
+	v.w.W("func (e *ValidationError) Data() interface{} {\nreturn e.Fields\n}\n\n")
+	v.w.W("func (e *ValidationError) ErrorData() interface{} {\nreturn e.Fields\n}\n\n")
+
+	v.w.W("func (e *ValidationError) add(field, rule, message string) {\n")
+	v.w.W("e.Fields = append(e.Fields, ValidationFieldError{Field: field, Rule: rule, Message: message})\n")
+	v.w.W("}\n\n")
+}
+
+func (v *Validation) writeStructValidator(named *option.NamedType) {
+	st := named.Type.(*option.StructType)
+
+	valueType := *named
+	valueType.IsPointer = false
+
+	v.w.W("func %s(verr *ValidationError, field string, v %s) {\n", v.structValidatorName(named), swipe.TypeString(&valueType, false, v.importer))
+	for _, f := range st.Fields {
+		if !f.Var.Exported {
+			continue
+		}
+		name := f.Var.Name.Value
+		if f.Tags != nil {
+			if tag, err := f.Tags.Get("json"); err == nil {
+				if tag.Name == "-" {
+					continue
+				}
+				if tag.Name != "" {
+					name = tag.Name
+				}
+			}
+		}
+		v.writeValue("field+"+strconv.Quote("."+name), "v."+f.Var.Name.Value, f.Var.Type, FieldRules(f))
+	}
+	v.w.W("}\n\n")
+}
+
+func (v *Validation) structValidatorName(named *option.NamedType) string {
+	return "validate" + strcase.ToCamel(named.Pkg.Name) + named.Name.Upper()
+}
+
+// hasRules reports whether the struct or any nested struct has validation rules.
+func (v *Validation) hasRules(named *option.NamedType, visited map[string]bool) bool {
+	if visited[named.ID()] {
+		return false
+	}
+	visited[named.ID()] = true
+	st, ok := named.Type.(*option.StructType)
+	if !ok {
+		return false
+	}
+	for _, f := range st.Fields {
+		if !f.Var.Exported {
+			continue
+		}
+		if len(FieldRules(f)) > 0 || len(enumValues(f.Var.Type)) > 0 {
+			return true
+		}
+		if v.typeHasRules(f.Var.Type, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// typeHasRules reports whether the struct or the struct elements of the collection have validation rules.
+func (v *Validation) typeHasRules(t interface{}, visited map[string]bool) bool {
+	if elem, _, ok := collectionElem(t); ok {
+		return v.typeHasRules(elem, visited)
+	}
+	if named, ok := t.(*option.NamedType); ok {
+		return v.hasRules(named, visited)
+	}
+	return false
+}
+
+func (v *Validation) writeValue(fieldExpr, valueExpr string, t interface{}, rules []Rule) {
+	kind := kindOf(t)
+	pointer := isPointer(t)
+
+	var (
+		required bool
+		checks   []Rule
+	)
+	for _, r := range rules {
+		if r.Name == "required" {
+			required = true
+			continue
+		}
+		checks = append(checks, r)
+	}
+
+	if kind == kindStruct {
+		named := t.(*option.NamedType)
+		if required && pointer {
+			v.w.W("if %s == nil {\n", valueExpr)
+			v.writeAdd(fieldExpr, "required", "is required")
+			v.w.W("}\n")
+		}
+		if !v.hasRules(named, map[string]bool{}) {
+			return
+		}
+		if !v.visited[named.ID()] {
+			v.visited[named.ID()] = true
+			v.structs = append(v.structs, named)
+		}
+		if pointer {
+			v.w.W("if %s != nil {\n", valueExpr)
+			v.w.W("%s(verr, %s, *%s)\n", v.structValidatorName(named), fieldExpr, valueExpr)
+			v.w.W("}\n")
+		} else {
+			v.w.W("%s(verr, %s, %s)\n", v.structValidatorName(named), fieldExpr, valueExpr)
+		}
+		return
+	}
+
+	v.writeRules(fieldExpr, valueExpr, t, kind, pointer, required, checks)
+	if kind == kindLen {
+		v.writeElems(fieldExpr, valueExpr, t)
+	}
+}
+
+func (v *Validation) writeRules(fieldExpr, valueExpr string, t interface{}, kind valueKind, pointer, required bool, checks []Rule) {
+	enums := enumValues(t)
+	for _, r := range checks {
+		if r.Name == "oneof" {
+			enums = nil
+		}
+	}
+
+	if !required && len(checks) == 0 && len(enums) == 0 {
+		return
+	}
+
+	value := valueExpr
+	open := false
+	if pointer {
+		if required {
+			v.w.W("if %s == nil {\n", valueExpr)
+			v.writeAdd(fieldExpr, "required", "is required")
+			v.w.W("}")
+			if len(checks) == 0 && len(enums) == 0 {
+				v.w.W("\n")
+				return
+			}
+			v.w.W(" else {\n")
+		} else {
+			v.w.W("if %s != nil {\n", valueExpr)
+		}
+		value = "*" + valueExpr
+		open = true
+	} else if required {
+		var cond string
+		switch kind {
+		case kindString:
+			cond = value + " == \"\""
+		case kindNumeric:
+			cond = value + " == 0"
+		case kindLen:
+			cond = "len(" + value + ") == 0"
+		}
+		if cond != "" {
+			v.w.W("if %s {\n", cond)
+			v.writeAdd(fieldExpr, "required", "is required")
+			v.w.W("}")
+			if len(checks) == 0 && len(enums) == 0 {
+				v.w.W("\n")
+				return
+			}
+			v.w.W(" else {\n")
+			open = true
+		}
+	}
+
+	for _, r := range checks {
+		v.writeCheck(fieldExpr, value, t, kind, r)
+	}
+	if len(enums) > 0 {
+		v.writeEnumCheck(fieldExpr, value, kind, enums, required || pointer)
+	}
+
+	if open {
+		v.w.W("}\n")
+	}
+}
+
+// writeElems validates the struct elements of the collection, the element field is qualified
+// by the index or the map key, for example: items[0].name.
+func (v *Validation) writeElems(fieldExpr, valueExpr string, t interface{}) {
+	elem, keyed, ok := collectionElem(t)
+	if !ok || !v.typeHasRules(elem, map[string]bool{}) {
+		return
+	}
+	value := valueExpr
+	if isPointer(t) {
+		v.w.W("if %s != nil {\n", valueExpr)
+		value = "*" + valueExpr
+	}
+	suffix := ""
+	if v.depth > 0 {
+		suffix = strconv.Itoa(v.depth)
+	}
+	keyVar, elemVar := "i"+suffix, "e"+suffix
+	var index string
+	if keyed {
+		keyVar = "k" + suffix
+		index = v.importer.Import("fmt", "fmt") + ".Sprint(" + keyVar + ")"
+	} else {
+		index = v.importer.Import("strconv", "strconv") + ".Itoa(" + keyVar + ")"
+	}
+	elemField := fieldExpr + "+\"[\""
+	if strings.HasSuffix(fieldExpr, "\"") {
+		elemField = strings.TrimSuffix(fieldExpr, "\"") + "[\""
+	}
+	elemField += "+" + index + "+\"]\""
+	v.w.W("for %s, %s := range %s {\n", keyVar, elemVar, value)
+	v.depth++
+	v.writeValue(elemField, elemVar, elem, nil)
+	v.depth--
+	v.w.W("}\n")
+	if isPointer(t) {
+		v.w.W("}\n")
+	}
+}
+
+func (v *Validation) writeCheck(fieldExpr, value string, t interface{}, kind valueKind, r Rule) {
+	switch r.Name {
+	case "min", "max":
+		op, msg := "<", "at least"
+		if r.Name == "max" {
+			op, msg = ">", "at most"
+		}
+		switch kind {
+		case kindString:
+			utf8Pkg := v.importer.Import("utf8", "unicode/utf8")
+			v.w.W("if %s.RuneCountInString(%s) %s %s {\n", utf8Pkg, stringValue(value, t), op, r.Param)
+			v.writeAdd(fieldExpr, r.Name, "must be "+msg+" "+r.Param+" characters long")
+			v.w.W("}\n")
+		case kindNumeric:
+			v.w.W("if %s %s %s {\n", value, op, r.Param)
+			v.writeAdd(fieldExpr, r.Name, "must be "+msg+" "+r.Param)
+			v.w.W("}\n")
+		case kindLen:
+			v.w.W("if len(%s) %s %s {\n", value, op, r.Param)
+			v.writeAdd(fieldExpr, r.Name, "must contain "+msg+" "+r.Param+" items")
+			v.w.W("}\n")
+		}
+	case "email":
+		if kind != kindString {
+			return
+		}
+		mailPkg := v.importer.Import("mail", "net/mail")
+		v.w.W("if _, err := %s.ParseAddress(%s); err != nil {\n", mailPkg, stringValue(value, t))
+		v.writeAdd(fieldExpr, r.Name, "must be a valid email address")
+		v.w.W("}\n")
+	case "oneof":
+		values := strings.Fields(r.Param)
+		if len(values) == 0 {
+			return
+		}
+		v.w.W("switch %s {\ncase ", value)
+		for i, s := range values {
+			if i > 0 {
+				v.w.W(", ")
+			}
+			if kind == kindString {
+				v.w.W(strconv.Quote(s))
+			} else {
+				v.w.W(s)
+			}
+		}
+		v.w.W(":\ndefault:\n")
+		v.writeAdd(fieldExpr, r.Name, "must be one of: "+strings.Join(values, ", "))
+		v.w.W("}\n")
+	}
+}
+
+// writeEnumCheck rejects values that are not declared as constants of the type,
+// the zero value is allowed for optional values.
+func (v *Validation) writeEnumCheck(fieldExpr, value string, kind valueKind, enums []*option.EnumValue, required bool) {
+	var zero string
+	switch kind {
+	case kindString:
+		zero = "\"\""
+	case kindNumeric:
+		zero = "0"
+	default:
+		return
+	}
+	literals := make([]string, 0, len(enums)+1)
+	values := make([]string, 0, len(enums))
+	for _, e := range enums {
+		if e.Literal == zero {
+			zero = ""
+		}
+		literals = append(literals, e.Literal)
+		values = append(values, fmt.Sprint(e.Value))
+	}
+	if !required && zero != "" {
+		literals = append(literals, zero)
+	}
+	v.w.W("switch %s {\ncase %s:\ndefault:\n", value, strings.Join(literals, ", "))
+	v.writeAdd(fieldExpr, "enum", "must be one of: "+strings.Join(values, ", "))
+	v.w.W("}\n")
+}
+
+func (v *Validation) writeAdd(fieldExpr, rule, message string) {
+	v.w.W("verr.add(%s, %s, %s)\n", fieldExpr, strconv.Quote(rule), strconv.Quote(message))
+}
+
+func enumValues(t interface{}) []*option.EnumValue {
+	if named, ok := t.(*option.NamedType); ok {
+		if _, ok := named.Type.(*option.BasicType); ok {
+			return named.Enums
+		}
+	}
+	return nil
+}
+
+func stringValue(value string, t interface{}) string {
+	if _, ok := t.(*option.NamedType); ok {
+		return "string(" + value + ")"
+	}
+	return value
+}
+
+func NewValidation(importer swipe.Importer) *Validation {
+	return &Validation{importer: importer, visited: map[string]bool{}}
+}
@@ -8,6 +8,8 @@ import (
 	"go/token"
 	stdtypes "go/types"
 	"path/filepath"
+	"sort"
+	"strconv"
 
 	"github.com/swipe-io/swipe/v3/internal/ast"
 
@@ -86,9 +88,12 @@ func (d *Decoder) normalizeStruct(pkg *packages.Package, t *stdtypes.Struct, isP
 	for i := 0; i < t.NumFields(); i++ {
 		field := t.Field(i)
 		comment := d.commentFields.GetByFieldName(t, field.Name())
+		fieldComment, example := parseFieldComment(comment)
 		f := &StructFieldType{
-			Var: d.normalizeVar(pkg, field, comment, visited),
+			Var: d.normalizeVar(pkg, field, fieldComment, visited),
 		}
+		f.Var.Example = example
+		f.Var.Deprecated = isDeprecated(comment)
 		if tags, err := structtag.Parse(t.Tag(i)); err == nil {
 			f.Tags = tags
 		}
@@ -147,10 +152,47 @@ func (d *Decoder) normalizeTypeName(pkg *packages.Package, obj *stdtypes.TypeNam
 	visited[k] = nt
 
 	nt.Type = d.normalizeType(pkg, obj.Type().Underlying(), false, visited)
+	if named, ok := obj.Type().(*stdtypes.Named); ok {
+		nt.Enums = normalizeEnums(named)
+	}
 
 	return nt
 }
 
+// normalizeEnums collects the constants of the named basic type declared in the package of the type,
+// in the order of declaration.
+func normalizeEnums(named *stdtypes.Named) (enums []*EnumValue) {
+	if named.Obj().Pkg() == nil {
+		return nil
+	}
+	if _, ok := named.Underlying().(*stdtypes.Basic); !ok {
+		return nil
+	}
+	scope := named.Obj().Pkg().Scope()
+	var consts []*stdtypes.Const
+	for _, name := range scope.Names() {
+		if c, ok := scope.Lookup(name).(*stdtypes.Const); ok && stdtypes.Identical(c.Type(), named) {
+			consts = append(consts, c)
+		}
+	}
+	sort.Slice(consts, func(i, j int) bool {
+		return consts[i].Pos() < consts[j].Pos()
+	})
+	for _, c := range consts {
+		enum := &EnumValue{Name: normalizeName(c.Name())}
+		if c.Val().Kind() == constant.Float {
+			f, _ := constant.Float64Val(c.Val())
+			enum.Value = f
+			enum.Literal = strconv.FormatFloat(f, 'g', -1, 64)
+		} else {
+			enum.Value = constant.Val(c.Val())
+			enum.Literal = c.Val().ExactString()
+		}
+		enums = append(enums, enum)
+	}
+	return
+}
+
 func (d *Decoder) normalizeNamed(pkg *packages.Package, named *stdtypes.Named, isPointer bool, visited map[string]interface{}) *NamedType {
 	var prefix string
 	if isPointer {
@@ -173,6 +215,7 @@ func (d *Decoder) normalizeNamed(pkg *packages.Package, named *stdtypes.Named, i
 	visited[k] = nt
 
 	nt.Type = d.normalizeType(pkg, named.Obj().Type().Underlying(), false, visited)
+	nt.Enums = normalizeEnums(named)
 
 	for i := 0; i < named.NumMethods(); i++ {
 		nt.Methods = append(nt.Methods, d.normalizeFunc(pkg, named.Method(i), visited))
@@ -222,15 +265,17 @@ func (d *Decoder) normalizeInterface(pkg *packages.Package, t *stdtypes.Interfac
 
 func (d *Decoder) normalizeFunc(pkg *packages.Package, t *stdtypes.Func, visited map[string]interface{}) *FuncType {
 	comments := d.commentFuncMap[t.String()]
-	comment, paramsComment := parseMethodComments(comments)
+	comment, paramsComment, example := parseMethodComments(comments)
 
 	return &FuncType{
-		Pkg:      d.normalizePkg(t.Pkg()),
-		FullName: t.FullName(),
-		Name:     normalizeName(t.Name()),
-		Exported: t.Exported(),
-		Sig:      d.normalizeSignature(pkg, t.Type().(*stdtypes.Signature), paramsComment, visited),
-		Comment:  comment,
+		Pkg:        d.normalizePkg(t.Pkg()),
+		FullName:   t.FullName(),
+		Name:       normalizeName(t.Name()),
+		Exported:   t.Exported(),
+		Sig:        d.normalizeSignature(pkg, t.Type().(*stdtypes.Signature), paramsComment, visited),
+		Comment:    comment,
+		Example:    example,
+		Deprecated: isDeprecated(comments...),
 	}
 }
 
@@ -247,6 +292,7 @@ func (d *Decoder) normalizeSignature(pkg *packages.Package, t *stdtypes.Signatur
 	for i := 0; i < t.Params().Len(); i++ {
 		v := t.Params().At(i)
 		nv := d.normalizeVar(pkg, v, comments[v.Name()], visited)
+		nv.Deprecated = isDeprecated(comments[v.Name()])
 		st.Params = append(st.Params, nv)
 	}
 	if t.Variadic() {
@@ -355,7 +401,11 @@ func (d *Decoder) decodeRecursive(pkg *packages.Package, expr goast.Expr) (inter
 		var value interface{}
 		tv := pkg.TypesInfo.Types[e]
 		if tv.IsValue() {
-			value = constant.Val(tv.Value)
+			if tv.Value.Kind() == constant.Float {
+				value, _ = constant.Float64Val(tv.Value)
+			} else {
+				value = constant.Val(tv.Value)
+			}
 		}
 		return value, nil
 	case *goast.Ident:
@@ -44,6 +44,8 @@ type VarType struct {
 	IsContext  bool
 	Type       interface{}
 	Comment    string
+	Example    string
+	Deprecated bool
 	Zero       string
 
 	originType stdtypes.Type
@@ -70,12 +72,14 @@ type SignType struct {
 }
 
 type FuncType struct {
-	Pkg      *PackageType
-	FullName string
-	Name     String
-	Exported bool
-	Sig      *SignType
-	Comment  string
+	Pkg        *PackageType
+	FullName   string
+	Name       String
+	Exported   bool
+	Sig        *SignType
+	Comment    string
+	Example    string
+	Deprecated bool
 }
 
 func (f *FuncType) ID() string {
@@ -111,6 +115,14 @@ type NamedType struct {
 	Pkg       *PackageType
 	IsPointer bool
 	Methods   []*FuncType
+	Enums     []*EnumValue
+}
+
+// EnumValue is a constant declared with a named basic type in the package of the type.
+type EnumValue struct {
+	Name    String
+	Value   interface{}
+	Literal string
 }
 
 func (n *NamedType) ID() string {
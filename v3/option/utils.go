@@ -17,8 +17,9 @@ import (
 
 var paramCommentRegexp = regexp.MustCompile(`(?s)@([a-zA-Z0-9_]*) (.*)`)
 
-func parseMethodComments(comments []string) (methodComment string, paramsComment map[string]string) {
+func parseMethodComments(comments []string) (methodComment string, paramsComment map[string]string, example string) {
 	paramsComment = make(map[string]string)
+	comments, example = extractExample(comments)
 	for _, comment := range comments {
 		comment = strings.TrimSpace(comment)
 		if strings.HasPrefix(comment, "@") {
@@ -28,11 +29,77 @@ func parseMethodComments(comments []string) (methodComment string, paramsComment
 			}
 			continue
 		}
+		if comment == "" {
+			continue
+		}
+		if methodComment != "" {
+			methodComment += " "
+		}
 		methodComment += comment
 	}
 	return
 }
 
+// parseFieldComment splits a struct field comment into the description and the @example block.
+func parseFieldComment(comment string) (fieldComment string, example string) {
+	if comment == "" {
+		return
+	}
+	lines, example := extractExample(strings.Split(comment, "\n"))
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if fieldComment != "" {
+			fieldComment += " "
+		}
+		fieldComment += line
+	}
+	return
+}
+
+// extractExample removes the @example block from the comment lines, the block starts with @example
+// and continues until an empty line or the next @ line.
+func extractExample(comments []string) (lines []string, example string) {
+	var (
+		block []string
+		open  bool
+	)
+	for _, comment := range comments {
+		comment = strings.TrimSpace(comment)
+		if open {
+			if comment != "" && !strings.HasPrefix(comment, "@") {
+				block = append(block, comment)
+				continue
+			}
+			open = false
+		}
+		if comment == "@example" || strings.HasPrefix(comment, "@example ") {
+			open = true
+			if v := strings.TrimSpace(strings.TrimPrefix(comment, "@example")); v != "" {
+				block = append(block, v)
+			}
+			continue
+		}
+		lines = append(lines, comment)
+	}
+	example = strings.Join(block, "\n")
+	return
+}
+
+// isDeprecated reports whether the comment has a paragraph that starts with "Deprecated:".
+func isDeprecated(comments ...string) bool {
+	for _, comment := range comments {
+		for _, line := range strings.Split(comment, "\n") {
+			if strings.HasPrefix(strings.TrimSpace(line), "Deprecated:") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func makeStringSlice(elts []goast.Expr, info *stdtypes.Info) (result []string) {
 	for _, expr := range elts {
 		tv := info.Types[expr]